@@ -0,0 +1,260 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"errors"
+	"io"
+	"slices"
+	"sort"
+	"unicode/utf8"
+)
+
+// Span is an attribute applied to a range of text
+type Span[A comparable] struct {
+	// Start is the index of the first rune of the span
+	Start int64
+	// End is the index following the last rune of the span
+	End int64
+	// Attr is the attribute of the span
+	Attr A
+}
+
+// StyledSegment is a run of text with the same attributes throughout,
+// returned by AttributedText.ReadStyledSlice
+type StyledSegment[A comparable] struct {
+	// Index is the index of the first rune of the segment
+	Index int64
+	// Size is the length of the segment in native units
+	Size int
+	// Runes is the text of the segment
+	Runes []rune
+	// Attrs are the attributes of the segment, in the order their spans
+	// were added
+	Attrs []A
+}
+
+// AttributedText is a RuneReader with attribute spans attached to ranges of
+// its text, such as the colours and styles of terminal text. The attribute
+// type is chosen by the caller and spans with equal attributes which
+// overlap or touch are merged into one
+//
+// Each rune may be covered by any number of spans with different
+// attributes. The attributes of a rune are returned in the order their spans
+// were added, so that later attributes can take precedence over earlier ones
+//
+// All indices are in the native units of the reader given
+type AttributedText[A comparable] struct {
+	runeReader
+	runes bool
+	spans []Span[A]
+}
+
+// NewAttributedText returns a new AttributedText for the reader given, with
+// no spans attached
+//
+// NewAttributedText was added by go-corelibs
+func NewAttributedText[A comparable](r RuneReader) *AttributedText[A] {
+	t := &AttributedText[A]{runeReader: r}
+	if ri, ok := r.(runeIndexer); ok {
+		t.runes = ri.runeIndexed()
+	}
+	return t
+}
+
+// runeIndexed returns true if the native units of the reader are runes
+func (t *AttributedText[A]) runeIndexed() bool { return t.runes }
+
+// checkRange validates the start and end of a span
+func (t *AttributedText[A]) checkRange(method string, start, end int64) error {
+	if start < 0 || end > t.Size() || start > end {
+		return errors.New("AttributedText." + method + ": range out of bounds")
+	}
+	return nil
+}
+
+// AddSpan attaches the attribute given to the range of text from start to
+// end. Any existing spans with an equal attribute which overlap or touch the
+// range are merged with it. Empty spans are ignored
+//
+// AddSpan was added by go-corelibs
+func (t *AttributedText[A]) AddSpan(start, end int64, attr A) error {
+	if err := t.checkRange("AddSpan", start, end); err != nil {
+		return err
+	} else if start == end {
+		return nil
+	}
+	merged := -1
+	for idx := 0; idx < len(t.spans); {
+		span := t.spans[idx]
+		if span.Attr != attr || span.End < start || span.Start > end {
+			idx++
+			continue
+		}
+		start, end = min(start, span.Start), max(end, span.End)
+		if merged < 0 {
+			merged = idx
+			idx++
+		} else {
+			t.spans = slices.Delete(t.spans, idx, idx+1)
+		}
+	}
+	if merged < 0 {
+		t.spans = append(t.spans, Span[A]{Start: start, End: end, Attr: attr})
+	} else {
+		t.spans[merged].Start, t.spans[merged].End = start, end
+	}
+	return nil
+}
+
+// RemoveSpan detaches the attribute given from the range of text from start
+// to end, shortening or splitting any spans with an equal attribute
+//
+// RemoveSpan was added by go-corelibs
+func (t *AttributedText[A]) RemoveSpan(start, end int64, attr A) error {
+	if err := t.checkRange("RemoveSpan", start, end); err != nil {
+		return err
+	}
+	spans := t.spans[:0:0]
+	for _, span := range t.spans {
+		if span.Attr != attr || span.End <= start || span.Start >= end {
+			spans = append(spans, span)
+			continue
+		}
+		if span.Start < start {
+			spans = append(spans, Span[A]{Start: span.Start, End: start, Attr: attr})
+		}
+		if span.End > end {
+			spans = append(spans, Span[A]{Start: end, End: span.End, Attr: attr})
+		}
+	}
+	t.spans = spans
+	return nil
+}
+
+// Spans returns a copy of the spans attached to the text, in the order they
+// were added
+//
+// Spans was added by go-corelibs
+func (t *AttributedText[A]) Spans() []Span[A] {
+	return slices.Clone(t.spans)
+}
+
+// AttrsAt returns the attributes of the rune at the index given, in the
+// order their spans were added
+//
+// AttrsAt was added by go-corelibs
+func (t *AttributedText[A]) AttrsAt(index int64) (attrs []A) {
+	for _, span := range t.spans {
+		if span.Start <= index && index < span.End {
+			attrs = append(attrs, span.Attr)
+		}
+	}
+	return
+}
+
+// ReadAttrRune is like ReadRune, but also returns the attributes of the rune
+//
+// ReadAttrRune was added by go-corelibs
+func (t *AttributedText[A]) ReadAttrRune() (ch rune, size int, attrs []A, err error) {
+	var index int64
+	if index, err = t.Seek(0, io.SeekCurrent); err != nil {
+		return 0, 0, nil, err
+	}
+	if ch, size, err = t.ReadRune(); err != nil {
+		return 0, 0, nil, err
+	}
+	return ch, size, t.AttrsAt(index), nil
+}
+
+// ReadAttrRuneAt is like ReadRuneAt, but also returns the attributes of the
+// rune
+//
+// ReadAttrRuneAt was added by go-corelibs
+func (t *AttributedText[A]) ReadAttrRuneAt(index int64) (ch rune, size int, attrs []A, err error) {
+	if ch, size, err = t.ReadRuneAt(index); err != nil {
+		return 0, 0, nil, err
+	}
+	return ch, size, t.AttrsAt(index), nil
+}
+
+// Slice returns a new AttributedText of up to count runes starting at the
+// index given, with the spans within the range clipped and moved along with
+// the text. The index and count arguments are as with ReadRuneSlice
+//
+// Slice was added by go-corelibs
+func (t *AttributedText[A]) Slice(index, count int64) (sliced *AttributedText[A], err error) {
+	var size int
+	if _, size, err = t.ReadRuneSlice(index, count); err != nil {
+		return nil, err
+	}
+	var text string
+	if text, err = t.ReadString(index, int64(size)); err != nil {
+		return nil, err
+	}
+	end := index + int64(size)
+	sliced = &AttributedText[A]{runeReader: textReaderFor(text, t.runes), runes: t.runes}
+	for _, span := range t.spans {
+		if span.End > index && span.Start < end {
+			sliced.spans = append(sliced.spans, Span[A]{
+				Start: max(span.Start, index) - index,
+				End:   min(span.End, end) - index,
+				Attr:  span.Attr,
+			})
+		}
+	}
+	return sliced, nil
+}
+
+// ReadStyledSlice is like ReadRuneSlice, but returns the runes as segments
+// with the same attributes throughout. Adjacent segments always have
+// different attributes. The size returned is the total size of the segments
+//
+// ReadStyledSlice was added by go-corelibs
+func (t *AttributedText[A]) ReadStyledSlice(index, count int64) (segments []StyledSegment[A], size int, err error) {
+	if _, size, err = t.ReadRuneSlice(index, count); err != nil {
+		return nil, 0, err
+	}
+	var text string
+	if text, err = t.ReadString(index, int64(size)); err != nil {
+		return nil, 0, err
+	}
+	end := index + int64(size)
+
+	// the attributes only change at the edges of spans
+	edges := []int64{end}
+	for _, span := range t.spans {
+		for _, edge := range []int64{span.Start, span.End} {
+			if index < edge && edge < end {
+				edges = append(edges, edge)
+			}
+		}
+	}
+	slices.Sort(edges)
+	edges = slices.Compact(edges)
+
+	native := index
+	for offset, ch := range text {
+		last := len(segments) - 1
+		if last < 0 || native >= segments[last].Index+int64(segments[last].Size) {
+			k := sort.Search(len(edges), func(i int) bool { return edges[i] > native })
+			attrs := t.AttrsAt(native)
+			if last >= 0 && slices.Equal(segments[last].Attrs, attrs) {
+				segments[last].Size = int(edges[k] - segments[last].Index)
+			} else {
+				segments = append(segments, StyledSegment[A]{Index: native, Size: int(edges[k] - native), Attrs: attrs})
+				last++
+			}
+		}
+		segments[last].Runes = append(segments[last].Runes, ch)
+		if t.runes {
+			native++
+		} else {
+			_, width := utf8.DecodeRuneInString(text[offset:])
+			native += int64(width)
+		}
+	}
+	return segments, size, nil
+}
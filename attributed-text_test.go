@@ -0,0 +1,124 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes_test
+
+import (
+	"io"
+	"reflect"
+	"testing"
+
+	. "github.com/go-corelibs/runes"
+)
+
+func TestAttributedTextSpans(t *testing.T) {
+	at := NewAttributedText[string](NewStringReader("the quick brown fox"))
+	for _, span := range []Span[string]{
+		{0, 3, "bold"}, {5, 8, "bold"}, {1, 4, "red"}, {2, 6, "bold"}, {8, 10, "bold"}, {12, 12, "red"},
+	} {
+		if err := at.AddSpan(span.Start, span.End, span.Attr); err != nil {
+			t.Fatalf("AddSpan(%+v): %v", span, err)
+		}
+	}
+	want := []Span[string]{{0, 10, "bold"}, {1, 4, "red"}}
+	if got := at.Spans(); !reflect.DeepEqual(got, want) {
+		t.Errorf("merged spans got %+v; want %+v", got, want)
+	}
+	if got := at.AttrsAt(2); !reflect.DeepEqual(got, []string{"bold", "red"}) {
+		t.Errorf("AttrsAt(2) got %q", got)
+	}
+	if got := at.AttrsAt(10); got != nil {
+		t.Errorf("AttrsAt(10) got %q", got)
+	}
+
+	if err := at.RemoveSpan(3, 5, "bold"); err != nil {
+		t.Fatal(err)
+	}
+	want = []Span[string]{{0, 3, "bold"}, {5, 10, "bold"}, {1, 4, "red"}}
+	if got := at.Spans(); !reflect.DeepEqual(got, want) {
+		t.Errorf("removed spans got %+v; want %+v", got, want)
+	}
+
+	for _, r := range [][2]int64{{-1, 2}, {3, 2}, {0, 20}} {
+		if err := at.AddSpan(r[0], r[1], "x"); err == nil {
+			t.Errorf("AddSpan(%d, %d): expected error", r[0], r[1])
+		}
+		if err := at.RemoveSpan(r[0], r[1], "x"); err == nil {
+			t.Errorf("RemoveSpan(%d, %d): expected error", r[0], r[1])
+		}
+	}
+}
+
+func TestAttributedTextRead(t *testing.T) {
+	at := NewAttributedText[string](NewStringReader("ab\u4e16"))
+	_ = at.AddSpan(1, 5, "red")
+	for _, want := range []struct {
+		ch    rune
+		size  int
+		attrs []string
+	}{{'a', 1, nil}, {'b', 1, []string{"red"}}, {'\u4e16', 3, []string{"red"}}} {
+		ch, size, attrs, err := at.ReadAttrRune()
+		if ch != want.ch || size != want.size || !reflect.DeepEqual(attrs, want.attrs) || err != nil {
+			t.Errorf("ReadAttrRune got %q,%d,%q,%v; want %q,%d,%q", ch, size, attrs, err, want.ch, want.size, want.attrs)
+		}
+	}
+	if _, _, _, err := at.ReadAttrRune(); err != io.EOF {
+		t.Errorf("ReadAttrRune at end got %v", err)
+	}
+	if ch, _, attrs, err := at.ReadAttrRuneAt(2); ch != '\u4e16' || !reflect.DeepEqual(attrs, []string{"red"}) || err != nil {
+		t.Errorf("ReadAttrRuneAt(2) got %q,%q,%v", ch, attrs, err)
+	}
+}
+
+func TestAttributedTextStyled(t *testing.T) {
+	text := "hello \u4e16\u754c!"
+	for _, tc := range []struct {
+		r      RuneReader
+		red    int64
+		want   []StyledSegment[string]
+		sliced []Span[string]
+	}{
+		{NewStringReader(text), 12, []StyledSegment[string]{
+			{0, 3, []rune("hel"), []string{"bold"}},
+			{3, 2, []rune("lo"), []string{"bold", "red"}},
+			{5, 7, []rune(" \u4e16\u754c"), []string{"red"}},
+			{12, 1, []rune("!"), nil},
+		}, []Span[string]{{0, 1, "bold"}, {0, 8, "red"}}},
+		{NewRunesReader([]rune(text)), 8, []StyledSegment[string]{
+			{0, 3, []rune("hel"), []string{"bold"}},
+			{3, 2, []rune("lo"), []string{"bold", "red"}},
+			{5, 3, []rune(" \u4e16\u754c"), []string{"red"}},
+			{8, 1, []rune("!"), nil},
+		}, []Span[string]{{0, 1, "bold"}, {0, 4, "red"}}},
+	} {
+		at := NewAttributedText[string](tc.r)
+		_ = at.AddSpan(0, 5, "bold")
+		_ = at.AddSpan(3, tc.red, "red")
+		segments, size, err := at.ReadStyledSlice(0, 100)
+		if !reflect.DeepEqual(segments, tc.want) || size != int(tc.r.Size()) || err != nil {
+			t.Errorf("ReadStyledSlice got %+v,%d,%v; want %+v", segments, size, err, tc.want)
+		}
+		segments, size, err = at.ReadStyledSlice(4, 3)
+		if len(segments) != 2 || string(segments[0].Runes) != "o" || string(segments[1].Runes) != " \u4e16" || err != nil {
+			t.Errorf("ReadStyledSlice(4, 3) got %+v,%d,%v", segments, size, err)
+		}
+		if _, _, err = at.ReadStyledSlice(-1, 3); err == nil {
+			t.Errorf("ReadStyledSlice(-1, 3): expected error")
+		}
+
+		sliced, err := at.Slice(4, 4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, _ := io.ReadAll(sliced); string(got) != "o \u4e16\u754c" {
+			t.Errorf("Slice text got %q", got)
+		}
+		if got := sliced.Spans(); !reflect.DeepEqual(got, tc.sliced) {
+			t.Errorf("Slice spans got %+v; want %+v", got, tc.sliced)
+		}
+		if segments, _, _ = sliced.ReadStyledSlice(0, 4); len(segments) != 2 || segments[1].Index != 1 {
+			t.Errorf("sliced ReadStyledSlice got %+v", segments)
+		}
+	}
+}
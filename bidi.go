@@ -0,0 +1,761 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"errors"
+	"sort"
+
+	"golang.org/x/text/unicode/bidi"
+)
+
+// BidiDirection is the base direction of a paragraph of bidirectional text
+type BidiDirection uint8
+
+const (
+	// BidiAuto takes the direction of each paragraph from its first strong
+	// character, defaulting to left-to-right
+	BidiAuto BidiDirection = iota
+	// BidiLeftToRight forces each paragraph to be left-to-right
+	BidiLeftToRight
+	// BidiRightToLeft forces each paragraph to be right-to-left
+	BidiRightToLeft
+)
+
+// bidiMaxDepth is the maximum explicit embedding level
+const bidiMaxDepth = 125
+
+// bidiParagraph is a paragraph of a BidiText, text[start:end]
+type bidiParagraph struct {
+	start, end int
+	level      uint8
+}
+
+// BidiText holds the Unicode Bidirectional Algorithm (UAX #9) resolution of
+// a range of text. All indices are rune offsets within the range
+//
+// Each paragraph of the range is ordered as a single line. Use Line to order
+// the lines of text which has been wrapped
+type BidiText struct {
+	// Runes is the text of the range
+	Runes []rune
+	// Levels is the resolved embedding level of each rune. Even levels are
+	// left-to-right and odd levels are right-to-left
+	Levels []uint8
+	// Visual lists the runes in display order: Visual[pos] is the index of
+	// the rune displayed at position pos
+	Visual []int
+	// Logical is the inverse of Visual: Logical[i] is the display position
+	// of rune i
+	Logical []int
+
+	classes    []bidi.Class // the original bidi class of each rune
+	resolved   []uint8      // the levels before rule L1
+	paragraphs []bidiParagraph
+}
+
+// ResolveBidi applies the Unicode Bidirectional Algorithm to the count runes
+// of r at the index given. The direction given overrides the direction
+// of every paragraph unless it is BidiAuto
+//
+// ResolveBidi was added by go-corelibs
+func ResolveBidi(r RuneReader, index, count int64, direction BidiDirection) (b *BidiText, err error) {
+	if direction > BidiRightToLeft {
+		return nil, errors.New("ResolveBidi: invalid direction")
+	}
+	b = &BidiText{}
+	if count > 0 {
+		if b.Runes, _, err = r.ReadRuneSlice(index, count); err != nil {
+			return nil, err
+		}
+	}
+
+	n := len(b.Runes)
+	b.classes = make([]bidi.Class, n)
+	b.resolved = make([]uint8, n)
+	for i, ch := range b.Runes {
+		props, _ := bidi.LookupRune(ch)
+		b.classes[i] = props.Class()
+	}
+
+	// P1: split the text into paragraphs, each including its separator
+	for start, i := 0, 0; i < n; i++ {
+		if b.classes[i] == bidi.B || i == n-1 {
+			p := bidiParagraph{start: start, end: i + 1}
+			p.level = b.levelOf(p, direction)
+			newBidiResolver(b, p).resolve()
+			b.paragraphs = append(b.paragraphs, p)
+			start = i + 1
+		}
+	}
+
+	b.Levels = make([]uint8, n)
+	b.Visual = make([]int, 0, n)
+	for _, p := range b.paragraphs {
+		b.Visual = append(b.Visual, b.lineOrder(p, p.start, p.end, b.Levels)...)
+	}
+	b.Logical = make([]int, n)
+	for pos, i := range b.Visual {
+		b.Logical[i] = pos
+	}
+	return b, nil
+}
+
+// levelOf returns the embedding level of paragraph p
+func (b *BidiText) levelOf(p bidiParagraph, direction BidiDirection) uint8 {
+	switch direction {
+	case BidiLeftToRight:
+		return 0
+	case BidiRightToLeft:
+		return 1
+	}
+	return firstStrongLevel(b.classes, p.start, p.end, 0)
+}
+
+// ParagraphLevel returns the embedding level of the paragraph containing the
+// rune index given, zero for left-to-right and one for right-to-left
+//
+// ParagraphLevel was added by go-corelibs
+func (b *BidiText) ParagraphLevel(index int) uint8 {
+	k := sort.Search(len(b.paragraphs), func(i int) bool { return b.paragraphs[i].end > index })
+	if k < len(b.paragraphs) {
+		return b.paragraphs[k].level
+	}
+	return 0
+}
+
+// Line returns the display order of the runes from start up to end, as a
+// line of text broken from its paragraph. The line must not span more than
+// one paragraph
+//
+// Line was added by go-corelibs
+func (b *BidiText) Line(start, end int) (visual []int, err error) {
+	if start < 0 || end > len(b.Runes) || start > end {
+		return nil, errors.New("BidiText.Line: range out of bounds")
+	} else if start == end {
+		return []int{}, nil
+	}
+	k := sort.Search(len(b.paragraphs), func(i int) bool { return b.paragraphs[i].end > start })
+	if p := b.paragraphs[k]; end > p.end {
+		return nil, errors.New("BidiText.Line: range spans more than one paragraph")
+	}
+	levels := make([]uint8, len(b.Runes))
+	return b.lineOrder(b.paragraphs[k], start, end, levels), nil
+}
+
+// lineOrder applies rules L1 and L2 to the line from start up to end,
+// writing the final levels to levels and returning the display order
+func (b *BidiText) lineOrder(p bidiParagraph, start, end int, levels []uint8) (visual []int) {
+	copy(levels[start:end], b.resolved[start:end])
+
+	// L1: separators, and any whitespace preceding them or the end of the
+	// line, are reset to the paragraph level
+	trailing := true
+	for i := end - 1; i >= start; i-- {
+		switch c := b.classes[i]; {
+		case c == bidi.B || c == bidi.S:
+			levels[i] = p.level
+			trailing = true
+		case trailing && (c == bidi.WS || isIsolateControl(c) || isRemovedByX9(c)):
+			levels[i] = p.level
+		default:
+			trailing = false
+		}
+	}
+
+	// L2: reverse every sequence at each level, from the highest level down
+	// to the lowest odd level
+	var highest, lowestOdd uint8 = 0, bidiMaxDepth + 2
+	for _, level := range levels[start:end] {
+		if level > highest {
+			highest = level
+		}
+		if level%2 == 1 && level < lowestOdd {
+			lowestOdd = level
+		}
+	}
+	visual = make([]int, end-start)
+	for i := range visual {
+		visual[i] = start + i
+	}
+	for level := highest; level >= lowestOdd && level > 0; level-- {
+		for i := 0; i < len(visual); {
+			if levels[visual[i]] < level {
+				i++
+				continue
+			}
+			j := i
+			for j < len(visual) && levels[visual[j]] >= level {
+				j++
+			}
+			for lo, hi := i, j-1; lo < hi; lo, hi = lo+1, hi-1 {
+				visual[lo], visual[hi] = visual[hi], visual[lo]
+			}
+			i = j
+		}
+	}
+	return
+}
+
+// isIsolateControl returns true for the isolate initiators and PDI
+func isIsolateControl(c bidi.Class) bool {
+	return c == bidi.LRI || c == bidi.RLI || c == bidi.FSI || c == bidi.PDI
+}
+
+// isIsolateInitiator returns true for LRI, RLI and FSI
+func isIsolateInitiator(c bidi.Class) bool {
+	return c == bidi.LRI || c == bidi.RLI || c == bidi.FSI
+}
+
+// isRemovedByX9 returns true for the classes ignored by rule X9
+func isRemovedByX9(c bidi.Class) bool {
+	switch c {
+	case bidi.LRE, bidi.RLE, bidi.LRO, bidi.RLO, bidi.PDF, bidi.BN:
+		return true
+	}
+	return false
+}
+
+// isStrongOrNumber maps a class to L or R for rules N0 and N1, with numbers
+// treated as R, returning false for any other class
+func isStrongOrNumber(c bidi.Class) (bidi.Class, bool) {
+	switch c {
+	case bidi.L:
+		return bidi.L, true
+	case bidi.R, bidi.AL, bidi.EN, bidi.AN:
+		return bidi.R, true
+	}
+	return c, false
+}
+
+// directionOf returns L for even levels and R for odd levels
+func directionOf(level uint8) bidi.Class {
+	if level%2 == 0 {
+		return bidi.L
+	}
+	return bidi.R
+}
+
+// firstStrongLevel implements rules P2 and P3 over classes[start:end],
+// skipping isolated text, returning the level given if there is no strong
+// character
+func firstStrongLevel(classes []bidi.Class, start, end int, level uint8) uint8 {
+	depth := 0
+	for i := start; i < end; i++ {
+		switch c := classes[i]; {
+		case isIsolateInitiator(c):
+			depth++
+		case c == bidi.PDI:
+			if depth > 0 {
+				depth--
+			}
+		case c == bidi.B:
+			return level
+		case depth == 0 && c == bidi.L:
+			return 0
+		case depth == 0 && (c == bidi.R || c == bidi.AL):
+			return 1
+		}
+	}
+	return level
+}
+
+// bidiResolver resolves the embedding levels of one paragraph
+type bidiResolver struct {
+	b         *BidiText
+	start     int
+	end       int
+	level     uint8
+	types     []bidi.Class // the current class of each rune of the paragraph
+	levels    []uint8      // the current level of each rune of the paragraph
+	matching  []int        // matching PDI of each isolate initiator, or -1
+	initiator []int        // matching isolate initiator of each PDI, or -1
+}
+
+func newBidiResolver(b *BidiText, p bidiParagraph) *bidiResolver {
+	return &bidiResolver{
+		b:      b,
+		start:  p.start,
+		end:    p.end,
+		level:  p.level,
+		types:  append([]bidi.Class(nil), b.classes[p.start:p.end]...),
+		levels: b.resolved[p.start:p.end],
+	}
+}
+
+func (r *bidiResolver) resolve() {
+	r.matchIsolates()
+	r.explicitLevels()
+	for _, seq := range r.isolatingRunSequences() {
+		seq.resolveWeakTypes()
+		seq.resolvePairedBrackets()
+		seq.resolveNeutralTypes()
+		seq.resolveImplicitLevels()
+	}
+	// the runes removed by X9 take the level of the preceding rune
+	for i, c := range r.b.classes[r.start:r.end] {
+		if !isRemovedByX9(c) {
+			continue
+		} else if i == 0 {
+			r.levels[i] = r.level
+		} else {
+			r.levels[i] = r.levels[i-1]
+		}
+	}
+}
+
+// matchIsolates implements BD9, matching isolate initiators and PDIs
+func (r *bidiResolver) matchIsolates() {
+	n := len(r.types)
+	r.matching = make([]int, n)
+	r.initiator = make([]int, n)
+	for i := range r.initiator {
+		r.matching[i], r.initiator[i] = -1, -1
+	}
+	var stack []int
+	for i, c := range r.types {
+		switch {
+		case isIsolateInitiator(c):
+			stack = append(stack, i)
+			r.matching[i] = n
+		case c == bidi.PDI && len(stack) > 0:
+			opener := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			r.matching[opener] = i
+			r.initiator[i] = opener
+		}
+	}
+}
+
+// bidiStatus is an entry of the directional status stack
+type bidiStatus struct {
+	level    uint8
+	override bidi.Class // ON when there is no override
+	isolate  bool
+}
+
+// explicitLevels implements rules X1 to X8
+func (r *bidiResolver) explicitLevels() {
+	stack := []bidiStatus{{level: r.level, override: bidi.ON}}
+	var overflowIsolates, overflowEmbeddings, validIsolates int
+
+	nextLevel := func(rtl bool) uint8 {
+		level := stack[len(stack)-1].level + 1
+		if (level%2 == 1) != rtl {
+			level++
+		}
+		return level
+	}
+
+	for i, c := range r.types {
+		last := stack[len(stack)-1]
+		switch c {
+		case bidi.RLE, bidi.LRE, bidi.RLO, bidi.LRO:
+			// X2 - X5
+			r.levels[i] = last.level
+			level := nextLevel(c == bidi.RLE || c == bidi.RLO)
+			if level <= bidiMaxDepth && overflowIsolates == 0 && overflowEmbeddings == 0 {
+				status := bidiStatus{level: level, override: bidi.ON}
+				if c == bidi.RLO {
+					status.override = bidi.R
+				} else if c == bidi.LRO {
+					status.override = bidi.L
+				}
+				stack = append(stack, status)
+			} else if overflowIsolates == 0 {
+				overflowEmbeddings++
+			}
+
+		case bidi.RLI, bidi.LRI, bidi.FSI:
+			// X5a - X5c
+			r.levels[i] = last.level
+			if last.override != bidi.ON {
+				r.types[i] = last.override
+			}
+			rtl := c == bidi.RLI
+			if c == bidi.FSI {
+				rtl = firstStrongLevel(r.b.classes, r.start+i+1, r.start+r.matching[i], 0) == 1
+			}
+			level := nextLevel(rtl)
+			if level <= bidiMaxDepth && overflowIsolates == 0 && overflowEmbeddings == 0 {
+				validIsolates++
+				stack = append(stack, bidiStatus{level: level, override: bidi.ON, isolate: true})
+			} else {
+				overflowIsolates++
+			}
+
+		case bidi.PDI:
+			// X6a
+			if overflowIsolates > 0 {
+				overflowIsolates--
+			} else if validIsolates > 0 {
+				overflowEmbeddings = 0
+				for !stack[len(stack)-1].isolate {
+					stack = stack[:len(stack)-1]
+				}
+				stack = stack[:len(stack)-1]
+				validIsolates--
+			}
+			last = stack[len(stack)-1]
+			r.levels[i] = last.level
+			if last.override != bidi.ON {
+				r.types[i] = last.override
+			}
+
+		case bidi.PDF:
+			// X7
+			r.levels[i] = last.level
+			if overflowIsolates > 0 {
+			} else if overflowEmbeddings > 0 {
+				overflowEmbeddings--
+			} else if !last.isolate && len(stack) >= 2 {
+				stack = stack[:len(stack)-1]
+			}
+
+		case bidi.B:
+			// X8
+			r.levels[i] = r.level
+
+		case bidi.BN:
+			r.levels[i] = last.level
+
+		default:
+			// X6
+			r.levels[i] = last.level
+			if last.override != bidi.ON {
+				r.types[i] = last.override
+			}
+		}
+	}
+}
+
+// bidiSequence is an isolating run sequence
+type bidiSequence struct {
+	r       *bidiResolver
+	indexes []int        // paragraph offsets of the runes of the sequence
+	types   []bidi.Class // the current class of each rune of the sequence
+	level   uint8
+	sos     bidi.Class
+	eos     bidi.Class
+}
+
+// isolatingRunSequences implements rules X9 and X10
+func (r *bidiResolver) isolatingRunSequences() (sequences []*bidiSequence) {
+	// level runs, ignoring the runes removed by X9
+	var runs [][]int
+	var run []int
+	for i, c := range r.b.classes[r.start:r.end] {
+		if isRemovedByX9(c) {
+			continue
+		}
+		if len(run) > 0 && r.levels[i] != r.levels[run[len(run)-1]] {
+			runs = append(runs, run)
+			run = nil
+		}
+		run = append(run, i)
+	}
+	if len(run) > 0 {
+		runs = append(runs, run)
+	}
+
+	// the level run starting with each PDI matched to an isolate initiator
+	runOf := make(map[int]int)
+	for k, run := range runs {
+		runOf[run[0]] = k
+	}
+	for _, run := range runs {
+		if first := run[0]; r.b.classes[r.start+first] == bidi.PDI && r.initiator[first] >= 0 {
+			// continues the sequence of its isolate initiator
+			continue
+		}
+		var indexes []int
+		for {
+			indexes = append(indexes, run...)
+			last := run[len(run)-1]
+			if !isIsolateInitiator(r.b.classes[r.start+last]) || r.matching[last] >= len(r.types) {
+				break
+			}
+			k, ok := runOf[r.matching[last]]
+			if !ok {
+				break
+			}
+			run = runs[k]
+		}
+		sequences = append(sequences, r.newSequence(indexes))
+	}
+	return
+}
+
+func (r *bidiResolver) newSequence(indexes []int) (s *bidiSequence) {
+	s = &bidiSequence{r: r, indexes: indexes, level: r.levels[indexes[0]]}
+	s.types = make([]bidi.Class, len(indexes))
+	for i, index := range indexes {
+		s.types[i] = r.types[index]
+	}
+
+	prev := r.level
+	for i := indexes[0] - 1; i >= 0; i-- {
+		if !isRemovedByX9(r.b.classes[r.start+i]) {
+			prev = r.levels[i]
+			break
+		}
+	}
+	next := r.level
+	if last := indexes[len(indexes)-1]; !isIsolateInitiator(r.types[last]) {
+		for i := last + 1; i < len(r.types); i++ {
+			if !isRemovedByX9(r.b.classes[r.start+i]) {
+				next = r.levels[i]
+				break
+			}
+		}
+	}
+	s.sos = directionOf(max(prev, s.level))
+	s.eos = directionOf(max(next, s.level))
+	return
+}
+
+// resolveWeakTypes implements rules W1 to W7
+func (s *bidiSequence) resolveWeakTypes() {
+	types := s.types
+
+	// W1
+	prev := s.sos
+	for i, c := range types {
+		if c == bidi.NSM {
+			if isIsolateControl(prev) {
+				types[i] = bidi.ON
+			} else {
+				types[i] = prev
+			}
+		}
+		prev = types[i]
+	}
+
+	// W2 and W3
+	strong := s.sos
+	for i, c := range types {
+		switch c {
+		case bidi.L, bidi.R, bidi.AL:
+			strong = c
+		case bidi.EN:
+			if strong == bidi.AL {
+				types[i] = bidi.AN
+			}
+		}
+	}
+	for i, c := range types {
+		if c == bidi.AL {
+			types[i] = bidi.R
+		}
+	}
+
+	// W4
+	for i := 1; i < len(types)-1; i++ {
+		before, after := types[i-1], types[i+1]
+		switch types[i] {
+		case bidi.ES:
+			if before == bidi.EN && after == bidi.EN {
+				types[i] = bidi.EN
+			}
+		case bidi.CS:
+			if before == after && (before == bidi.EN || before == bidi.AN) {
+				types[i] = before
+			}
+		}
+	}
+
+	// W5
+	for i := 0; i < len(types); i++ {
+		if types[i] != bidi.ET {
+			continue
+		}
+		j := i
+		for j < len(types) && types[j] == bidi.ET {
+			j++
+		}
+		if (i > 0 && types[i-1] == bidi.EN) || (j < len(types) && types[j] == bidi.EN) {
+			for k := i; k < j; k++ {
+				types[k] = bidi.EN
+			}
+		}
+		i = j
+	}
+
+	// W6
+	for i, c := range types {
+		if c == bidi.ES || c == bidi.ET || c == bidi.CS {
+			types[i] = bidi.ON
+		}
+	}
+
+	// W7
+	strong = s.sos
+	for i, c := range types {
+		switch c {
+		case bidi.L, bidi.R:
+			strong = c
+		case bidi.EN:
+			if strong == bidi.L {
+				types[i] = bidi.L
+			}
+		}
+	}
+}
+
+// bidiBracketPair is a pair of matched brackets, as offsets in a sequence
+type bidiBracketPair struct {
+	opener, closer int
+}
+
+// canonicalBracket maps the deprecated angle brackets to their canonical
+// equivalents, as required by BD16
+func canonicalBracket(ch rune) rune {
+	switch ch {
+	case '\u2329':
+		return '\u3008'
+	case '\u232A':
+		return '\u3009'
+	}
+	return ch
+}
+
+// bracketPairs implements BD16, locating the bracket pairs of the sequence
+func (s *bidiSequence) bracketPairs() (pairs []bidiBracketPair) {
+	type opening struct {
+		closer   rune
+		position int
+	}
+	var stack []opening
+	for i, index := range s.indexes {
+		if s.types[i] != bidi.ON {
+			continue
+		}
+		ch := s.r.b.Runes[s.r.start+index]
+		if closer, ok := bidiOpeningBrackets[ch]; ok {
+			if len(stack) == 63 {
+				break
+			}
+			stack = append(stack, opening{closer: canonicalBracket(closer), position: i})
+		} else if _, ok := bidiClosingBrackets[ch]; ok {
+			ch = canonicalBracket(ch)
+			for k := len(stack) - 1; k >= 0; k-- {
+				if stack[k].closer == ch {
+					pairs = append(pairs, bidiBracketPair{opener: stack[k].position, closer: i})
+					stack = stack[:k]
+					break
+				}
+			}
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].opener < pairs[j].opener })
+	return
+}
+
+// resolvePairedBrackets implements rule N0
+func (s *bidiSequence) resolvePairedBrackets() {
+	embedding := directionOf(s.level)
+	for _, pair := range s.bracketPairs() {
+		var found, opposite bool
+		for i := pair.opener + 1; i < pair.closer; i++ {
+			if dir, ok := isStrongOrNumber(s.types[i]); ok {
+				if dir == embedding {
+					found = true
+					break
+				}
+				opposite = true
+			}
+		}
+
+		var dir bidi.Class
+		switch {
+		case found:
+			dir = embedding
+		case opposite:
+			context := s.sos
+			for i := pair.opener - 1; i >= 0; i-- {
+				if d, ok := isStrongOrNumber(s.types[i]); ok {
+					context = d
+					break
+				}
+			}
+			if context != embedding {
+				dir = context
+			} else {
+				dir = embedding
+			}
+		default:
+			continue
+		}
+
+		for _, position := range []int{pair.opener, pair.closer} {
+			s.types[position] = dir
+			// any NSM following a bracket takes its direction
+			for i := position + 1; i < len(s.types); i++ {
+				if s.r.b.classes[s.r.start+s.indexes[i]] != bidi.NSM {
+					break
+				}
+				s.types[i] = dir
+			}
+		}
+	}
+}
+
+// isNeutralOrIsolate returns true for the NI classes of rules N1 and N2
+func isNeutralOrIsolate(c bidi.Class) bool {
+	switch c {
+	case bidi.B, bidi.S, bidi.WS, bidi.ON:
+		return true
+	}
+	return isIsolateControl(c)
+}
+
+// resolveNeutralTypes implements rules N1 and N2
+func (s *bidiSequence) resolveNeutralTypes() {
+	types := s.types
+	embedding := directionOf(s.level)
+	for i := 0; i < len(types); i++ {
+		if !isNeutralOrIsolate(types[i]) {
+			continue
+		}
+		j := i
+		for j < len(types) && isNeutralOrIsolate(types[j]) {
+			j++
+		}
+		before := s.sos
+		if i > 0 {
+			before, _ = isStrongOrNumber(types[i-1])
+		}
+		after := s.eos
+		if j < len(types) {
+			after, _ = isStrongOrNumber(types[j])
+		}
+		dir := embedding
+		if before == after {
+			dir = before
+		}
+		for k := i; k < j; k++ {
+			types[k] = dir
+		}
+		i = j
+	}
+}
+
+// resolveImplicitLevels implements rules I1 and I2, storing the resolved
+// levels in the paragraph
+func (s *bidiSequence) resolveImplicitLevels() {
+	for i, index := range s.indexes {
+		level := s.r.levels[index]
+		switch c := s.types[i]; {
+		case level%2 == 0 && c == bidi.R:
+			level++
+		case level%2 == 0 && (c == bidi.AN || c == bidi.EN):
+			level += 2
+		case level%2 == 1 && (c == bidi.L || c == bidi.EN || c == bidi.AN):
+			level++
+		}
+		s.r.levels[index] = level
+		s.r.types[index] = s.types[i]
+	}
+}
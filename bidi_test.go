@@ -0,0 +1,130 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes_test
+
+import (
+	"bufio"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/unicode/bidi"
+	"golang.org/x/text/unicode/rangetable"
+
+	. "github.com/go-corelibs/runes"
+)
+
+//gocyclo:ignore
+func TestBidiCharacterConformance(t *testing.T) {
+	fh, err := os.Open("testdata/BidiCharacterTest.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fh.Close()
+
+	assigned := rangetable.Assigned(bidi.UnicodeVersion)
+	directions := map[string]BidiDirection{"0": BidiLeftToRight, "1": BidiRightToLeft, "2": BidiAuto}
+
+	var count, failures int
+	s := bufio.NewScanner(fh)
+	s.Buffer(nil, 1<<20)
+	for line := 1; s.Scan() && failures < 20; line++ {
+		text := s.Text()
+		if text == "" || text[0] == '#' {
+			continue
+		}
+		fields := strings.Split(text, ";")
+		if len(fields) != 5 {
+			t.Fatalf("line %d: invalid test", line)
+		}
+		input := parseCodePoints(t, fields[0])
+		if !assignedIn(assigned, input) {
+			// the test uses characters unknown to golang.org/x/text
+			continue
+		}
+		count++
+
+		b, err := ResolveBidi(NewStringReader(input), 0, int64(len(input)), directions[fields[1]])
+		if err != nil {
+			t.Fatalf("line %d: ResolveBidi: %v", line, err)
+		}
+
+		wantLevel, _ := strconv.Atoi(fields[2])
+		var gotLevels, wantLevels []string
+		ignored := map[int]bool{}
+		for i, level := range strings.Fields(fields[3]) {
+			if level == "x" {
+				ignored[i] = true
+				continue
+			}
+			wantLevels = append(wantLevels, level)
+			gotLevels = append(gotLevels, strconv.Itoa(int(b.Levels[i])))
+		}
+		var gotOrder []string
+		for _, i := range b.Visual {
+			if !ignored[i] {
+				gotOrder = append(gotOrder, strconv.Itoa(i))
+			}
+		}
+		wantOrder := strings.Fields(fields[4])
+		if int(b.ParagraphLevel(0)) != wantLevel || !reflect.DeepEqual(gotLevels, wantLevels) || !reflect.DeepEqual(gotOrder, wantOrder) {
+			failures++
+			t.Errorf("line %d: %s\n got level %d, levels %v, order %v\nwant level %d, levels %v, order %v",
+				line, fields[0], b.ParagraphLevel(0), gotLevels, gotOrder, wantLevel, wantLevels, wantOrder)
+		}
+	}
+	if err = s.Err(); err != nil {
+		t.Fatal(err)
+	} else if count < 90000 {
+		t.Errorf("only %d tests checked", count)
+	}
+}
+
+func TestResolveBidi(t *testing.T) {
+	// "abc ALEF BET GIMEL 123." followed by a second, right-to-left paragraph
+	const text = "abc \u05d0\u05d1\u05d2 123.\u2029\u05d3\u05d4 de"
+	for _, r := range newCaseReaders(text) {
+		b, err := ResolveBidi(r, 0, r.Size(), BidiAuto)
+		if err != nil {
+			t.Fatalf("%T ResolveBidi: unexpected error: %v", r, err)
+		}
+		wantLevels := []uint8{0, 0, 0, 0, 1, 1, 1, 1, 2, 2, 2, 0, 0, 1, 1, 1, 2, 2}
+		wantVisual := []int{0, 1, 2, 3, 8, 9, 10, 7, 6, 5, 4, 11, 12, 16, 17, 15, 14, 13}
+		if !reflect.DeepEqual(b.Levels, wantLevels) || !reflect.DeepEqual(b.Visual, wantVisual) {
+			t.Errorf("%T ResolveBidi got %v %v; want %v %v", r, b.Levels, b.Visual, wantLevels, wantVisual)
+		}
+		for pos, i := range b.Visual {
+			if b.Logical[i] != pos {
+				t.Errorf("%T Logical[%d] got %d; want %d", r, i, b.Logical[i], pos)
+			}
+		}
+		if b.ParagraphLevel(0) != 0 || b.ParagraphLevel(13) != 1 {
+			t.Errorf("%T ParagraphLevel got %d,%d; want 0,1", r, b.ParagraphLevel(0), b.ParagraphLevel(13))
+		}
+	}
+
+	// the trailing whitespace of a line takes the paragraph direction
+	b, _ := ResolveBidi(NewStringReader(text), 0, 11, BidiRightToLeft)
+	if want := []int{8, 9, 10, 7, 6, 5, 4, 3, 0, 1, 2}; !reflect.DeepEqual(b.Visual, want) {
+		t.Errorf("ResolveBidi(RTL) got %v; want %v", b.Visual, want)
+	}
+	if visual, err := b.Line(0, 4); !reflect.DeepEqual(visual, []int{3, 0, 1, 2}) || err != nil {
+		t.Errorf("Line(0, 4) got %v,%v; want [3 0 1 2]", visual, err)
+	}
+	if visual, err := b.Line(4, 8); !reflect.DeepEqual(visual, []int{7, 6, 5, 4}) || err != nil {
+		t.Errorf("Line(4, 8) got %v,%v; want [7 6 5 4]", visual, err)
+	}
+	if _, err := b.Line(5, 20); err == nil || err.Error() != "BidiText.Line: range out of bounds" {
+		t.Errorf("Line(5, 20) got %v; want out of bounds", err)
+	}
+	if _, err := ResolveBidi(NewStringReader(text), 0, 1, BidiDirection(9)); err == nil {
+		t.Errorf("ResolveBidi with an invalid direction succeeded")
+	}
+	if b, err := ResolveBidi(NewStringReader(""), 0, 0, BidiAuto); err != nil || len(b.Visual) != 0 {
+		t.Errorf("ResolveBidi of empty text got %v,%v", b.Visual, err)
+	}
+}
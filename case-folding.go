@@ -0,0 +1,273 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// foldRep returns the representative rune of the simple case folding orbit of
+// ch, two runes are equal under simple case folding when their
+// representatives are the same
+func foldRep(ch rune) rune {
+	if ch < utf8.RuneSelf {
+		if 'a' <= ch && ch <= 'z' {
+			return ch - 'a' + 'A'
+		}
+		return ch
+	}
+	rep := ch
+	for f := unicode.SimpleFold(ch); f != ch; f = unicode.SimpleFold(f) {
+		if f < rep {
+			rep = f
+		}
+	}
+	return rep
+}
+
+// folder produces the full case folding of a RuneReader, one rune at a time
+type folder struct {
+	r     RuneReader
+	index int64  // index of the next source rune
+	size  int64  // size of the source
+	exp   string // the remaining runes of a multi-rune folding
+}
+
+func newFolder(r RuneReader, index int64) *folder {
+	return &folder{r: r, index: index, size: r.Size()}
+}
+
+// next returns the next rune of the case folded text, or io.EOF
+func (f *folder) next() (ch rune, err error) {
+	if f.exp != "" {
+		ch, width := utf8.DecodeRuneInString(f.exp)
+		f.exp = f.exp[width:]
+		return foldRep(ch), nil
+	}
+	if f.index >= f.size {
+		return 0, io.EOF
+	}
+	var width int
+	if ch, width, err = f.r.ReadRuneAt(f.index); err != nil {
+		return 0, err
+	}
+	f.index += int64(width)
+	if ch >= 0xDF {
+		if full, ok := fullCaseFolding[ch]; ok {
+			ch, width = utf8.DecodeRuneInString(full)
+			f.exp = full[width:]
+		}
+	}
+	return foldRep(ch), nil
+}
+
+// boundary returns true if all the runes of the last source rune have been
+// returned by next
+func (f *folder) boundary() bool {
+	return f.exp == ""
+}
+
+// EqualFold reports whether the entire texts of a and b are equal under full
+// Unicode case folding, without allocating new strings. For example, "ß" is
+// equal to "SS". Both readers are left at unspecified positions
+//
+// EqualFold was added by go-corelibs
+func EqualFold(a, b RuneReader) (ok bool, err error) {
+	fa, fb := newFolder(a, 0), newFolder(b, 0)
+	for {
+		ca, ea := fa.next()
+		cb, eb := fb.next()
+		if ea == io.EOF && eb == io.EOF {
+			return true, nil
+		} else if ea != nil && ea != io.EOF {
+			return false, ea
+		} else if eb != nil && eb != io.EOF {
+			return false, eb
+		} else if ea != nil || eb != nil || ca != cb {
+			return false, nil
+		}
+	}
+}
+
+// HasPrefixFoldAt reports whether the text of r, starting at the index given,
+// begins with prefix under full Unicode case folding. When ok is true, size
+// is the number of native units of r which matched the prefix. A match must
+// end on a rune boundary of r, so "ß" begins with "ss" but not with "s"
+//
+// HasPrefixFoldAt was added by go-corelibs
+func HasPrefixFoldAt(r RuneReader, index int64, prefix string) (ok bool, size int64, err error) {
+	if index < 0 {
+		return false, 0, errors.New("HasPrefixFoldAt: negative position")
+	}
+	fr, fp := newFolder(r, index), newFolder(NewStringReader(prefix), 0)
+	for {
+		cp, ep := fp.next()
+		if ep == io.EOF {
+			if fr.boundary() {
+				return true, fr.index - index, nil
+			}
+			return false, 0, nil
+		}
+		cr, er := fr.next()
+		if er == io.EOF {
+			return false, 0, nil
+		} else if er != nil {
+			return false, 0, er
+		} else if cr != cp {
+			return false, 0, nil
+		}
+	}
+}
+
+// IndexFold returns the index and native size of the first match of substr
+// within the text of r, under full Unicode case folding, or -1 if there is no
+// match
+//
+// IndexFold was added by go-corelibs
+func IndexFold(r RuneReader, substr string) (index, size int64, err error) {
+	if substr == "" {
+		return 0, 0, nil
+	}
+	end := r.Size()
+	for index < end {
+		var ok bool
+		if ok, size, err = HasPrefixFoldAt(r, index, substr); err != nil {
+			return -1, 0, err
+		} else if ok {
+			return index, size, nil
+		}
+		var width int
+		if _, width, err = r.ReadRuneAt(index); err != nil {
+			return -1, 0, err
+		}
+		index += int64(width)
+	}
+	return -1, 0, nil
+}
+
+// ToUpper returns the entire text of r mapped to upper case. When special is
+// not nil, its mappings take precedence, such as unicode.TurkishCase for
+// dotted and dotless i. Mappings to more than one rune are honoured, so "ß"
+// becomes "SS"
+//
+// ToUpper was added by go-corelibs
+func ToUpper(r RuneReader, special unicode.SpecialCase) (string, error) {
+	return mapCase(r, special, unicode.UpperCase)
+}
+
+// ToLower returns the entire text of r mapped to lower case. When special is
+// not nil, its mappings take precedence. Mappings to more than one rune are
+// honoured and a capital sigma at the end of a word becomes a final sigma
+//
+// ToLower was added by go-corelibs
+func ToLower(r RuneReader, special unicode.SpecialCase) (string, error) {
+	return mapCase(r, special, unicode.LowerCase)
+}
+
+// ToTitle returns the entire text of r with every rune mapped to title case,
+// like unicode.ToTitle. When special is not nil, its mappings take
+// precedence. Mappings to more than one rune are honoured, so "ß" becomes "Ss"
+//
+// ToTitle was added by go-corelibs
+func ToTitle(r RuneReader, special unicode.SpecialCase) (string, error) {
+	return mapCase(r, special, unicode.TitleCase)
+}
+
+func mapCase(r RuneReader, special unicode.SpecialCase, _case int) (mapped string, err error) {
+	var text string
+	if text, _, err = readText(r); err != nil {
+		return "", err
+	}
+
+	var multi map[rune]string
+	switch _case {
+	case unicode.UpperCase:
+		multi = specialUpper
+	case unicode.LowerCase:
+		multi = specialLower
+	default:
+		multi = specialTitle
+	}
+
+	var buf strings.Builder
+	buf.Grow(len(text))
+	for idx, ch := range text {
+		switch {
+		case special != nil && specialCovers(special, ch):
+			buf.WriteRune(mapSpecial(special, _case, ch))
+		case multi[ch] != "":
+			buf.WriteString(multi[ch])
+		case ch == 'Σ' && _case == unicode.LowerCase && isFinalSigma(text, idx):
+			buf.WriteRune('ς')
+		default:
+			buf.WriteRune(unicode.To(_case, ch))
+		}
+	}
+	return buf.String(), nil
+}
+
+func mapSpecial(special unicode.SpecialCase, _case int, ch rune) rune {
+	switch _case {
+	case unicode.UpperCase:
+		return special.ToUpper(ch)
+	case unicode.LowerCase:
+		return special.ToLower(ch)
+	}
+	return special.ToTitle(ch)
+}
+
+// specialCovers returns true if one of the special case ranges includes ch
+func specialCovers(special unicode.SpecialCase, ch rune) bool {
+	for _, cr := range special {
+		if cr.Lo <= uint32(ch) && uint32(ch) <= cr.Hi {
+			return true
+		}
+	}
+	return false
+}
+
+// isCased returns true if ch has the Unicode Cased property
+func isCased(ch rune) bool {
+	return unicode.In(ch, unicode.Lu, unicode.Ll, unicode.Lt, unicode.Other_Lowercase, unicode.Other_Uppercase)
+}
+
+// isCaseIgnorable returns true if ch has the Unicode Case_Ignorable property
+func isCaseIgnorable(ch rune) bool {
+	switch ch {
+	case '\'', '.', ':', '^', '`', '\u00A8', '\u00AD', '\u00AF', '\u00B4', '\u00B7',
+		'\u00B8', '\u0387', '\u055F', '\u05F4', '\u2018', '\u2019', '\u2024', '\u2027',
+		'\uFE13', '\uFE52', '\uFE55', '\uFF07', '\uFF0E', '\uFF1A':
+		return true
+	}
+	return unicode.In(ch, unicode.Mn, unicode.Me, unicode.Cf, unicode.Lm, unicode.Sk)
+}
+
+// isFinalSigma implements the Final_Sigma condition of SpecialCasing.txt for
+// the sigma at index idx of text: it is preceded by a cased letter and is not
+// followed by one, ignoring any case-ignorable runes in between
+func isFinalSigma(text string, idx int) bool {
+	before := false
+	for prev := text[:idx]; prev != ""; {
+		ch, width := utf8.DecodeLastRuneInString(prev)
+		if !isCaseIgnorable(ch) {
+			before = isCased(ch)
+			break
+		}
+		prev = prev[:len(prev)-width]
+	}
+	if !before {
+		return false
+	}
+	for _, ch := range text[idx+len("Σ"):] {
+		if !isCaseIgnorable(ch) {
+			return !isCased(ch)
+		}
+	}
+	return true
+}
@@ -0,0 +1,224 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes_test
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"testing"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/rangetable"
+
+	. "github.com/go-corelibs/runes"
+)
+
+func newCaseReaders(text string) []RuneReader {
+	return []RuneReader{
+		NewBytesReader([]byte(text)),
+		NewStringReader(text),
+		NewRunesReader([]rune(text)),
+	}
+}
+
+//gocyclo:ignore
+func TestCaseFoldingConformance(t *testing.T) {
+	fh, err := os.Open("testdata/CaseFolding.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fh.Close()
+
+	var count int
+	s := bufio.NewScanner(fh)
+	for line := 1; s.Scan(); line++ {
+		text := s.Text()
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+		fields := strings.Split(text, ";")
+		if len(fields) < 3 {
+			continue
+		}
+		status := strings.TrimSpace(fields[1])
+		if status != "C" && status != "F" {
+			continue
+		}
+		code := parseCodePoints(t, fields[0])
+		folded := parseCodePoints(t, fields[2])
+		if status == "C" && unicode.SimpleFold([]rune(code)[0]) == []rune(code)[0] {
+			// the case pair is not known to this version of the unicode package
+			continue
+		}
+		count++
+		upper := strings.ToUpper(folded)
+		for _, pair := range [][2]string{{code, folded}, {folded, code}, {code, upper}} {
+			for _, r := range newCaseReaders(pair[0]) {
+				if ok, err := EqualFold(r, NewStringReader(pair[1])); !ok || err != nil {
+					t.Errorf("line %d: EqualFold(%+q, %+q) got %v,%v; want true", line, pair[0], pair[1], ok, err)
+				}
+			}
+		}
+		haystack := "<" + code + ">"
+		for _, r := range newCaseReaders(haystack) {
+			want := int64(len(code))
+			if _, ok := r.(*Reader); ok {
+				want = int64(len([]rune(code)))
+			}
+			if index, size, err := IndexFold(r, folded+">"); index != 1 || size != want+1 || err != nil {
+				t.Errorf("line %d: IndexFold(%+q, %+q) got %d,%d,%v; want 1,%d", line, haystack, folded, index, size, err, want+1)
+			}
+		}
+	}
+	if err = s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if count < 1000 {
+		t.Errorf("only %d case foldings checked", count)
+	}
+}
+
+func TestEqualFold(t *testing.T) {
+	for _, tt := range []struct {
+		a, b string
+		want bool
+	}{
+		{"", "", true},
+		{"Go", "GO", true},
+		{"Go", "Goo", false},
+		{"straße", "STRASSE", true},
+		{"straße", "STRASE", false},
+		{"Kelvin", "kelvin", true},
+		{"Σσς", "σΣΣ", true},
+		{"ﬃ", "FFI", true},
+		{"İ", "i\u0307", true},
+		{"İ", "i", false},
+	} {
+		for _, r := range newCaseReaders(tt.a) {
+			if ok, err := EqualFold(r, NewRunesReader([]rune(tt.b))); ok != tt.want || err != nil {
+				t.Errorf("EqualFold(%+q, %+q) got %v,%v; want %v", tt.a, tt.b, ok, err, tt.want)
+			}
+		}
+	}
+}
+
+func TestHasPrefixFoldAt(t *testing.T) {
+	r := NewStringReader("Große STRASSE")
+	for _, tt := range []struct {
+		index  int64
+		prefix string
+		ok     bool
+		size   int64
+	}{
+		{0, "", true, 0},
+		{0, "gross", true, 5},
+		{0, "gros", false, 0},
+		{0, "GROSSE STRAßE", true, 14},
+		{7, "straße", true, 7},
+		{7, "strassen", false, 0},
+		{14, "", true, 0},
+		{15, "", true, 0},
+		{15, "x", false, 0},
+	} {
+		if ok, size, err := HasPrefixFoldAt(r, tt.index, tt.prefix); ok != tt.ok || size != tt.size || err != nil {
+			t.Errorf("HasPrefixFoldAt(%d, %+q) got %v,%d,%v; want %v,%d", tt.index, tt.prefix, ok, size, err, tt.ok, tt.size)
+		}
+	}
+	if _, _, err := HasPrefixFoldAt(r, -1, "x"); err == nil || err.Error() != "HasPrefixFoldAt: negative position" {
+		t.Errorf("HasPrefixFoldAt(-1) got %v; want negative position", err)
+	}
+}
+
+func TestIndexFold(t *testing.T) {
+	const text = "Die Große Straße"
+	for _, tt := range []struct {
+		substr      string
+		index, size int64
+		runes       [2]int64
+	}{
+		{"", 0, 0, [2]int64{0, 0}},
+		{"die", 0, 3, [2]int64{0, 3}},
+		{"STRASSE", 11, 7, [2]int64{10, 6}},
+		{"sse", 7, 3, [2]int64{7, 2}},
+		{"se", -1, 0, [2]int64{-1, 0}},
+		{"größe", -1, 0, [2]int64{-1, 0}},
+	} {
+		for _, r := range newCaseReaders(text) {
+			wantIndex, wantSize := tt.index, tt.size
+			if _, ok := r.(*Reader); ok {
+				wantIndex, wantSize = tt.runes[0], tt.runes[1]
+			}
+			if index, size, err := IndexFold(r, tt.substr); index != wantIndex || size != wantSize || err != nil {
+				t.Errorf("%T IndexFold(%+q) got %d,%d,%v; want %d,%d", r, tt.substr, index, size, err, wantIndex, wantSize)
+			}
+		}
+	}
+}
+
+func TestCaseMapping(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		fn      func(RuneReader, unicode.SpecialCase) (string, error)
+		special unicode.SpecialCase
+		input   string
+		want    string
+	}{
+		{"ToUpper", ToUpper, nil, "straße", "STRASSE"},
+		{"ToUpper", ToUpper, nil, "ﬃx", "FFIX"},
+		{"ToUpper", ToUpper, nil, "istanbul", "ISTANBUL"},
+		{"ToUpper", ToUpper, unicode.TurkishCase, "istanbul", "İSTANBUL"},
+		{"ToUpper", ToUpper, unicode.AzeriCase, "ıi", "Iİ"},
+		{"ToLower", ToLower, nil, "İSTANBUL", "i\u0307stanbul"},
+		{"ToLower", ToLower, unicode.TurkishCase, "İSTANBUL", "istanbul"},
+		{"ToLower", ToLower, unicode.TurkishCase, "DIYARBAKIR", "dıyarbakır"},
+		{"ToLower", ToLower, nil, "ΟΔΥΣΣΕΥΣ", "οδυσσευς"},
+		{"ToLower", ToLower, nil, "Σ", "σ"},
+		{"ToLower", ToLower, nil, "AΣ'. B", "aς'. b"},
+		{"ToLower", ToLower, nil, "AΣ'B", "aσ'b"},
+		{"ToTitle", ToTitle, nil, "straße", "STRASsE"},
+		{"ToTitle", ToTitle, nil, "ǆ", "ǅ"},
+		{"ToTitle", ToTitle, unicode.TurkishCase, "i", "İ"},
+	} {
+		for _, r := range newCaseReaders(tt.input) {
+			if got, err := tt.fn(r, tt.special); got != tt.want || err != nil {
+				t.Errorf("%T %s(%+q) got %+q,%v; want %+q", r, tt.name, tt.input, got, err, tt.want)
+			}
+		}
+	}
+}
+
+func TestCaseMappingRunes(t *testing.T) {
+	// compare the mapping of every rune known to both this package and
+	// golang.org/x/text/cases, which uses the same full case mappings
+	assigned := rangetable.Assigned(unicode.Version)
+	if assigned == nil {
+		assigned = rangetable.Assigned("15.0.0")
+	}
+	upper, lower := cases.Upper(language.Und), cases.Lower(language.Und)
+	rangetable.Visit(assigned, func(ch rune) {
+		text := string(ch)
+		for _, tt := range []struct {
+			name   string
+			fn     func(RuneReader, unicode.SpecialCase) (string, error)
+			caser  cases.Caser
+			simple rune
+		}{
+			{"ToUpper", ToUpper, upper, unicode.ToUpper(ch)},
+			{"ToLower", ToLower, lower, unicode.ToLower(ch)},
+		} {
+			want := tt.caser.String(text)
+			if !assignedIn(assigned, want) || (want == text && tt.simple != ch) {
+				// the mapping differs between versions of Unicode
+				return
+			}
+			if got, err := tt.fn(NewStringReader(text), nil); got != want || err != nil {
+				t.Errorf("%s(%+q) got %+q,%v; want %+q", tt.name, text, got, err, want)
+			}
+		}
+	})
+}
@@ -0,0 +1,6579 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+// Code generated by gen-confusable-tables.go; DO NOT EDIT.
+
+package runes
+
+// The following table contains the confusable prototypes of the Unicode 17.0.0
+// confusables.txt from UTS #39, Unicode Security Mechanisms
+
+// confusablePrototypes maps runes to the prototype they are confusable with
+var confusablePrototypes = map[rune]string{
+	0x0022:  "''",
+	0x0025:  "\u00BA/\u2080",
+	0x0030:  "O",
+	0x0031:  "l",
+	0x0049:  "l",
+	0x0060:  "'",
+	0x006D:  "rn",
+	0x007C:  "l",
+	0x00A0:  " ",
+	0x00A2:  "c\u0338",
+	0x00A5:  "Y\u0335",
+	0x00AF:  "\u02C9",
+	0x00B4:  "'",
+	0x00B5:  "\u03BC",
+	0x00B8:  ",",
+	0x00C6:  "AE",
+	0x00C7:  "C\u0326",
+	0x00D0:  "D\u0335",
+	0x00D7:  "x",
+	0x00D8:  "O\u0338",
+	0x00E6:  "ae",
+	0x00E7:  "c\u0326",
+	0x00F0:  "\u2202\u0335",
+	0x00F6:  "\u0629",
+	0x00F8:  "o\u0338",
+	0x00FE:  "p",
+	0x0110:  "D\u0335",
+	0x0111:  "d\u0335",
+	0x011A:  "\u0114",
+	0x011B:  "\u0115",
+	0x0126:  "H\u0335",
+	0x0127:  "h\u0335",
+	0x0131:  "i",
+	0x0132:  "lJ",
+	0x0133:  "ij",
+	0x013F:  "l\u00B7",
+	0x0140:  "l\u00B7",
+	0x0141:  "L\u0338",
+	0x0142:  "l\u0338",
+	0x0146:  "\u0272",
+	0x0149:  "'n",
+	0x014B:  "n\u0329",
+	0x0150:  "\u00D6",
+	0x0152:  "OE",
+	0x0153:  "oe",
+	0x0163:  "\u01AB",
+	0x0166:  "T\u0335",
+	0x0167:  "t\u0335",
+	0x017F:  "f",
+	0x0180:  "b\u0335",
+	0x0181:  "'B",
+	0x0182:  "b\u0304",
+	0x0183:  "b\u0304",
+	0x0184:  "b",
+	0x0187:  "C'",
+	0x0189:  "D\u0335",
+	0x018A:  "'D",
+	0x018C:  "d\u0304",
+	0x018D:  "g",
+	0x0191:  "F\u0326",
+	0x0192:  "f",
+	0x0193:  "G'",
+	0x0196:  "l",
+	0x0197:  "l\u0335",
+	0x0198:  "K'",
+	0x0199:  "k\u0314",
+	0x019A:  "l\u0335",
+	0x019B:  "\u03BB\u0338",
+	0x019D:  "N\u0326",
+	0x019E:  "n\u0329",
+	0x019F:  "O\u0335",
+	0x01A0:  "O'",
+	0x01A1:  "o'",
+	0x01A4:  "'P",
+	0x01A5:  "p\u0314",
+	0x01A6:  "R",
+	0x01A7:  "2",
+	0x01AC:  "'T",
+	0x01AD:  "t\u0314",
+	0x01AE:  "T\u0328",
+	0x01B3:  "'Y",
+	0x01B4:  "y\u0314",
+	0x01B5:  "Z\u0335",
+	0x01B6:  "z\u0335",
+	0x01B7:  "3",
+	0x01BB:  "2\u0335",
+	0x01BC:  "5",
+	0x01BD:  "s",
+	0x01BF:  "p",
+	0x01C0:  "l",
+	0x01C1:  "ll",
+	0x01C3:  "!",
+	0x01C4:  "D\u017D",
+	0x01C5:  "D\u017E",
+	0x01C6:  "d\u017E",
+	0x01C7:  "LJ",
+	0x01C8:  "Lj",
+	0x01C9:  "lj",
+	0x01CA:  "NJ",
+	0x01CB:  "Nj",
+	0x01CC:  "nj",
+	0x01CD:  "\u0102",
+	0x01CE:  "\u0103",
+	0x01CF:  "\u012C",
+	0x01D0:  "\u012D",
+	0x01D1:  "\u014E",
+	0x01D2:  "\u014F",
+	0x01D3:  "\u016C",
+	0x01D4:  "\u016D",
+	0x01E4:  "G\u0335",
+	0x01E5:  "g\u0335",
+	0x01E6:  "\u011E",
+	0x01E7:  "\u011F",
+	0x01F1:  "DZ",
+	0x01F2:  "Dz",
+	0x01F3:  "dz",
+	0x01F5:  "\u0123",
+	0x01FE:  "O\u0338\u0301",
+	0x021A:  "\u0162",
+	0x021B:  "\u01AB",
+	0x021C:  "3",
+	0x0222:  "8",
+	0x0223:  "8",
+	0x0224:  "Z\u0326",
+	0x0225:  "z\u0326",
+	0x0226:  "\u00C5",
+	0x0227:  "\u00E5",
+	0x023C:  "c\u0338",
+	0x023E:  "T\u0338",
+	0x0241:  "?",
+	0x0244:  "U\u0335",
+	0x0246:  "E\u0338",
+	0x0247:  "e\u0338",
+	0x0248:  "J\u0335",
+	0x0249:  "j\u0335",
+	0x024D:  "r\u0335",
+	0x024E:  "Y\u0335",
+	0x024F:  "y\u0335",
+	0x0251:  "a",
+	0x0253:  "b\u0314",
+	0x0256:  "d\u0328",
+	0x0257:  "d\u0314",
+	0x0259:  "\u01DD",
+	0x025A:  "\u01DD\u02DE",
+	0x025B:  "\uA793",
+	0x0260:  "g\u0314",
+	0x0261:  "g",
+	0x0263:  "y",
+	0x0266:  "h\u0314",
+	0x0268:  "i\u0335",
+	0x0269:  "i",
+	0x026A:  "i",
+	0x026B:  "l\u0334",
+	0x026D:  "l\u0328",
+	0x026E:  "l\u021D",
+	0x026F:  "w",
+	0x0271:  "rn\u0326",
+	0x0273:  "n\u0328",
+	0x0275:  "o\u0335",
+	0x0276:  "o\u1D07",
+	0x027C:  "r\u0329",
+	0x027D:  "r\u0328",
+	0x0282:  "s\u0328",
+	0x028B:  "u",
+	0x028F:  "y",
+	0x0290:  "z\u0328",
+	0x0292:  "\u021D",
+	0x0294:  "?",
+	0x0295:  "\uA7CE",
+	0x02A0:  "q\u0314",
+	0x02A3:  "dz",
+	0x02A4:  "d\u021D",
+	0x02A5:  "d\u0291",
+	0x02A6:  "ts",
+	0x02A7:  "t\u0283",
+	0x02A8:  "t\u0255",
+	0x02A9:  "fn\u0329",
+	0x02AA:  "ls",
+	0x02AB:  "lz",
+	0x02B3:  "\u18F4",
+	0x02B9:  "'",
+	0x02BA:  "''",
+	0x02BB:  "'",
+	0x02BC:  "'",
+	0x02BD:  "'",
+	0x02BE:  "'",
+	0x02BF:  "\u0559",
+	0x02C2:  "<",
+	0x02C3:  ">",
+	0x02C4:  "^",
+	0x02C6:  "^",
+	0x02C8:  "'",
+	0x02CA:  "'",
+	0x02CB:  "'",
+	0x02D0:  ":",
+	0x02D3:  "\u0559",
+	0x02D7:  "-",
+	0x02D8:  "\u02C7",
+	0x02D9:  "\u0971",
+	0x02DA:  "\u00B0",
+	0x02DB:  "i",
+	0x02DC:  "~",
+	0x02DD:  "''",
+	0x02E1:  "\u18F3",
+	0x02E2:  "\u18F5",
+	0x02E4:  "\u02C1",
+	0x02EE:  "''",
+	0x02F4:  "'",
+	0x02F6:  "''",
+	0x02F8:  ":",
+	0x02FB:  "\u02EA",
+	0x0305:  "\u0304",
+	0x030C:  "\u0306",
+	0x030D:  "\u0670",
+	0x0310:  "\u0306\u0307",
+	0x0311:  "\u0302",
+	0x0315:  "\u0313",
+	0x0317:  "\u0650",
+	0x031A:  "\u1AE9",
+	0x0320:  "\u0331",
+	0x0321:  "\u0326",
+	0x0322:  "\u0328",
+	0x0327:  "\u0326",
+	0x0336:  "\u0335",
+	0x0337:  "\u0338",
+	0x0339:  "\u0326",
+	0x0340:  "\u0300",
+	0x0341:  "\u0301",
+	0x0342:  "\u0303",
+	0x0343:  "\u0313",
+	0x0345:  "\u0328",
+	0x0347:  "\u0333",
+	0x0348:  "\U00010EFA",
+	0x0357:  "\u0350",
+	0x0358:  "\u0307",
+	0x0366:  "\u030A",
+	0x036E:  "\u0306",
+	0x0370:  "\u2C75",
+	0x0374:  "'",
+	0x0375:  "\u02CF",
+	0x0376:  "\u0418",
+	0x0377:  "\u1D0E",
+	0x037A:  "i",
+	0x037B:  "\u0254",
+	0x037D:  "\uA73F",
+	0x037E:  ";",
+	0x037F:  "J",
+	0x0384:  "'",
+	0x0387:  "\u00B7",
+	0x0391:  "A",
+	0x0392:  "B",
+	0x0395:  "E",
+	0x0396:  "Z",
+	0x0397:  "H",
+	0x0398:  "O\u0335",
+	0x0399:  "l",
+	0x039A:  "K",
+	0x039B:  "\u0245",
+	0x039C:  "M",
+	0x039D:  "N",
+	0x039F:  "O",
+	0x03A1:  "P",
+	0x03A3:  "\u01A9",
+	0x03A4:  "T",
+	0x03A5:  "Y",
+	0x03A7:  "X",
+	0x03B1:  "a",
+	0x03B2:  "\u00DF",
+	0x03B3:  "y",
+	0x03B4:  "\u1E9F",
+	0x03B5:  "\uA793",
+	0x03B7:  "n\u0329",
+	0x03B8:  "O\u0335",
+	0x03B9:  "i",
+	0x03BA:  "\u0138",
+	0x03BD:  "v",
+	0x03BF:  "o",
+	0x03C1:  "p",
+	0x03C3:  "o",
+	0x03C4:  "\u1D1B",
+	0x03C5:  "u",
+	0x03C6:  "\u0278",
+	0x03D0:  "\u00DF",
+	0x03D1:  "O\u0335",
+	0x03D2:  "Y",
+	0x03D5:  "\u0278",
+	0x03D6:  "\u03C0",
+	0x03DB:  "\u03C2",
+	0x03DC:  "F",
+	0x03E4:  "\u0427",
+	0x03E5:  "\u0447",
+	0x03E8:  "2",
+	0x03E9:  "\u01A8",
+	0x03EC:  "6",
+	0x03ED:  "o",
+	0x03F0:  "\u0138",
+	0x03F1:  "p",
+	0x03F2:  "c",
+	0x03F3:  "j",
+	0x03F4:  "O\u0335",
+	0x03F5:  "\uA793",
+	0x03F7:  "\u00DE",
+	0x03F8:  "p",
+	0x03F9:  "C",
+	0x03FA:  "M",
+	0x03FD:  "\u0186",
+	0x03FF:  "\uA73E",
+	0x0404:  "\uA792",
+	0x0405:  "S",
+	0x0406:  "l",
+	0x0408:  "J",
+	0x0410:  "A",
+	0x0411:  "b\u0304",
+	0x0412:  "B",
+	0x0413:  "\u0393",
+	0x0415:  "E",
+	0x0417:  "3",
+	0x0419:  "\u040D",
+	0x041A:  "K",
+	0x041B:  "\u0245",
+	0x041C:  "M",
+	0x041D:  "H",
+	0x041E:  "O",
+	0x041F:  "\u03A0",
+	0x0420:  "P",
+	0x0421:  "C",
+	0x0422:  "T",
+	0x0423:  "Y",
+	0x0424:  "\u03A6",
+	0x0425:  "X",
+	0x042B:  "bl",
+	0x042C:  "b",
+	0x042E:  "lO",
+	0x0430:  "a",
+	0x0431:  "6",
+	0x0432:  "\u0299",
+	0x0433:  "r",
+	0x0435:  "e",
+	0x0437:  "\u025C",
+	0x0438:  "\u1D0E",
+	0x043A:  "\u0138",
+	0x043C:  "\u028D",
+	0x043D:  "\u029C",
+	0x043E:  "o",
+	0x043F:  "\u03C0",
+	0x0440:  "p",
+	0x0441:  "c",
+	0x0442:  "\u1D1B",
+	0x0443:  "y",
+	0x0444:  "\u0278",
+	0x0445:  "x",
+	0x0448:  "w",
+	0x044A:  "\u02C9b",
+	0x044B:  "\u0185i",
+	0x044C:  "\u0185",
+	0x044F:  "\u1D19",
+	0x0454:  "\uA793",
+	0x0455:  "s",
+	0x0456:  "i",
+	0x0458:  "j",
+	0x045B:  "h\u0335",
+	0x045D:  "\u0439",
+	0x045F:  "u\u0329",
+	0x0461:  "w",
+	0x0462:  "b\u0335",
+	0x0463:  "b\u0335",
+	0x0470:  "\u03A8",
+	0x0471:  "\u03C8",
+	0x0472:  "O\u0335",
+	0x0473:  "o\u0335",
+	0x0474:  "V",
+	0x0475:  "v",
+	0x047C:  "\u0460\u0486\u0487",
+	0x047D:  "w\u0486\u0487",
+	0x048A:  "\u040D\u0326",
+	0x048B:  "\u0439\u0326",
+	0x048C:  "b\u0335",
+	0x048D:  "b\u0335",
+	0x0490:  "\u0393'",
+	0x0491:  "r'",
+	0x0492:  "\u0393\u0335",
+	0x0493:  "r\u0335",
+	0x0496:  "\u0416\u0329",
+	0x0497:  "\u0436\u0329",
+	0x0498:  "3\u0326",
+	0x0499:  "\u025C\u0326",
+	0x049A:  "K\u0329",
+	0x049B:  "\u0138\u0329",
+	0x049E:  "K\u0335",
+	0x049F:  "\u0138\u0335",
+	0x04A2:  "H\u0329",
+	0x04A3:  "\u029C\u0329",
+	0x04AA:  "C\u0326",
+	0x04AB:  "c\u0326",
+	0x04AC:  "T\u0329",
+	0x04AD:  "\u1D1B\u0329",
+	0x04AE:  "Y",
+	0x04AF:  "y",
+	0x04B0:  "Y\u0335",
+	0x04B1:  "y\u0335",
+	0x04B2:  "X\u0329",
+	0x04BB:  "h",
+	0x04BD:  "e",
+	0x04BE:  "\u04BC\u0328",
+	0x04BF:  "e\u0328",
+	0x04C0:  "l",
+	0x04C5:  "\u0245\u0326",
+	0x04C6:  "\u043B\u0326",
+	0x04C7:  "H\u0326",
+	0x04C8:  "\u029C\u0326",
+	0x04C9:  "H\u0326",
+	0x04CA:  "\u029C\u0326",
+	0x04CB:  "\u04B6",
+	0x04CC:  "\u04B7",
+	0x04CD:  "M\u0326",
+	0x04CE:  "\u028D\u0326",
+	0x04CF:  "l",
+	0x04D4:  "AE",
+	0x04D5:  "ae",
+	0x04D8:  "\u018F",
+	0x04D9:  "\u01DD",
+	0x04E0:  "3",
+	0x04E1:  "\u021D",
+	0x04E8:  "O\u0335",
+	0x04E9:  "o\u0335",
+	0x0501:  "d",
+	0x050A:  "\u01F6",
+	0x050C:  "G",
+	0x050D:  "\u0262",
+	0x0510:  "\u0190",
+	0x0511:  "\uA793",
+	0x051B:  "q",
+	0x051C:  "W",
+	0x051D:  "w",
+	0x053B:  "\u12AE",
+	0x0544:  "\u1206",
+	0x054A:  "\u1323",
+	0x054C:  "\u1261",
+	0x054D:  "U",
+	0x054F:  "S",
+	0x0553:  "\u03A6",
+	0x0555:  "O",
+	0x055A:  "'",
+	0x055D:  "'",
+	0x0561:  "w",
+	0x0563:  "q",
+	0x0566:  "q",
+	0x056E:  "\u1E9F",
+	0x0570:  "h",
+	0x0572:  "n\u0329",
+	0x0575:  "\u0237",
+	0x0578:  "n",
+	0x057A:  "\u0270",
+	0x057C:  "n",
+	0x057D:  "u",
+	0x0581:  "g",
+	0x0582:  "i",
+	0x0584:  "f",
+	0x0585:  "o",
+	0x0587:  "\u0565i",
+	0x0589:  ":",
+	0x059C:  "\u0301",
+	0x059D:  "\u0301",
+	0x05A4:  "\u059A",
+	0x05A8:  "\u0599",
+	0x05AD:  "\u0596",
+	0x05AE:  "\u0598",
+	0x05AF:  "\u030A",
+	0x05B4:  "\u0323",
+	0x05B9:  "\u0307",
+	0x05BA:  "\u0307",
+	0x05C0:  "l",
+	0x05C1:  "\u0307",
+	0x05C2:  "\u0307",
+	0x05C3:  ":",
+	0x05C4:  "\u0307",
+	0x05C5:  "\u0323",
+	0x05D5:  "l",
+	0x05D8:  "v",
+	0x05D9:  "'",
+	0x05DF:  "l",
+	0x05E1:  "o",
+	0x05F0:  "ll",
+	0x05F1:  "l'",
+	0x05F2:  "''",
+	0x05F3:  "'",
+	0x05F4:  "''",
+	0x0609:  "\u00BA/\u2080\u2080",
+	0x060A:  "\u00BA/\u2080\u2080\u2080",
+	0x060D:  ",",
+	0x060F:  "\u0639",
+	0x0618:  "\u0301",
+	0x0619:  "\u0313",
+	0x061A:  "\u0650",
+	0x0623:  "l\u0674",
+	0x0624:  "\u0648\u0674",
+	0x0625:  "l\u0655",
+	0x0626:  "\u0649\u0674",
+	0x0627:  "l",
+	0x062B:  "\u0649\u06DB",
+	0x0634:  "\u0633\u06DB",
+	0x063D:  "\u0649\u0302",
+	0x063F:  "\u0649\u06DB",
+	0x0647:  "o",
+	0x064A:  "\u0649",
+	0x064B:  "\u030B",
+	0x064E:  "\u0301",
+	0x064F:  "\u0313",
+	0x0652:  "\u030A",
+	0x0653:  "\u0303",
+	0x0656:  "\u0329",
+	0x0657:  "\u0312",
+	0x0658:  "\u0306",
+	0x0659:  "\u0304",
+	0x065A:  "\u0306",
+	0x065B:  "\u0302",
+	0x065C:  "\u0323",
+	0x065D:  "\u0314",
+	0x065F:  "\u0655",
+	0x0660:  ".",
+	0x0661:  "l",
+	0x0665:  "o",
+	0x0667:  "V",
+	0x0668:  "\u0245",
+	0x066A:  "\u00BA/\u2080",
+	0x066B:  ",",
+	0x066C:  "\u060C",
+	0x066D:  "*",
+	0x066E:  "\u0649",
+	0x066F:  "\u06A1",
+	0x0672:  "l\u0674",
+	0x0673:  "l\u0655",
+	0x0675:  "l\u0674",
+	0x0676:  "\u0648\u0674",
+	0x0677:  "\u0648\u0313\u0674",
+	0x0678:  "\u0649\u0674",
+	0x0679:  "\u0649\u0615",
+	0x067A:  "\u062A",
+	0x067E:  "\u0649\u06DB",
+	0x0681:  "\u062D\u0654",
+	0x0685:  "\u062D\u06DB",
+	0x0688:  "\u062F\u0615",
+	0x068B:  "\u068A\u0615",
+	0x068E:  "\u062F\u06DB",
+	0x068F:  "\u062F\u06DB",
+	0x0691:  "\u0631\u0615",
+	0x0692:  "\u0631\u0306",
+	0x0698:  "\u0631\u06DB",
+	0x069E:  "\u0635\u06DB",
+	0x069F:  "\u0637\u06DB",
+	0x06A4:  "\u06A1\u06DB",
+	0x06A7:  "\u0641",
+	0x06A8:  "\u06A1\u06DB",
+	0x06A9:  "\u0643",
+	0x06AA:  "\u0643",
+	0x06AD:  "\u0643\u06DB",
+	0x06B4:  "\u06AF\u06DB",
+	0x06B5:  "\u0644\u0306",
+	0x06B7:  "\u0644\u06DB",
+	0x06BA:  "\u0649",
+	0x06BB:  "\u0649\u0615",
+	0x06BD:  "\u0649\u06DB",
+	0x06BE:  "o",
+	0x06C1:  "o",
+	0x06C2:  "\u06C0",
+	0x06C3:  "\u0629",
+	0x06C6:  "\u0648\u0306",
+	0x06C7:  "\u0648\u0313",
+	0x06C8:  "\u0648\u0670",
+	0x06C9:  "\u0648\u0302",
+	0x06CB:  "\u0648\u06DB",
+	0x06CC:  "\u0649",
+	0x06CE:  "\u0649\u0306",
+	0x06D0:  "\u067B",
+	0x06D1:  "\u0649\u06DB",
+	0x06D2:  "\u0649",
+	0x06D4:  "-",
+	0x06D5:  "o",
+	0x06DF:  "\u030A",
+	0x06E8:  "\u0306\u0307",
+	0x06EC:  "\u0307",
+	0x06EE:  "\u062F\u0302",
+	0x06EF:  "\u0631\u0302",
+	0x06F0:  ".",
+	0x06F1:  "l",
+	0x06F2:  "\u0662",
+	0x06F3:  "\u0663",
+	0x06F4:  "\u0664",
+	0x06F5:  "o",
+	0x06F6:  "\u0666",
+	0x06F7:  "V",
+	0x06F8:  "\u0245",
+	0x06F9:  "\u0669",
+	0x06FD:  "\u0621\U00010EFA",
+	0x06FE:  "\u0645\U00010EFA",
+	0x06FF:  "o\u0302",
+	0x0701:  ".",
+	0x0702:  ".",
+	0x0703:  ":",
+	0x0704:  ":",
+	0x0740:  "\u0307",
+	0x0741:  "\u0307",
+	0x0742:  "\u073C",
+	0x0747:  "\u0301",
+	0x0751:  "\u0628\u06DB",
+	0x0752:  "\u0649\u06DB",
+	0x0756:  "\u0649\u0306",
+	0x0762:  "\u06AC",
+	0x0763:  "\u0643\u06DB",
+	0x0767:  "\u0754",
+	0x0768:  "\u0646\u0615",
+	0x0769:  "\u0646\u0306",
+	0x076C:  "\u0631\u0654",
+	0x0771:  "\u0697\u0615",
+	0x0772:  "\u062D\u0654",
+	0x077E:  "\u0633\u0302",
+	0x07C0:  "O",
+	0x07CA:  "l",
+	0x07EB:  "\u0304",
+	0x07ED:  "\u0307",
+	0x07EE:  "\u0302",
+	0x07F3:  "\u0308",
+	0x07F4:  "'",
+	0x07F5:  "'",
+	0x07FA:  "_",
+	0x088F:  "\u0649\u030A",
+	0x08A1:  "\u0628\u0654",
+	0x08A4:  "\u06A2\u06DB",
+	0x08A7:  "\u0645\u06DB",
+	0x08A8:  "\u0649\u0654",
+	0x08A9:  "\u0754",
+	0x08AE:  "\u062F\u0324\u0323",
+	0x08AF:  "\u0635\u0324\u0323",
+	0x08B0:  "\u06AF",
+	0x08B1:  "\u0648",
+	0x08B2:  "\u0632\u0302",
+	0x08B6:  "\u0628\u06E2",
+	0x08B7:  "\u0649\u06DB\u06E2",
+	0x08B9:  "\u0631\u0306\u0307",
+	0x08BA:  "\u0649\u0306\u0307",
+	0x08BB:  "\u06A1",
+	0x08BC:  "\u06A1",
+	0x08BD:  "\u0649",
+	0x08BE:  "\u0649\u06DB\u0306",
+	0x08BF:  "\u062A\u0306",
+	0x08C0:  "\u0649\u0615\u0306",
+	0x08C1:  "\u0686\u0306",
+	0x08C2:  "\u0643\u0306",
+	0x08E5:  "\u064C",
+	0x08E8:  "\u064C",
+	0x08EA:  "\u0307",
+	0x08EB:  "\u0308",
+	0x08ED:  "\u0323",
+	0x08EE:  "\u0324",
+	0x08F0:  "\u030B",
+	0x08F1:  "\u064C",
+	0x08F2:  "\u064D",
+	0x08F3:  "\u0313",
+	0x08F8:  "\u0350",
+	0x08F9:  "\u0354",
+	0x08FA:  "\u0355",
+	0x08FF:  "\u0350",
+	0x0900:  "\u0352",
+	0x0901:  "\u0306\u0307",
+	0x0902:  "\u0307",
+	0x0903:  ":",
+	0x0904:  "\u0905\u0946",
+	0x0906:  "\u0905\u093E",
+	0x0908:  "\u0930\u094D\u0907",
+	0x090D:  "\u090F\u0306",
+	0x090E:  "\u090F\u0946",
+	0x0910:  "\u090F\U00011B64",
+	0x0911:  "\u0905\u093E\u0306",
+	0x0912:  "\u0905\u093E\u0946",
+	0x0913:  "\u0905\u093E\U00011B64",
+	0x0914:  "\u0905\u093E\u0948",
+	0x093B:  "\u093E\u093A",
+	0x093C:  "\u0323",
+	0x093F:  "\u09BF",
+	0x0945:  "\u0306",
+	0x0947:  "\U00011B64",
+	0x0949:  "\u093E\u0306",
+	0x0952:  "\u0331",
+	0x0953:  "\u0300",
+	0x0954:  "\u0301",
+	0x0956:  "\U00011B62",
+	0x0957:  "\U00011B63",
+	0x0965:  "\u0964\u0964",
+	0x0966:  "o",
+	0x0967:  "\u0669",
+	0x0969:  "3",
+	0x0972:  "\u0905\u0306",
+	0x0973:  "\u0905\u093A",
+	0x0974:  "\u0905\u093E\u093A",
+	0x0975:  "\u0905\u094F",
+	0x097D:  "?",
+	0x0981:  "\u0306\u0307",
+	0x0986:  "\u0985\u09BE",
+	0x09BC:  "\u0323",
+	0x09E0:  "\u098B\u09C3",
+	0x09E1:  "\u098B\u09C3",
+	0x09E6:  "o",
+	0x09EA:  "8",
+	0x09ED:  "9",
+	0x09F0:  "\u09B0",
+	0x0A02:  "\u0307",
+	0x0A03:  "\u0983",
+	0x0A06:  "\u0A05\u0A3E",
+	0x0A07:  "\u092A\u094D\u091F\u09BF",
+	0x0A08:  "\u092A\u094D\u091F\u0A40",
+	0x0A09:  "\u0A73\U00011B62",
+	0x0A0A:  "\u0A73\U00011B63",
+	0x0A0F:  "\u092A\u094D\u091F\U00011B64",
+	0x0A10:  "\u0A05\u0948",
+	0x0A14:  "\u0A05\u0A4C",
+	0x0A15:  "\u0935",
+	0x0A1C:  "\u0924\u094D\u0924",
+	0x0A1F:  "\u091F",
+	0x0A20:  "\u0920",
+	0x0A24:  "\u0909",
+	0x0A27:  "\u092A",
+	0x0A2B:  "\u0922",
+	0x0A2E:  "\u092D",
+	0x0A35:  "\u0939",
+	0x0A38:  "\u092E",
+	0x0A3C:  "\u0323",
+	0x0A3F:  "\u09BF",
+	0x0A41:  "\U00011B62",
+	0x0A42:  "\U00011B63",
+	0x0A47:  "\U00011B64",
+	0x0A48:  "\u0948",
+	0x0A4B:  "\u0946",
+	0x0A4D:  "\u094D",
+	0x0A66:  "o",
+	0x0A67:  "9",
+	0x0A6A:  "8",
+	0x0A72:  "\u092A\u094D\u091F",
+	0x0A81:  "\u0306\u0307",
+	0x0A82:  "\u0307",
+	0x0A83:  ":",
+	0x0A86:  "\u0A85\u0ABE",
+	0x0A8D:  "\u0A85\u0AC5",
+	0x0A8F:  "\u0A85\u0AC7",
+	0x0A90:  "\u0A85\u0AC8",
+	0x0A91:  "\u0A85\u0ABE\u0AC5",
+	0x0A93:  "\u0A85\u0ABE\u0AC7",
+	0x0A94:  "\u0A85\u0ABE\u0AC8",
+	0x0AAA:  "\u0447",
+	0x0AB0:  "\u0968",
+	0x0ABC:  "\u0323",
+	0x0ABD:  "\u093D",
+	0x0AC1:  "\u0941",
+	0x0AC2:  "\u0942",
+	0x0ACD:  "\u094D",
+	0x0AE6:  "o",
+	0x0AE8:  "\u0968",
+	0x0AE9:  "3",
+	0x0AEA:  "\u096A",
+	0x0AEB:  "\u0447",
+	0x0AEE:  "\u096E",
+	0x0AF0:  "\u0970",
+	0x0B01:  "\u0306\u0307",
+	0x0B03:  "8",
+	0x0B06:  "\u0B05\u0B3E",
+	0x0B20:  "O",
+	0x0B3C:  "\u0323",
+	0x0B66:  "o",
+	0x0B68:  "9",
+	0x0B82:  "\u030A",
+	0x0B8A:  "\u0B89\u0BB3",
+	0x0B94:  "\u0B92\u0BB3",
+	0x0B9C:  "\u0B90",
+	0x0BB0:  "\u0B88",
+	0x0BB8:  "\u0BB6",
+	0x0BBE:  "\u0B88",
+	0x0BC8:  "\u0BA9",
+	0x0BCA:  "\u0BC6\u0B88",
+	0x0BCB:  "\u0BC7\u0B88",
+	0x0BCC:  "\u0BC6\u0BB3",
+	0x0BCD:  "\u0307",
+	0x0BD7:  "\u0BB3",
+	0x0BE6:  "o",
+	0x0BE7:  "\u0B95",
+	0x0BE8:  "\u0B89",
+	0x0BEA:  "\u0B9A",
+	0x0BEB:  "\u0B88\u0BC1",
+	0x0BEC:  "\u0B9A\u0BC1",
+	0x0BED:  "\u0B8E",
+	0x0BEE:  "\u0B85",
+	0x0BF0:  "\u0BAF",
+	0x0BF2:  "\u0B9A\u0BC2",
+	0x0BF4:  "\u0BAE\u0BC0",
+	0x0BF5:  "\u0BF3",
+	0x0BF7:  "\u0B8E\u0BB5",
+	0x0BF8:  "\u0BB7",
+	0x0BFA:  "\u0BA8\u0BC0",
+	0x0C00:  "\u0306\u0307",
+	0x0C02:  "o",
+	0x0C03:  "\u0983",
+	0x0C13:  "\u0C12\u0C55",
+	0x0C14:  "\u0C12\u0C4C",
+	0x0C16:  "\u0C96\u0323",
+	0x0C20:  "\u0C30\u05BC",
+	0x0C22:  "\u0C21\u0323",
+	0x0C25:  "\u0C27\u05BC",
+	0x0C2D:  "\u0C2C\u0323",
+	0x0C2E:  "\u0C35\u0C41",
+	0x0C37:  "\u0C35\u0323",
+	0x0C39:  "\u0C35\u0C3E",
+	0x0C42:  "\u0C41\u0C3E",
+	0x0C44:  "\u0C43\u0C3E",
+	0x0C60:  "\u0C0B\u0C3E",
+	0x0C61:  "\u0C0C\u0C3E",
+	0x0C66:  "o",
+	0x0C81:  "\u0306\u0307",
+	0x0C82:  "o",
+	0x0C83:  "\u0983",
+	0x0C85:  "\u0C05",
+	0x0C86:  "\u0C06",
+	0x0C87:  "\u0C07",
+	0x0C90:  "\u0C10",
+	0x0C92:  "\u0C12",
+	0x0C93:  "\u0C12\u0C55",
+	0x0C94:  "\u0C12\u0C4C",
+	0x0C97:  "\u0C17",
+	0x0C9C:  "\u0C1C",
+	0x0C9D:  "\u0C1D",
+	0x0C9E:  "\u0C1E",
+	0x0C9F:  "\u0C1F",
+	0x0CA3:  "\u0C23",
+	0x0CA6:  "\u0C26",
+	0x0CA8:  "\u0C28",
+	0x0CAF:  "\u0C2F",
+	0x0CB0:  "\u0C30",
+	0x0CB1:  "\u0C31",
+	0x0CB2:  "\u0C32",
+	0x0CB3:  "\u0C33",
+	0x0CBF:  "\u0C3F",
+	0x0CC1:  "\u0C41",
+	0x0CC3:  "\u0C43",
+	0x0CDC:  "\u0C5C",
+	0x0CE1:  "\u0C8C\u0CBE",
+	0x0CE6:  "O",
+	0x0CE7:  "\u0C67",
+	0x0CE8:  "\u0C68",
+	0x0CEF:  "\u0C6F",
+	0x0D01:  "\u0306\u0307",
+	0x0D02:  "o",
+	0x0D03:  "\u0983",
+	0x0D08:  "\u0D07\u0D57",
+	0x0D09:  "\u0B89",
+	0x0D0A:  "\u0B89\u0D57",
+	0x0D0C:  "\u0D28\u0D41",
+	0x0D10:  "\u0BC6\u0D0E",
+	0x0D13:  "\u0D12\u0D3E",
+	0x0D14:  "\u0D12\u0D57",
+	0x0D16:  "\u0BB5",
+	0x0D19:  "\u0D28\u0D41",
+	0x0D1C:  "\u0B90",
+	0x0D1F:  "s",
+	0x0D20:  "o",
+	0x0D23:  "\u0BA3",
+	0x0D25:  "\u0BAE",
+	0x0D31:  "\u0D30",
+	0x0D34:  "\u0BB4",
+	0x0D36:  "\u0BB6",
+	0x0D3A:  "\u0B9F\u0BBF",
+	0x0D3F:  "\u0BBF",
+	0x0D40:  "\u0BBF",
+	0x0D42:  "\u0D41",
+	0x0D43:  "\u0D41",
+	0x0D46:  "\u0BC6",
+	0x0D47:  "\u0BC7",
+	0x0D48:  "\u0BC6\u0BC6",
+	0x0D4E:  "\u0971",
+	0x0D5A:  "\u0D28\u0D4D\u0D2E",
+	0x0D5F:  "o\u0D30o",
+	0x0D61:  "\u0D1E",
+	0x0D66:  "o",
+	0x0D6A:  "\u0D30\u0D4D",
+	0x0D6B:  "\u0D26\u0D4D\u0D30",
+	0x0D6C:  "\u0D28\u0D4D\u0D28",
+	0x0D6D:  "9",
+	0x0D6E:  "\u0D35\u0D4D\u0D30",
+	0x0D6F:  "\u0D28\u0D4D",
+	0x0D76:  "\u0D39\u0D4D\u0D2E",
+	0x0D79:  "\u0D28\u0D41",
+	0x0D7A:  "\u0BA3\u0D4D",
+	0x0D7B:  "\u0D28\u0D4D",
+	0x0D7C:  "\u0D30\u0D4D",
+	0x0D7D:  "\u0D32\u0D4D",
+	0x0D7E:  "\u0D33\u0D4D",
+	0x0D82:  "o",
+	0x0D83:  "\u0983",
+	0x0D8D:  "\u0DC3\u0DD8",
+	0x0D92:  "\u0D91\u0DCA",
+	0x0D93:  "\u0D91\u0DD9",
+	0x0DB5:  "\u0D91",
+	0x0DB6:  "\u0D9B",
+	0x0DB9:  "\u0D94",
+	0x0DC0:  "\u0DA0",
+	0x0DC4:  "\u0DB7",
+	0x0DE9:  "\u0DE8\u0DCF",
+	0x0DEA:  "\u0DA2",
+	0x0DEB:  "\u0DAF",
+	0x0DEF:  "\u0DE8\u0DD3",
+	0x0E03:  "\u0E02",
+	0x0E0B:  "\u0E0A",
+	0x0E0F:  "\u0E0E",
+	0x0E14:  "\u0E04",
+	0x0E15:  "\u0E04",
+	0x0E17:  "\u0E11",
+	0x0E21:  "\u0E06",
+	0x0E26:  "\u0E20",
+	0x0E33:  "\u030A\u0E32",
+	0x0E41:  "\u0E40\u0E40",
+	0x0E45:  "\u0E32",
+	0x0E4D:  "\u030A",
+	0x0E50:  "o",
+	0x0E88:  "\u0E08",
+	0x0E8D:  "\u0E22",
+	0x0E9A:  "\u0E1A",
+	0x0E9B:  "\u0E1B",
+	0x0E9D:  "\u0E1D",
+	0x0E9E:  "\u0E1E",
+	0x0E9F:  "\u0E1F",
+	0x0EB3:  "\u030A\u0EB2",
+	0x0EB8:  "\u0E38",
+	0x0EB9:  "\u0E39",
+	0x0EC8:  "\u0E48",
+	0x0EC9:  "\u0E49",
+	0x0ECA:  "\u0E4A",
+	0x0ECB:  "\u0E4B",
+	0x0ECD:  "\u030A",
+	0x0ED0:  "o",
+	0x0EDC:  "\u0EAB\u0E99",
+	0x0EDD:  "\u0EAB\u0EA1",
+	0x0F00:  "\u0F68\u0F7C\u0F7E",
+	0x0F02:  "\u0F60\u0F74\u0F82\u0F7F",
+	0x0F03:  "\u0F60\u0F74\u0F82\u0F14",
+	0x0F0C:  "\u0F0B",
+	0x0F0E:  "\u0F0D\u0F0D",
+	0x0F1B:  "\u0F1A\u0F1A",
+	0x0F1E:  "\u0F1D\u0F1D",
+	0x0F1F:  "\u0F1A\u0F1D",
+	0x0F37:  "\u0325",
+	0x0F6A:  "\u0F62",
+	0x0F77:  "\u0FB2\u0F71\u0F80",
+	0x0F79:  "\u0FB3\u0F71\u0F80",
+	0x0F7B:  "\u0F7A\u0F7A",
+	0x0F7D:  "\u0F7C\u0F7C",
+	0x0FCE:  "\u0F1D\u0F1A",
+	0x0FD5:  "\u5350",
+	0x0FD6:  "\u534D",
+	0x1000:  "\u0D30\u102C",
+	0x1002:  "\u0D30",
+	0x1004:  "c",
+	0x1010:  "o\u102C",
+	0x101D:  "o",
+	0x101F:  "\u1015\u102C",
+	0x1023:  "\u0D30\u102C\u1039\u0D30\u102C",
+	0x1029:  "\u101E\u103C",
+	0x102A:  "\u101E\u103C\u0B47\u102C\u103A",
+	0x1031:  "\u0B47",
+	0x1036:  "\u030A",
+	0x1038:  "\u0983",
+	0x1040:  "o",
+	0x104B:  "\u104A\u104A",
+	0x105A:  "c",
+	0x1061:  "\u101B\u103E",
+	0x1065:  "\u1041",
+	0x1066:  "\u1015\u103E",
+	0x106F:  "\u1015\u102C\u103E",
+	0x1070:  "\u1003\u103E",
+	0x107E:  "\u107D\u103E",
+	0x1081:  "\u0D30\u103E",
+	0x109E:  "\u1083\u030A",
+	0x10A0:  "\uA786",
+	0x10D7:  "o\u102C",
+	0x10D8:  "\u0D30",
+	0x10E7:  "y",
+	0x10F3:  "\u021D",
+	0x10FF:  "o",
+	0x1101:  "\u1100\u1100",
+	0x1104:  "\u1103\u1103",
+	0x1108:  "\u1107\u1107",
+	0x110A:  "\u1109\u1109",
+	0x110D:  "\u110C\u110C",
+	0x1113:  "\u1102\u1100",
+	0x1114:  "\u1102\u1102",
+	0x1115:  "\u1102\u1103",
+	0x1116:  "\u1102\u1107",
+	0x1117:  "\u1103\u1100",
+	0x1118:  "\u1105\u1102",
+	0x1119:  "\u1105\u1105",
+	0x111A:  "\u1105\u1112",
+	0x111B:  "\u1105\u110B",
+	0x111C:  "\u1106\u1107",
+	0x111D:  "\u1106\u110B",
+	0x111E:  "\u1107\u1100",
+	0x111F:  "\u1107\u1102",
+	0x1120:  "\u1107\u1103",
+	0x1121:  "\u1107\u1109",
+	0x1122:  "\u1107\u1109\u1100",
+	0x1123:  "\u1107\u1109\u1103",
+	0x1124:  "\u1107\u1109\u1107",
+	0x1125:  "\u1107\u1109\u1109",
+	0x1126:  "\u1107\u1109\u110C",
+	0x1127:  "\u1107\u110C",
+	0x1128:  "\u1107\u110E",
+	0x1129:  "\u1107\u1110",
+	0x112A:  "\u1107\u1111",
+	0x112B:  "\u1107\u110B",
+	0x112C:  "\u1107\u1107\u110B",
+	0x112D:  "\u1109\u1100",
+	0x112E:  "\u1109\u1102",
+	0x112F:  "\u1109\u1103",
+	0x1130:  "\u1109\u1105",
+	0x1131:  "\u1109\u1106",
+	0x1132:  "\u1109\u1107",
+	0x1133:  "\u1109\u1107\u1100",
+	0x1134:  "\u1109\u1109\u1109",
+	0x1135:  "\u1109\u110B",
+	0x1136:  "\u1109\u110C",
+	0x1137:  "\u1109\u110E",
+	0x1138:  "\u1109\u110F",
+	0x1139:  "\u1109\u1110",
+	0x113A:  "\u1109\u1111",
+	0x113B:  "\u1105\u1112",
+	0x113D:  "\u113C\u113C",
+	0x113F:  "\u113E\u113E",
+	0x1141:  "\u110B\u1100",
+	0x1142:  "\u110B\u1103",
+	0x1143:  "\u110B\u1106",
+	0x1144:  "\u110B\u1107",
+	0x1145:  "\u110B\u1109",
+	0x1146:  "\u110B\u1140",
+	0x1147:  "\u110B\u110B",
+	0x1148:  "\u110B\u110C",
+	0x1149:  "\u110B\u110E",
+	0x114A:  "\u110B\u1110",
+	0x114B:  "\u110B\u1111",
+	0x114D:  "\u110C\u110B",
+	0x114F:  "\u114E\u114E",
+	0x1151:  "\u1150\u1150",
+	0x1152:  "\u110E\u110F",
+	0x1153:  "\u110E\u1112",
+	0x1156:  "\u1111\u1107",
+	0x1157:  "\u1111\u110B",
+	0x1158:  "\u1112\u1112",
+	0x115A:  "\u1100\u1103",
+	0x115B:  "\u1102\u1109",
+	0x115C:  "\u1102\u110C",
+	0x115D:  "\u1102\u1112",
+	0x115E:  "\u1103\u1105",
+	0x1162:  "\u1161\u4E28",
+	0x1164:  "\u1163\u4E28",
+	0x1166:  "\u1165\u4E28",
+	0x1168:  "\u1167\u4E28",
+	0x116A:  "\u1169\u1161",
+	0x116B:  "\u1169\u1161\u4E28",
+	0x116C:  "\u1169\u4E28",
+	0x116F:  "\u116E\u1165",
+	0x1170:  "\u116E\u1165\u4E28",
+	0x1171:  "\u116E\u4E28",
+	0x1173:  "\u30FC",
+	0x1174:  "\u30FC\u4E28",
+	0x1175:  "\u4E28",
+	0x1176:  "\u1161\u1169",
+	0x1177:  "\u1161\u116E",
+	0x1178:  "\u1163\u1169",
+	0x1179:  "\u1163\u116D",
+	0x117A:  "\u1165\u1169",
+	0x117B:  "\u1165\u116E",
+	0x117C:  "\u1165\u30FC",
+	0x117D:  "\u1167\u1169",
+	0x117E:  "\u1167\u116E",
+	0x117F:  "\u1169\u1165",
+	0x1180:  "\u1169\u1165\u4E28",
+	0x1181:  "\u1169\u1167\u4E28",
+	0x1182:  "\u1169\u1169",
+	0x1183:  "\u1169\u116E",
+	0x1184:  "\u116D\u1163",
+	0x1185:  "\u116D\u1163\u4E28",
+	0x1186:  "\u116D\u1163",
+	0x1187:  "\u116D\u1169",
+	0x1188:  "\u116D\u4E28",
+	0x1189:  "\u116E\u1161",
+	0x118A:  "\u116E\u1161\u4E28",
+	0x118B:  "\u116E\u1165\u30FC",
+	0x118C:  "\u116E\u1167\u4E28",
+	0x118D:  "\u116E\u116E",
+	0x118E:  "\u1172\u1161",
+	0x118F:  "\u1172\u1165",
+	0x1190:  "\u1172\u1165\u4E28",
+	0x1191:  "\u1172\u1167",
+	0x1192:  "\u1172\u1167\u4E28",
+	0x1193:  "\u1172\u116E",
+	0x1194:  "\u1172\u4E28",
+	0x1195:  "\u30FC\u116E",
+	0x1196:  "\u30FC\u30FC",
+	0x1197:  "\u30FC\u4E28\u116E",
+	0x1198:  "\u4E28\u1161",
+	0x1199:  "\u4E28\u1163",
+	0x119A:  "\u4E28\u1169",
+	0x119B:  "\u4E28\u116E",
+	0x119C:  "\u4E28\u30FC",
+	0x119D:  "\u4E28\u119E",
+	0x119F:  "\u119E\u1165",
+	0x11A0:  "\u119E\u116E",
+	0x11A1:  "\u119E\u4E28",
+	0x11A2:  "\u119E\u119E",
+	0x11A3:  "\u1161\u30FC",
+	0x11A4:  "\u1163\u116E",
+	0x11A5:  "\u1167\u1163",
+	0x11A6:  "\u1169\u1163",
+	0x11A7:  "\u1169\u1163\u4E28",
+	0x11A8:  "\u1100",
+	0x11A9:  "\u1100\u1100",
+	0x11AA:  "\u1100\u1109",
+	0x11AB:  "\u1102",
+	0x11AC:  "\u1102\u110C",
+	0x11AD:  "\u1102\u1112",
+	0x11AE:  "\u1103",
+	0x11AF:  "\u1105",
+	0x11B0:  "\u1105\u1100",
+	0x11B1:  "\u1105\u1106",
+	0x11B2:  "\u1105\u1107",
+	0x11B3:  "\u1105\u1109",
+	0x11B4:  "\u1105\u1110",
+	0x11B5:  "\u1105\u1111",
+	0x11B6:  "\u1105\u1112",
+	0x11B7:  "\u1106",
+	0x11B8:  "\u1107",
+	0x11B9:  "\u1107\u1109",
+	0x11BA:  "\u1109",
+	0x11BB:  "\u1109\u1109",
+	0x11BC:  "\u110B",
+	0x11BD:  "\u110C",
+	0x11BE:  "\u110E",
+	0x11BF:  "\u110F",
+	0x11C0:  "\u1110",
+	0x11C1:  "\u1111",
+	0x11C2:  "\u1112",
+	0x11C3:  "\u1100\u1105",
+	0x11C4:  "\u1100\u1109\u1100",
+	0x11C5:  "\u1102\u1100",
+	0x11C6:  "\u1102\u1103",
+	0x11C7:  "\u1102\u1109",
+	0x11C8:  "\u1102\u1140",
+	0x11C9:  "\u1102\u1110",
+	0x11CA:  "\u1103\u1100",
+	0x11CB:  "\u1103\u1105",
+	0x11CC:  "\u1105\u1100\u1109",
+	0x11CD:  "\u1105\u1102",
+	0x11CE:  "\u1105\u1103",
+	0x11CF:  "\u1105\u1103\u1112",
+	0x11D0:  "\u1105\u1105",
+	0x11D1:  "\u1105\u1106\u1100",
+	0x11D2:  "\u1105\u1106\u1109",
+	0x11D3:  "\u1105\u1107\u1109",
+	0x11D4:  "\u1105\u1107\u1112",
+	0x11D5:  "\u1105\u1107\u110B",
+	0x11D6:  "\u1105\u1109\u1109",
+	0x11D7:  "\u1105\u1140",
+	0x11D8:  "\u1105\u110F",
+	0x11D9:  "\u1105\u1159",
+	0x11DA:  "\u1106\u1100",
+	0x11DB:  "\u1106\u1105",
+	0x11DC:  "\u1106\u1107",
+	0x11DD:  "\u1106\u1109",
+	0x11DE:  "\u1106\u1109\u1109",
+	0x11DF:  "\u1106\u1140",
+	0x11E0:  "\u1106\u110E",
+	0x11E1:  "\u1106\u1112",
+	0x11E2:  "\u1106\u110B",
+	0x11E3:  "\u1107\u1105",
+	0x11E4:  "\u1107\u1111",
+	0x11E5:  "\u1107\u1112",
+	0x11E6:  "\u1107\u110B",
+	0x11E7:  "\u1109\u1100",
+	0x11E8:  "\u1109\u1103",
+	0x11E9:  "\u1109\u1105",
+	0x11EA:  "\u1109\u1107",
+	0x11EB:  "\u1140",
+	0x11EC:  "\u110B\u1100",
+	0x11ED:  "\u110B\u1100\u1100",
+	0x11EE:  "\u110B\u110B",
+	0x11EF:  "\u110B\u110F",
+	0x11F0:  "\u114C",
+	0x11F1:  "\u110B\u1109",
+	0x11F2:  "\u110B\u1140",
+	0x11F3:  "\u1111\u1107",
+	0x11F4:  "\u1111\u110B",
+	0x11F5:  "\u1112\u1102",
+	0x11F6:  "\u1112\u1105",
+	0x11F7:  "\u1112\u1106",
+	0x11F8:  "\u1112\u1107",
+	0x11F9:  "\u1159",
+	0x11FA:  "\u1100\u1102",
+	0x11FB:  "\u1100\u1107",
+	0x11FC:  "\u1100\u110E",
+	0x11FD:  "\u1100\u110F",
+	0x11FE:  "\u1100\u1112",
+	0x11FF:  "\u1102\u1102",
+	0x1200:  "U",
+	0x1223:  "\u0270",
+	0x1240:  "\u03A6",
+	0x1260:  "\u0548",
+	0x1294:  "\u0571",
+	0x12D0:  "O",
+	0x13A0:  "D",
+	0x13A1:  "R",
+	0x13A2:  "T",
+	0x13A4:  "O'",
+	0x13A5:  "i",
+	0x13A8:  "\u2C75",
+	0x13A9:  "Y",
+	0x13AA:  "A",
+	0x13AB:  "J",
+	0x13AC:  "E",
+	0x13AE:  "?",
+	0x13B0:  "\u2C75",
+	0x13B1:  "\u0393",
+	0x13B3:  "W",
+	0x13B7:  "M",
+	0x13BB:  "H",
+	0x13BD:  "Y",
+	0x13BE:  "O\u0335",
+	0x13BF:  "\u01AB",
+	0x13C0:  "G",
+	0x13C2:  "h",
+	0x13C3:  "Z",
+	0x13C7:  "\u0460",
+	0x13CB:  "\u0190",
+	0x13CC:  "U\u0335",
+	0x13CE:  "4",
+	0x13CF:  "b",
+	0x13D2:  "R",
+	0x13D4:  "W",
+	0x13D5:  "S",
+	0x13D9:  "V",
+	0x13DA:  "S",
+	0x13DE:  "L",
+	0x13DF:  "C",
+	0x13E2:  "P",
+	0x13E6:  "K",
+	0x13E7:  "d",
+	0x13EB:  "O\u0335",
+	0x13EE:  "6",
+	0x13F0:  "\u00DF",
+	0x13F2:  "h\u0314",
+	0x13F3:  "G",
+	0x13F4:  "B",
+	0x13FB:  "\u0262",
+	0x13FC:  "\u0299",
+	0x1400:  "=",
+	0x1403:  "\u0394",
+	0x140C:  "\u00B7\u1401",
+	0x140D:  "\u1401\u00B7",
+	0x140E:  "\u00B7\u0394",
+	0x140F:  "\u0394\u00B7",
+	0x1410:  "\u00B7\u1404",
+	0x1411:  "\u1404\u00B7",
+	0x1412:  "\u00B7\u1405",
+	0x1413:  "\u1405\u00B7",
+	0x1414:  "\u00B7\u1406",
+	0x1415:  "\u1406\u00B7",
+	0x1417:  "\u00B7\u140A",
+	0x1418:  "\u140A\u00B7",
+	0x1419:  "\u00B7\u140B",
+	0x141A:  "\u140B\u00B7",
+	0x1427:  "\u00B7",
+	0x142B:  "\u1401\u1420",
+	0x142C:  "\u0394\u1420",
+	0x142D:  "\u1405\u1420",
+	0x142E:  "\u140A\u1420",
+	0x142F:  "V",
+	0x1431:  "\u0245",
+	0x1433:  ">",
+	0x1437:  "\u00B7>",
+	0x1438:  "<",
+	0x143A:  "\u00B7V",
+	0x143B:  "V\u00B7",
+	0x143C:  "\u00B7\u0245",
+	0x143D:  "\u0245\u00B7",
+	0x143E:  "\u00B7\u1432",
+	0x143F:  "\u1432\u00B7",
+	0x1440:  "\u00B7>",
+	0x1441:  ">\u00B7",
+	0x1442:  "\u00B7\u1434",
+	0x1443:  "\u1434\u00B7",
+	0x1444:  "\u00B7<",
+	0x1445:  "<\u00B7",
+	0x1446:  "\u00B7\u1439",
+	0x1447:  "\u1439\u00B7",
+	0x144A:  "'",
+	0x144C:  "U",
+	0x144E:  "\u0548",
+	0x1454:  "\u00B7\u1450",
+	0x1457:  "\u00B7U",
+	0x1458:  "U\u00B7",
+	0x1459:  "\u00B7\u0548",
+	0x145A:  "\u0548\u00B7",
+	0x145B:  "\u00B7\u144F",
+	0x145C:  "\u144F\u00B7",
+	0x145D:  "\u00B7\u1450",
+	0x145E:  "\u1450\u00B7",
+	0x145F:  "\u00B7\u1451",
+	0x1460:  "\u1451\u00B7",
+	0x1461:  "\u00B7\u1455",
+	0x1462:  "\u1455\u00B7",
+	0x1463:  "\u00B7\u1456",
+	0x1464:  "\u1456\u00B7",
+	0x1467:  "U'",
+	0x1468:  "\u0548'",
+	0x1469:  "\u1450'",
+	0x146A:  "\u1455'",
+	0x146D:  "P",
+	0x146F:  "d",
+	0x1472:  "b",
+	0x1473:  "b\u0307",
+	0x1474:  "\u00B7\u146B",
+	0x1475:  "\u146B\u00B7",
+	0x1476:  "\u00B7P",
+	0x1477:  "p\u00B7",
+	0x1478:  "\u00B7\u146E",
+	0x1479:  "\u146E\u00B7",
+	0x147A:  "\u00B7d",
+	0x147B:  "d\u00B7",
+	0x147C:  "\u00B7\u1470",
+	0x147D:  "\u1470\u00B7",
+	0x147E:  "\u00B7b",
+	0x147F:  "b\u00B7",
+	0x1480:  "\u00B7b\u0307",
+	0x1481:  "b\u0307\u00B7",
+	0x1485:  "\u146B'",
+	0x1486:  "P'",
+	0x1487:  "d'",
+	0x1488:  "b'",
+	0x148D:  "J",
+	0x1492:  "\u00B7\u1489",
+	0x1493:  "\u1489\u00B7",
+	0x1494:  "\u00B7\u148B",
+	0x1495:  "\u148B\u00B7",
+	0x1496:  "\u00B7\u148C",
+	0x1497:  "\u148C\u00B7",
+	0x1498:  "\u00B7J",
+	0x1499:  "J\u00B7",
+	0x149A:  "\u00B7\u148E",
+	0x149B:  "\u148E\u00B7",
+	0x149C:  "\u00B7\u1490",
+	0x149D:  "\u1490\u00B7",
+	0x149E:  "\u00B7\u1491",
+	0x149F:  "\u1491\u00B7",
+	0x14A5:  "\u0393",
+	0x14AA:  "L",
+	0x14AC:  "\u00B7\u14A3",
+	0x14AD:  "\u14A3\u00B7",
+	0x14AE:  "\u00B7\u0393",
+	0x14AF:  "\u0393\u00B7",
+	0x14B0:  "\u00B7\u14A6",
+	0x14B1:  "\u14A6\u00B7",
+	0x14B2:  "\u00B7\u14A7",
+	0x14B3:  "\u14A7\u00B7",
+	0x14B4:  "\u00B7\u14A8",
+	0x14B5:  "\u14A8\u00B7",
+	0x14B6:  "\u00B7L",
+	0x14B7:  "l\u00B7",
+	0x14B8:  "\u00B7\u14AB",
+	0x14B9:  "\u14AB\u00B7",
+	0x14BF:  "2",
+	0x14C9:  "\u00B7\u14C0",
+	0x14CA:  "\u14C0\u00B7",
+	0x14CB:  "\u00B7\u14C7",
+	0x14CC:  "\u14C7\u00B7",
+	0x14CD:  "\u00B7\u14C8",
+	0x14CE:  "\u14C8\u00B7",
+	0x14D1:  "\u1421",
+	0x14DC:  "\u00B7\u14D3",
+	0x14DD:  "\u14D3\u00B7",
+	0x14DE:  "\u00B7\u14D5",
+	0x14DF:  "\u14D5\u00B7",
+	0x14E0:  "\u00B7\u14D6",
+	0x14E1:  "\u14D6\u00B7",
+	0x14E2:  "\u00B7\u14D7",
+	0x14E3:  "\u14D7\u00B7",
+	0x14E4:  "\u00B7\u14D8",
+	0x14E5:  "\u14D8\u00B7",
+	0x14E6:  "\u00B7\u14DA",
+	0x14E7:  "\u14DA\u00B7",
+	0x14E8:  "\u00B7\u14DB",
+	0x14E9:  "\u14DB\u00B7",
+	0x14F6:  "\u00B7\u14ED",
+	0x14F7:  "\u14ED\u00B7",
+	0x14F8:  "\u00B7\u14EF",
+	0x14F9:  "\u14EF\u00B7",
+	0x14FA:  "\u00B7\u14F0",
+	0x14FB:  "\u14F0\u00B7",
+	0x14FC:  "\u00B7\u14F1",
+	0x14FD:  "\u14F1\u00B7",
+	0x14FE:  "\u00B7\u14F2",
+	0x14FF:  "\u14F2\u00B7",
+	0x1500:  "\u00B7\u14F4",
+	0x1501:  "\u14F4\u00B7",
+	0x1502:  "\u00B7\u14F5",
+	0x1503:  "\u14F5\u00B7",
+	0x150C:  "\u150B<",
+	0x150D:  "\u150B\u1455",
+	0x150E:  "\u150Bb",
+	0x150F:  "\u150B\u1490",
+	0x1517:  "\u00B7\u1510",
+	0x1518:  "\u1510\u00B7",
+	0x1519:  "\u00B7\u1511",
+	0x151A:  "\u1511\u00B7",
+	0x151B:  "\u00B7\u1512",
+	0x151C:  "\u1512\u00B7",
+	0x151D:  "\u00B7\u1513",
+	0x151E:  "\u1513\u00B7",
+	0x151F:  "\u00B7\u1514",
+	0x1520:  "\u1514\u00B7",
+	0x1521:  "\u00B7\u1515",
+	0x1522:  "\u1515\u00B7",
+	0x1523:  "\u00B7\u1516",
+	0x1524:  "\u1516\u00B7",
+	0x152F:  "\u00B74",
+	0x1530:  "4\u00B7",
+	0x1531:  "\u00B7\u1528",
+	0x1532:  "\u1528\u00B7",
+	0x1533:  "\u00B7\u1529",
+	0x1534:  "\u1529\u00B7",
+	0x1535:  "\u00B7\u152A",
+	0x1536:  "\u152A\u00B7",
+	0x1537:  "\u00B7\u152B",
+	0x1538:  "\u152B\u00B7",
+	0x1539:  "\u00B7\u152D",
+	0x153A:  "\u152D\u00B7",
+	0x153B:  "\u00B7\u152E",
+	0x153C:  "\u152E\u00B7",
+	0x1540:  "\u1429",
+	0x1541:  "x",
+	0x154E:  "\u00B7\u154C",
+	0x154F:  "\u154C\u00B7",
+	0x155B:  "\u00B7\u155A",
+	0x155C:  "\u155A\u00B7",
+	0x1568:  "\u00B7\u1567",
+	0x1569:  "\u1567\u00B7",
+	0x1577:  "\u1E9F",
+	0x157C:  "H",
+	0x157D:  "x",
+	0x157E:  "\u1550\u146C",
+	0x157F:  "\u1550P",
+	0x1580:  "\u1550\u146E",
+	0x1581:  "\u1550d",
+	0x1582:  "\u1550\u1470",
+	0x1583:  "\u1550b",
+	0x1584:  "\u1550b\u0307",
+	0x1585:  "\u1550\u1483",
+	0x1587:  "R",
+	0x158E:  "\u1595\u148A",
+	0x158F:  "\u1595\u148B",
+	0x1590:  "\u1595\u148C",
+	0x1591:  "\u1595J",
+	0x1592:  "\u1595\u148E",
+	0x1593:  "\u1595\u1490",
+	0x1594:  "\u1595\u1491",
+	0x15AF:  "b",
+	0x15B4:  "F",
+	0x15B5:  "\u2132",
+	0x15B7:  "\uA7FB",
+	0x15C4:  "\u2C6F",
+	0x15C5:  "A",
+	0x15DE:  "D",
+	0x15EA:  "D",
+	0x15EF:  "\u0460",
+	0x15F0:  "M",
+	0x15F7:  "B",
+	0x1602:  "\u1490",
+	0x1603:  "\u1489",
+	0x1604:  "\u14D3",
+	0x1607:  "\u14DA",
+	0x1622:  "\u1543",
+	0x1623:  "\u1546",
+	0x1624:  "\u154A",
+	0x162E:  "\u01B1",
+	0x162F:  "\u03A9",
+	0x1634:  "\u01B1",
+	0x1635:  "\u03A9",
+	0x166D:  "X",
+	0x166E:  "x",
+	0x166F:  "\u1550\u146B",
+	0x1670:  "\u1595\u1489",
+	0x1671:  "\u1596\u148B",
+	0x1672:  "\u1596\u148C",
+	0x1673:  "\u1596J",
+	0x1674:  "\u1596\u148E",
+	0x1675:  "\u1596\u1490",
+	0x1676:  "\u1596\u1491",
+	0x1677:  "\u15A7\u00B7",
+	0x1678:  "\u15A8\u00B7",
+	0x1679:  "\u15A9\u00B7",
+	0x167A:  "\u15AA\u00B7",
+	0x167B:  "\u15AB\u00B7",
+	0x167C:  "\u15AC\u00B7",
+	0x167D:  "\u15AD\u00B7",
+	0x1680:  " ",
+	0x16B2:  "<",
+	0x16B7:  "X",
+	0x16C1:  "l",
+	0x16C2:  "\u16BD",
+	0x16CC:  "'",
+	0x16D5:  "K",
+	0x16D6:  "M",
+	0x16D8:  "\u03A8",
+	0x16E1:  "\u16BC",
+	0x16EB:  "\u00B7",
+	0x16EC:  ":",
+	0x16ED:  "+",
+	0x16F0:  "\u03A6",
+	0x1734:  "\u1715",
+	0x1735:  "/",
+	0x178F:  "\u178A",
+	0x17A3:  "\u17A2",
+	0x17B7:  "\u0E34",
+	0x17B8:  "\u0E35",
+	0x17B9:  "\u0E36",
+	0x17BA:  "\u0E37",
+	0x17C6:  "\u030A",
+	0x17CB:  "\u0E48",
+	0x17D3:  "\u030A",
+	0x17D4:  "\u0E2F",
+	0x17D5:  "\u0E5A",
+	0x17D9:  "\u0E4F",
+	0x17DA:  "\u0E5B",
+	0x17E0:  "o",
+	0x1803:  ":",
+	0x1809:  ":",
+	0x1855:  "\u1835",
+	0x1896:  "\u185C",
+	0x18B3:  "\u00B7\u18B1",
+	0x18B6:  "\u00B7\u18B4",
+	0x18B9:  "\u00B7\u18B8",
+	0x18C2:  "\u00B7\u18C0",
+	0x18C6:  "\u00B7\u14C2",
+	0x18C7:  "\u14C2\u00B7",
+	0x18C8:  "\u00B7\u14C3",
+	0x18C9:  "\u14C3\u00B7",
+	0x18CA:  "\u00B7\u14C4",
+	0x18CB:  "\u14C4\u00B7",
+	0x18CC:  "\u00B7\u14C5",
+	0x18CD:  "\u14C5\u00B7",
+	0x18CE:  "\u00B7\u1543",
+	0x18CF:  "\u00B7\u1546",
+	0x18D0:  "\u00B7\u1547",
+	0x18D1:  "\u00B7\u1548",
+	0x18D2:  "\u00B7\u1549",
+	0x18D3:  "\u00B7\u154B",
+	0x18DB:  "\u18F5",
+	0x18DC:  "\u18DF\u141E",
+	0x18DD:  "\u141E\u18DF",
+	0x18E0:  "\u1543\u00B7",
+	0x18E3:  "\u155E\u00B7",
+	0x18E4:  "\u1566\u00B7",
+	0x18E5:  "\u156B\u00B7",
+	0x18E8:  "\u1586\u00B7",
+	0x18EA:  "\u1597\u00B7",
+	0x18ED:  "\u0460\u00B7",
+	0x18F0:  "\u15F4\u00B7",
+	0x18F2:  "\u161B\u00B7",
+	0x19D0:  "\u199E",
+	0x19D1:  "\u19B1",
+	0x1A80:  "\u1A45",
+	0x1A90:  "\u1A45",
+	0x1AA9:  "\u1AA8\u1AA8",
+	0x1AAB:  "\u1AAA\u1AA8",
+	0x1AB4:  "\u06DB",
+	0x1AB7:  "\u0328",
+	0x1AD9:  "\u1AC6",
+	0x1AE2:  "\u0304",
+	0x1AE7:  "\u1AE5",
+	0x1AE8:  "\u0304\u0304",
+	0x1B52:  "\u1B0D",
+	0x1B53:  "\u1B11",
+	0x1B58:  "\u1B28",
+	0x1B5C:  "\u1B50",
+	0x1B5F:  "\u1B5E\u1B5E",
+	0x1C3C:  "\u1C3B\u1C3B",
+	0x1C7F:  "\u1C7E\u1C7E",
+	0x1CD0:  "\u0302",
+	0x1CD2:  "\u0304",
+	0x1CD3:  "''",
+	0x1CD5:  "\u032B",
+	0x1CD8:  "\u032E",
+	0x1CD9:  "\u032D",
+	0x1CDA:  "\u030E",
+	0x1CDC:  "\u0329",
+	0x1CDD:  "\u0323",
+	0x1CDE:  "\u0324",
+	0x1CED:  "\u0316",
+	0x1D04:  "c",
+	0x1D08:  "\u025C",
+	0x1D0B:  "\u0138",
+	0x1D0D:  "\u028D",
+	0x1D0F:  "o",
+	0x1D10:  "\u0254",
+	0x1D11:  "o",
+	0x1D14:  "\u01DDo",
+	0x1D1C:  "u",
+	0x1D20:  "v",
+	0x1D21:  "w",
+	0x1D22:  "z",
+	0x1D24:  "\u01A8",
+	0x1D26:  "r",
+	0x1D27:  "\u028C",
+	0x1D28:  "\u03C0",
+	0x1D29:  "\u1D18",
+	0x1D2B:  "\u043B",
+	0x1D3E:  "\u18D6",
+	0x1D52:  "\u00BA",
+	0x1D6B:  "ue",
+	0x1D6E:  "f\u0334",
+	0x1D6F:  "rn\u0334",
+	0x1D70:  "n\u0334",
+	0x1D72:  "r\u0334",
+	0x1D73:  "\u027E\u0334",
+	0x1D74:  "s\u0334",
+	0x1D75:  "t\u0334",
+	0x1D76:  "z\u0334",
+	0x1D78:  "\u1D34",
+	0x1D7B:  "i\u0335",
+	0x1D7C:  "i\u0335",
+	0x1D7D:  "p\u0335",
+	0x1D7E:  "u\u0335",
+	0x1D7F:  "\u028A\u0335",
+	0x1D83:  "g",
+	0x1D8C:  "y",
+	0x1D90:  "\u024B",
+	0x1D9F:  "\u1D4B",
+	0x1DA2:  "\u1D4D",
+	0x1DBA:  "\u18D4",
+	0x1DBB:  "\u1646",
+	0x1DE8:  "\u1ADA",
+	0x1DEE:  "\u2DEC",
+	0x1E43:  "\uAB51",
+	0x1E9A:  "\u1EA3",
+	0x1E9D:  "f",
+	0x1E9E:  "\u00DF",
+	0x1EFF:  "y",
+	0x1F7D:  "\u1FF4",
+	0x1FBD:  "'",
+	0x1FBE:  "i",
+	0x1FBF:  "'",
+	0x1FC0:  "~",
+	0x1FEF:  "'",
+	0x1FF6:  "\u13EF",
+	0x1FFD:  "'",
+	0x1FFE:  "'",
+	0x2000:  " ",
+	0x2001:  " ",
+	0x2002:  " ",
+	0x2003:  " ",
+	0x2004:  " ",
+	0x2005:  " ",
+	0x2006:  " ",
+	0x2007:  " ",
+	0x2008:  " ",
+	0x2009:  " ",
+	0x200A:  " ",
+	0x2010:  "-",
+	0x2011:  "-",
+	0x2012:  "-",
+	0x2013:  "-",
+	0x2014:  "\u30FC",
+	0x2015:  "\u30FC",
+	0x2016:  "ll",
+	0x2018:  "'",
+	0x2019:  "'",
+	0x201A:  ",",
+	0x201B:  "'",
+	0x201C:  "''",
+	0x201D:  "''",
+	0x201F:  "''",
+	0x2022:  "\u00B7",
+	0x2024:  ".",
+	0x2025:  "..",
+	0x2026:  "...",
+	0x2027:  "\u00B7",
+	0x2028:  " ",
+	0x2029:  " ",
+	0x202F:  " ",
+	0x2030:  "\u00BA/\u2080\u2080",
+	0x2031:  "\u00BA/\u2080\u2080\u2080",
+	0x2032:  "'",
+	0x2033:  "''",
+	0x2034:  "'''",
+	0x2035:  "'",
+	0x2036:  "''",
+	0x2037:  "'''",
+	0x2039:  "<",
+	0x203A:  ">",
+	0x203C:  "!!",
+	0x203E:  "\u02C9",
+	0x2041:  "/",
+	0x2043:  "-",
+	0x2044:  "/",
+	0x2047:  "??",
+	0x2048:  "?!",
+	0x2049:  "!?",
+	0x204E:  "*",
+	0x2052:  "\u00BA/\u2080",
+	0x2053:  "~",
+	0x2057:  "''''",
+	0x205A:  ":",
+	0x205D:  "\u2D57",
+	0x205E:  "\u2D42",
+	0x205F:  " ",
+	0x2070:  "\u00BA",
+	0x2079:  "\uA770",
+	0x20A1:  "C\u20EB",
+	0x20A4:  "\u00A3",
+	0x20A5:  "rn\u0338",
+	0x20A8:  "Rs",
+	0x20A9:  "W\u0335",
+	0x20AB:  "d\u0335\u0331",
+	0x20AC:  "\uA792",
+	0x20AD:  "K\u0335",
+	0x20AE:  "T\u20EB",
+	0x20B6:  "lt",
+	0x20BD:  "\u0554",
+	0x20C1:  "\u0631\u0649l\u0644",
+	0x20DB:  "\u06DB",
+	0x2100:  "a/c",
+	0x2101:  "a/s",
+	0x2102:  "C",
+	0x2103:  "\u00B0C",
+	0x2105:  "c/o",
+	0x2106:  "c/u",
+	0x2107:  "\u0190",
+	0x2108:  "\u042D",
+	0x2109:  "\u00B0F",
+	0x210A:  "g",
+	0x210B:  "H",
+	0x210C:  "H",
+	0x210D:  "H",
+	0x210E:  "h",
+	0x210F:  "h\u0335",
+	0x2110:  "l",
+	0x2111:  "l",
+	0x2112:  "L",
+	0x2113:  "l",
+	0x2115:  "N",
+	0x2116:  "No",
+	0x2119:  "P",
+	0x211A:  "Q",
+	0x211B:  "R",
+	0x211C:  "R",
+	0x211D:  "R",
+	0x2121:  "TEL",
+	0x2124:  "Z",
+	0x2126:  "\u03A9",
+	0x2127:  "\u01B1",
+	0x2128:  "Z",
+	0x2129:  "\u027F",
+	0x212A:  "K",
+	0x212C:  "B",
+	0x212D:  "C",
+	0x212E:  "e",
+	0x212F:  "e",
+	0x2130:  "E",
+	0x2131:  "F",
+	0x2133:  "M",
+	0x2134:  "o",
+	0x2135:  "\u05D0",
+	0x2136:  "\u05D1",
+	0x2137:  "\u05D2",
+	0x2138:  "\u05D3",
+	0x2139:  "i",
+	0x213B:  "FAX",
+	0x213C:  "\u03C0",
+	0x213D:  "y",
+	0x213E:  "\u0393",
+	0x213F:  "\u03A0",
+	0x2140:  "\u01A9",
+	0x2141:  "\uA4E8",
+	0x2142:  "\uA4F6",
+	0x2143:  "\U00016F00",
+	0x2145:  "D",
+	0x2146:  "d",
+	0x2147:  "e",
+	0x2148:  "i",
+	0x2149:  "j",
+	0x2160:  "l",
+	0x2161:  "ll",
+	0x2162:  "lll",
+	0x2163:  "lV",
+	0x2164:  "V",
+	0x2165:  "Vl",
+	0x2166:  "Vll",
+	0x2167:  "Vlll",
+	0x2168:  "lX",
+	0x2169:  "X",
+	0x216A:  "Xl",
+	0x216B:  "Xll",
+	0x216C:  "L",
+	0x216D:  "C",
+	0x216E:  "D",
+	0x216F:  "M",
+	0x2170:  "i",
+	0x2171:  "ii",
+	0x2172:  "iii",
+	0x2173:  "iv",
+	0x2174:  "v",
+	0x2175:  "vi",
+	0x2176:  "vii",
+	0x2177:  "viii",
+	0x2178:  "ix",
+	0x2179:  "x",
+	0x217A:  "xi",
+	0x217B:  "xii",
+	0x217C:  "l",
+	0x217D:  "c",
+	0x217E:  "d",
+	0x217F:  "rn",
+	0x2183:  "\u0186",
+	0x2184:  "\u0254",
+	0x2191:  "\u16CF",
+	0x2195:  "\u16E8",
+	0x21B5:  "\u21B2",
+	0x21BA:  "\U0001F10E",
+	0x21BE:  "\u16DA",
+	0x21BF:  "\u16D0",
+	0x21C4:  "\U0001F8D0",
+	0x21CC:  "\U0001F8D1",
+	0x2200:  "\u2C6F",
+	0x2203:  "\u018E",
+	0x2206:  "\u0394",
+	0x220F:  "\u03A0",
+	0x2211:  "\u01A9",
+	0x2212:  "-",
+	0x2214:  "+\u0307",
+	0x2215:  "/",
+	0x2216:  "\u005C",
+	0x2217:  "*",
+	0x2218:  "\u00B0",
+	0x2219:  "\u00B7",
+	0x221E:  "oo",
+	0x2223:  "l",
+	0x2225:  "ll",
+	0x2228:  "v",
+	0x2229:  "\u0548",
+	0x222A:  "U",
+	0x222B:  "\u0283",
+	0x222C:  "\u0283\u0283",
+	0x222D:  "\u0283\u0283\u0283",
+	0x222F:  "\u222E\u222E",
+	0x2230:  "\u222E\u222E\u222E",
+	0x2236:  ":",
+	0x2238:  "-\u0307",
+	0x223C:  "~",
+	0x2250:  "=\u0307",
+	0x2251:  "=\u0307\u0323",
+	0x2257:  "=\u030A",
+	0x2259:  "=\u0302",
+	0x225A:  "=\u0306",
+	0x225E:  "=\u036B",
+	0x2263:  "\u2261",
+	0x226A:  "<<",
+	0x226B:  ">>",
+	0x2282:  "\u1455",
+	0x2283:  "\u1450",
+	0x2295:  "\U000102A8",
+	0x2296:  "O\u0335",
+	0x2299:  "\u0298",
+	0x229D:  "O\u0335",
+	0x22A4:  "T",
+	0x22A5:  "\uA4D5",
+	0x22C0:  "\u2227",
+	0x22C1:  "v",
+	0x22C2:  "\u0548",
+	0x22C3:  "U",
+	0x22C4:  "\u16DC",
+	0x22C5:  "\u00B7",
+	0x22C8:  "\u16DE",
+	0x22D6:  "<\u00B7",
+	0x22D7:  "\u00B7>",
+	0x22D8:  "<<<",
+	0x22D9:  ">>>",
+	0x22EE:  "\u2D57",
+	0x22EF:  "\u00B7\u00B7\u00B7",
+	0x22F4:  "\uA793",
+	0x22FF:  "E",
+	0x2300:  "\u2205",
+	0x2325:  "\u2324",
+	0x2329:  "\u276C",
+	0x232A:  "\u276D",
+	0x2341:  "\u303C",
+	0x2359:  "\u0394\u0332",
+	0x235A:  "\u16DC\u0332",
+	0x235C:  "\u00B0\u0332",
+	0x235F:  "\u229B",
+	0x2361:  "T\u0308",
+	0x2362:  "\u2207\u0308",
+	0x2363:  "\u22C6\u0308",
+	0x2364:  "\u00B0\u0308",
+	0x2365:  "\u0629",
+	0x2368:  "~\u0308",
+	0x2369:  "\u1435",
+	0x236B:  "\u2207\u0334",
+	0x236C:  "O\u0335",
+	0x2373:  "i",
+	0x2374:  "p",
+	0x2375:  "\u03C9",
+	0x2376:  "a\u0332",
+	0x2377:  "\uA793\u0332",
+	0x2378:  "i\u0332",
+	0x2379:  "\u03C9\u0332",
+	0x237A:  "a",
+	0x237F:  "\u16BD",
+	0x239C:  "\u4E28",
+	0x239F:  "\u4E28",
+	0x23A2:  "\u4E28",
+	0x23A5:  "\u4E28",
+	0x23AA:  "\u4E28",
+	0x23AE:  "\u4E28",
+	0x23C1:  "\u2355",
+	0x23C2:  "\u234E",
+	0x23C3:  "\u234B",
+	0x23C6:  "\u236D",
+	0x23E8:  "\u2081\u2080",
+	0x23FC:  "\u23FB",
+	0x23FD:  "l",
+	0x23FE:  "\u263E",
+	0x244A:  "\u005C\u005C",
+	0x2460:  "\u2780",
+	0x2461:  "\u2781",
+	0x2462:  "\u2782",
+	0x2463:  "\u2783",
+	0x2464:  "\u2784",
+	0x2465:  "\u2785",
+	0x2466:  "\u2786",
+	0x2467:  "\u2787",
+	0x2468:  "\u2788",
+	0x2469:  "\u2789",
+	0x2474:  "(l)",
+	0x2475:  "(2)",
+	0x2476:  "(3)",
+	0x2477:  "(4)",
+	0x2478:  "(5)",
+	0x2479:  "(6)",
+	0x247A:  "(7)",
+	0x247B:  "(8)",
+	0x247C:  "(9)",
+	0x247D:  "(lO)",
+	0x247E:  "(ll)",
+	0x247F:  "(l2)",
+	0x2480:  "(l3)",
+	0x2481:  "(l4)",
+	0x2482:  "(l5)",
+	0x2483:  "(l6)",
+	0x2484:  "(l7)",
+	0x2485:  "(l8)",
+	0x2486:  "(l9)",
+	0x2487:  "(2O)",
+	0x2488:  "l.",
+	0x2489:  "2.",
+	0x248A:  "3.",
+	0x248B:  "4.",
+	0x248C:  "5.",
+	0x248D:  "6.",
+	0x248E:  "7.",
+	0x248F:  "8.",
+	0x2490:  "9.",
+	0x2491:  "lO.",
+	0x2492:  "ll.",
+	0x2493:  "l2.",
+	0x2494:  "l3.",
+	0x2495:  "l4.",
+	0x2496:  "l5.",
+	0x2497:  "l6.",
+	0x2498:  "l7.",
+	0x2499:  "l8.",
+	0x249A:  "l9.",
+	0x249B:  "2O.",
+	0x249C:  "(a)",
+	0x249D:  "(b)",
+	0x249E:  "(c)",
+	0x249F:  "(d)",
+	0x24A0:  "(e)",
+	0x24A1:  "(f)",
+	0x24A2:  "(g)",
+	0x24A3:  "(h)",
+	0x24A4:  "(i)",
+	0x24A5:  "(j)",
+	0x24A6:  "(k)",
+	0x24A7:  "(l)",
+	0x24A8:  "(rn)",
+	0x24A9:  "(n)",
+	0x24AA:  "(o)",
+	0x24AB:  "(p)",
+	0x24AC:  "(q)",
+	0x24AD:  "(r)",
+	0x24AE:  "(s)",
+	0x24AF:  "(t)",
+	0x24B0:  "(u)",
+	0x24B1:  "(v)",
+	0x24B2:  "(w)",
+	0x24B3:  "(x)",
+	0x24B4:  "(y)",
+	0x24B5:  "(z)",
+	0x24B8:  "\u00A9",
+	0x24C5:  "\u2117",
+	0x24C7:  "\u00AE",
+	0x24DB:  "\u24BE",
+	0x24EA:  "\U0001F10D",
+	0x2500:  "\u30FC",
+	0x2501:  "\u30FC",
+	0x2503:  "\u2502",
+	0x250F:  "\u250C",
+	0x2523:  "\u251C",
+	0x256A:  "\u01C2",
+	0x2571:  "/",
+	0x2573:  "X",
+	0x2588:  "\u220E",
+	0x2590:  "\u258C",
+	0x2594:  "\u02C9",
+	0x2597:  "\u2596",
+	0x259D:  "\u2598",
+	0x25A0:  "\u220E",
+	0x25B1:  "\u23E5",
+	0x25B3:  "\u0394",
+	0x25B7:  "\u22B3",
+	0x25B8:  "\u25B6",
+	0x25BA:  "\u25B6",
+	0x25BD:  "\U000102BC",
+	0x25C1:  "\u22B2",
+	0x25C7:  "\u16DC",
+	0x25CA:  "\u16DC",
+	0x25CB:  "\u00B0",
+	0x25CE:  "\u233E",
+	0x25E0:  "\u2312",
+	0x25E6:  "\u00B0",
+	0x2609:  "\u0298",
+	0x2610:  "\u25A1",
+	0x2625:  "\U0001099E",
+	0x2630:  "\u039E",
+	0x2638:  "\u2388",
+	0x264E:  "\u224F",
+	0x2657:  "\U0001FA55",
+	0x265D:  "\U0001FA57",
+	0x2662:  "\u16DC",
+	0x2669:  "\U0001D158\U0001D165",
+	0x266A:  "\U0001D158\U0001D165\U0001D16E",
+	0x26AC:  "\u0970",
+	0x2768:  "(",
+	0x2769:  ")",
+	0x276E:  "<",
+	0x276F:  ">",
+	0x2772:  "(",
+	0x2773:  ")",
+	0x2774:  "{",
+	0x2775:  "}",
+	0x2795:  "+",
+	0x2796:  "-",
+	0x2797:  "\u00F7",
+	0x27C2:  "\uA4D5",
+	0x27C8:  "\u005C\u1455",
+	0x27C9:  "\u1450/",
+	0x27CB:  "/",
+	0x27CD:  "\u005C",
+	0x27D9:  "T",
+	0x27E8:  "\u276C",
+	0x27E9:  "\u276D",
+	0x28FF:  "\U0001CEE0",
+	0x292B:  "x",
+	0x292C:  "x",
+	0x2963:  "\u16D0\u16DA",
+	0x2965:  "\u21C3\u21C2",
+	0x296E:  "\u16D0\u21C2",
+	0x296F:  "\u21C3\u16DA",
+	0x2999:  "\u2D42",
+	0x29B0:  "\u2349",
+	0x29B5:  "\U0001CEF0",
+	0x29BE:  "\u233E",
+	0x29C4:  "\u303C",
+	0x29C5:  "\u2342",
+	0x29C7:  "\u233B",
+	0x29D6:  "\U000102C0",
+	0x29D9:  "\u299A",
+	0x29F4:  ":\u2192",
+	0x29F5:  "\u005C",
+	0x29F6:  "/\u0304",
+	0x29F8:  "/",
+	0x29F9:  "\u005C",
+	0x2A00:  "\u0298",
+	0x2A01:  "\U000102A8",
+	0x2A02:  "\u2297",
+	0x2A03:  "\u228D",
+	0x2A04:  "\u228E",
+	0x2A05:  "\u2293",
+	0x2A06:  "\u2294",
+	0x2A0C:  "\u0283\u0283\u0283\u0283",
+	0x2A1D:  "\u16DE",
+	0x2A20:  ">>",
+	0x2A21:  "\u16DA",
+	0x2A22:  "+\u030A",
+	0x2A23:  "+\u0302",
+	0x2A24:  "+\u0303",
+	0x2A25:  "+\u0323",
+	0x2A26:  "+\u0330",
+	0x2A27:  "+\u2082",
+	0x2A29:  "-\u0313",
+	0x2A2A:  "-\u0323",
+	0x2A2F:  "x",
+	0x2A30:  "x\u0307",
+	0x2A3D:  "\u2319",
+	0x2A3E:  "\u2A1F",
+	0x2A3F:  "\u2210",
+	0x2A6A:  "~\u0307",
+	0x2A6E:  "=\u20F0",
+	0x2A74:  "::=",
+	0x2A75:  "==",
+	0x2A76:  "===",
+	0x2AA5:  "><",
+	0x2AAA:  "\u15D5",
+	0x2AAB:  "\u15D2",
+	0x2AD7:  "\u1450\u1455",
+	0x2AFB:  "///",
+	0x2AFD:  "//",
+	0x2B96:  "=\u1AB2",
+	0x2BEC:  "\u219E",
+	0x2BED:  "\u219F",
+	0x2BEE:  "\u21A0",
+	0x2BEF:  "\u21A1",
+	0x2C67:  "H\u0329",
+	0x2C69:  "K\u0329",
+	0x2C82:  "B",
+	0x2C83:  "\u0299",
+	0x2C84:  "\u0393",
+	0x2C85:  "r",
+	0x2C86:  "\u0394",
+	0x2C88:  "\uA792",
+	0x2C89:  "\uA793",
+	0x2C8B:  "\u03C2",
+	0x2C8C:  "\u2C6B",
+	0x2C8D:  "\u2C6C",
+	0x2C8E:  "H",
+	0x2C8F:  "\u029C",
+	0x2C90:  "O\u0335",
+	0x2C91:  "o\u0335",
+	0x2C92:  "l",
+	0x2C93:  "i",
+	0x2C94:  "K",
+	0x2C95:  "\u0138",
+	0x2C96:  "\u03BB",
+	0x2C97:  "\u028C",
+	0x2C98:  "M",
+	0x2C99:  "\u028D",
+	0x2C9A:  "N",
+	0x2C9B:  "\u0274",
+	0x2C9C:  "3",
+	0x2C9D:  "\u0293",
+	0x2C9E:  "O",
+	0x2C9F:  "o",
+	0x2CA0:  "\u03A0",
+	0x2CA1:  "\u03C0",
+	0x2CA2:  "P",
+	0x2CA3:  "p",
+	0x2CA4:  "C",
+	0x2CA5:  "c",
+	0x2CA6:  "T",
+	0x2CA7:  "\u1D1B",
+	0x2CA8:  "Y",
+	0x2CA9:  "y",
+	0x2CAA:  "\u03A6",
+	0x2CAB:  "\u0278",
+	0x2CAC:  "X",
+	0x2CAD:  "\u03C7",
+	0x2CAE:  "\u03A8",
+	0x2CAF:  "\u03C8",
+	0x2CB0:  "\uA64C",
+	0x2CB1:  "\u03C9",
+	0x2CB2:  "-\u0307",
+	0x2CB3:  "-\u0307",
+	0x2CB4:  "<\u00B7",
+	0x2CB5:  "<\u00B7",
+	0x2CB6:  "\u039E",
+	0x2CB7:  "\u2261",
+	0x2CBA:  "-",
+	0x2CBB:  "-",
+	0x2CBC:  "\u0428",
+	0x2CBD:  "w",
+	0x2CC0:  "\u0554",
+	0x2CC1:  "\u03FC",
+	0x2CC4:  "3",
+	0x2CC5:  "\u021D",
+	0x2CC6:  "/",
+	0x2CC7:  "/",
+	0x2CCA:  "9",
+	0x2CCB:  "9",
+	0x2CCC:  "3",
+	0x2CCD:  "\u021D",
+	0x2CCE:  "P",
+	0x2CCF:  "p",
+	0x2CD0:  "L",
+	0x2CD1:  "\u029F",
+	0x2CD2:  "6",
+	0x2CD3:  "6",
+	0x2CDC:  "6",
+	0x2CDD:  "\u1E9F",
+	0x2CE0:  "\u0278",
+	0x2CE1:  "\u0278",
+	0x2CE4:  "\u03D7",
+	0x2CE8:  "\u0554",
+	0x2CE9:  "\u2627",
+	0x2CF9:  "\u005C\u005C",
+	0x2D31:  "O\u0335",
+	0x2D37:  "\u0245",
+	0x2D38:  "V",
+	0x2D39:  "E",
+	0x2D3A:  "\u018E",
+	0x2D41:  "O\u0338",
+	0x2D48:  "\u00B7\u00B7\u00B7",
+	0x2D49:  "\u01A9",
+	0x2D4F:  "l",
+	0x2D51:  "!",
+	0x2D54:  "O",
+	0x2D55:  "Q",
+	0x2D59:  "\u0298",
+	0x2D5D:  "X",
+	0x2D60:  "\u0394",
+	0x2D63:  "\u16EF",
+	0x2DE8:  "\u1DDF",
+	0x2DEA:  "\u030A",
+	0x2DED:  "\u0368",
+	0x2DEE:  "\u1ADB",
+	0x2DEF:  "\u036F",
+	0x2DF6:  "\u0363",
+	0x2DF7:  "\u0364",
+	0x2E1A:  "-\u0308",
+	0x2E1E:  "~\u0307",
+	0x2E1F:  "~\u0323",
+	0x2E26:  "\u1455",
+	0x2E27:  "\u1450",
+	0x2E28:  "((",
+	0x2E29:  "))",
+	0x2E2A:  "\u2235",
+	0x2E2B:  "\u2234",
+	0x2E2C:  "\u2237",
+	0x2E2E:  "\u061F",
+	0x2E30:  "\u00B0",
+	0x2E31:  "\u00B7",
+	0x2E32:  "\u060C",
+	0x2E35:  "\u061B",
+	0x2E39:  "\u1E9F",
+	0x2E3D:  "\u2D42",
+	0x2E3F:  "\u00B6",
+	0x2E40:  "=",
+	0x2E82:  "\u4E5B",
+	0x2E83:  "\u4E5A",
+	0x2E85:  "\u4EBB",
+	0x2E89:  "\u5202",
+	0x2E8B:  "\u353E",
+	0x2E8E:  "\u5140",
+	0x2E8F:  "\u5C23",
+	0x2E90:  "\u5C22",
+	0x2E92:  "\u5DF3",
+	0x2E93:  "\u5E7A",
+	0x2E94:  "\u5F51",
+	0x2E96:  "\u5FC4",
+	0x2E97:  "\u38FA",
+	0x2E98:  "\u624C",
+	0x2E99:  "\u6535",
+	0x2E9B:  "\u65E1",
+	0x2E9E:  "\u6B7A",
+	0x2E9F:  "\u6BCD",
+	0x2EA0:  "\u6C11",
+	0x2EA1:  "\u6C35",
+	0x2EA2:  "\u6C3A",
+	0x2EA3:  "\u706C",
+	0x2EA4:  "\u722B",
+	0x2EA6:  "\u4E2C",
+	0x2EA8:  "\u72AD",
+	0x2EAB:  "\u7F52",
+	0x2EAD:  "\u793B",
+	0x2EAF:  "\u7CF9",
+	0x2EB1:  "\u7F53",
+	0x2EB2:  "\u7F52",
+	0x2EB9:  "\u8002",
+	0x2EBA:  "\u8080",
+	0x2EBE:  "\u8279",
+	0x2EBF:  "\u8279",
+	0x2EC0:  "\u8279",
+	0x2EC1:  "\u864E",
+	0x2EC2:  "\u8864",
+	0x2EC3:  "\u8980",
+	0x2EC4:  "\u897F",
+	0x2EC5:  "\u89C1",
+	0x2EC8:  "\u8BA0",
+	0x2EC9:  "\u8D1D",
+	0x2ECB:  "\u8F66",
+	0x2ECC:  "\u8FB6",
+	0x2ECD:  "\u8FB6",
+	0x2ECF:  "\u961D",
+	0x2ED0:  "\u9485",
+	0x2ED1:  "\u1110\u30FC\u1102\u110C",
+	0x2ED2:  "\u9578",
+	0x2ED3:  "\u957F",
+	0x2ED4:  "\u95E8",
+	0x2ED6:  "\u961D",
+	0x2ED8:  "\u9752",
+	0x2ED9:  "\u97E6",
+	0x2EDA:  "\u9875",
+	0x2EDB:  "\u98CE",
+	0x2EDC:  "\u98DE",
+	0x2EDD:  "\u98DF",
+	0x2EDF:  "\u98E0",
+	0x2EE0:  "\u9963",
+	0x2EE2:  "\u9A6C",
+	0x2EE4:  "\u9B3C",
+	0x2EE5:  "\u9C7C",
+	0x2EE8:  "\u9EA6",
+	0x2EE9:  "\u9EC4",
+	0x2EEB:  "\u6589",
+	0x2EEC:  "\u9F50",
+	0x2EED:  "\u6B6F",
+	0x2EEE:  "\u9F7F",
+	0x2EEF:  "\u7ADC",
+	0x2EF0:  "\u9F99",
+	0x2EF2:  "\u4E80",
+	0x2EF3:  "\u9F9F",
+	0x2F00:  "\u30FC",
+	0x2F01:  "\u4E28",
+	0x2F02:  "\u005C",
+	0x2F03:  "/",
+	0x2F04:  "\u4E59",
+	0x2F05:  "\u4E85",
+	0x2F06:  "\u4E8C",
+	0x2F07:  "\u4EA0",
+	0x2F08:  "\u4EBA",
+	0x2F09:  "\u513F",
+	0x2F0A:  "\u5165",
+	0x2F0B:  "\u516B",
+	0x2F0C:  "\u5182",
+	0x2F0D:  "\u5196",
+	0x2F0E:  "\u51AB",
+	0x2F0F:  "\u51E0",
+	0x2F10:  "\u51F5",
+	0x2F11:  "\u5200",
+	0x2F12:  "\u529B",
+	0x2F13:  "\u52F9",
+	0x2F14:  "\u5315",
+	0x2F15:  "\u531A",
+	0x2F16:  "\u5338",
+	0x2F17:  "\u5341",
+	0x2F18:  "\u535C",
+	0x2F19:  "\u5369",
+	0x2F1A:  "\u5382",
+	0x2F1B:  "\u53B6",
+	0x2F1C:  "\u53C8",
+	0x2F1D:  "\u53E3",
+	0x2F1E:  "\u53E3",
+	0x2F1F:  "\u571F",
+	0x2F20:  "\u571F",
+	0x2F21:  "\u5902",
+	0x2F22:  "\u590A",
+	0x2F23:  "\u5915",
+	0x2F24:  "\u5927",
+	0x2F25:  "\u5973",
+	0x2F26:  "\u5B50",
+	0x2F27:  "\u5B80",
+	0x2F28:  "\u5BF8",
+	0x2F29:  "\u5C0F",
+	0x2F2A:  "\u5C22",
+	0x2F2B:  "\u5C38",
+	0x2F2C:  "\u5C6E",
+	0x2F2D:  "\u5C71",
+	0x2F2E:  "\u5DDB",
+	0x2F2F:  "\u5DE5",
+	0x2F30:  "\u5DF1",
+	0x2F31:  "\u5DFE",
+	0x2F32:  "\u5E72",
+	0x2F33:  "\u5E7A",
+	0x2F34:  "\u5E7F",
+	0x2F35:  "\u5EF4",
+	0x2F36:  "\u5EFE",
+	0x2F37:  "\u5F0B",
+	0x2F38:  "\u5F13",
+	0x2F39:  "\u5F50",
+	0x2F3A:  "\u5F61",
+	0x2F3B:  "\u5F73",
+	0x2F3C:  "\u5FC3",
+	0x2F3D:  "\u6208",
+	0x2F3E:  "\u6236",
+	0x2F3F:  "\u624B",
+	0x2F40:  "\u652F",
+	0x2F41:  "\u6534",
+	0x2F42:  "\u6587",
+	0x2F43:  "\u6597",
+	0x2F44:  "\u65A4",
+	0x2F45:  "\u65B9",
+	0x2F46:  "\u65E0",
+	0x2F47:  "\u65E5",
+	0x2F48:  "\u66F0",
+	0x2F49:  "\u6708",
+	0x2F4A:  "\u6728",
+	0x2F4B:  "\u6B20",
+	0x2F4C:  "\u6B62",
+	0x2F4D:  "\u6B79",
+	0x2F4E:  "\u6BB3",
+	0x2F4F:  "\u6BCB",
+	0x2F50:  "\u6BD4",
+	0x2F51:  "\u6BDB",
+	0x2F52:  "\u6C0F",
+	0x2F53:  "\u6C14",
+	0x2F54:  "\u6C34",
+	0x2F55:  "\u706B",
+	0x2F56:  "\u722A",
+	0x2F57:  "\u7236",
+	0x2F58:  "\u723B",
+	0x2F59:  "\u1102\u116E\u4E28",
+	0x2F5A:  "\u7247",
+	0x2F5B:  "\u7259",
+	0x2F5C:  "\u725B",
+	0x2F5D:  "\u72AC",
+	0x2F5E:  "\u7384",
+	0x2F5F:  "\u7389",
+	0x2F60:  "\u74DC",
+	0x2F61:  "\u74E6",
+	0x2F62:  "\u7518",
+	0x2F63:  "\u751F",
+	0x2F64:  "\u7528",
+	0x2F65:  "\u7530",
+	0x2F66:  "\u758B",
+	0x2F67:  "\u7592",
+	0x2F68:  "\u7676",
+	0x2F69:  "\u767D",
+	0x2F6A:  "\u76AE",
+	0x2F6B:  "\u76BF",
+	0x2F6C:  "\u76EE",
+	0x2F6D:  "\u77DB",
+	0x2F6E:  "\u77E2",
+	0x2F6F:  "\u77F3",
+	0x2F70:  "\u793A",
+	0x2F71:  "\u79B8",
+	0x2F72:  "\u79BE",
+	0x2F73:  "\u7A74",
+	0x2F74:  "\u7ACB",
+	0x2F75:  "\u7AF9",
+	0x2F76:  "\u7C73",
+	0x2F77:  "\u7CF8",
+	0x2F78:  "\u7F36",
+	0x2F79:  "\u7F51",
+	0x2F7A:  "\u7F8A",
+	0x2F7B:  "\u7FBD",
+	0x2F7C:  "\u8001",
+	0x2F7D:  "\u800C",
+	0x2F7E:  "\u8012",
+	0x2F7F:  "\u8033",
+	0x2F80:  "\u807F",
+	0x2F81:  "\u8089",
+	0x2F82:  "\u81E3",
+	0x2F83:  "\u81EA",
+	0x2F84:  "\u81F3",
+	0x2F85:  "\u81FC",
+	0x2F86:  "\u820C",
+	0x2F87:  "\u821B",
+	0x2F88:  "\u821F",
+	0x2F89:  "\u826E",
+	0x2F8A:  "\u8272",
+	0x2F8B:  "\u8278",
+	0x2F8C:  "\u864D",
+	0x2F8D:  "\u866B",
+	0x2F8E:  "\u8840",
+	0x2F8F:  "\u884C",
+	0x2F90:  "\u8863",
+	0x2F91:  "\u897E",
+	0x2F92:  "\u898B",
+	0x2F93:  "\u89D2",
+	0x2F94:  "\u8A00",
+	0x2F95:  "\u8C37",
+	0x2F96:  "\u8C46",
+	0x2F97:  "\u8C55",
+	0x2F98:  "\u8C78",
+	0x2F99:  "\u8C9D",
+	0x2F9A:  "\u8D64",
+	0x2F9B:  "\u8D70",
+	0x2F9C:  "\u8DB3",
+	0x2F9D:  "\u8EAB",
+	0x2F9E:  "\u8ECA",
+	0x2F9F:  "\u8F9B",
+	0x2FA0:  "\u8FB0",
+	0x2FA1:  "\u8FB5",
+	0x2FA2:  "\u9091",
+	0x2FA3:  "\u9149",
+	0x2FA4:  "\u91C6",
+	0x2FA5:  "\u91CC",
+	0x2FA6:  "\u91D1",
+	0x2FA7:  "\u1110\u30FC\u1102\u110C",
+	0x2FA8:  "\u9580",
+	0x2FA9:  "\u961C",
+	0x2FAA:  "\u96B6",
+	0x2FAB:  "\u96B9",
+	0x2FAC:  "\u96E8",
+	0x2FAD:  "\u9751",
+	0x2FAE:  "\u975E",
+	0x2FAF:  "\u9762",
+	0x2FB0:  "\u9769",
+	0x2FB1:  "\u97CB",
+	0x2FB2:  "\u97ED",
+	0x2FB3:  "\u97F3",
+	0x2FB4:  "\u9801",
+	0x2FB5:  "\u98A8",
+	0x2FB6:  "\u98DB",
+	0x2FB7:  "\u98DF",
+	0x2FB8:  "\u9996",
+	0x2FB9:  "\u9999",
+	0x2FBA:  "\u99AC",
+	0x2FBB:  "\u9AA8",
+	0x2FBC:  "\u9AD8",
+	0x2FBD:  "\u9ADF",
+	0x2FBE:  "\u9B25",
+	0x2FBF:  "\u9B2F",
+	0x2FC0:  "\u9B32",
+	0x2FC1:  "\u9B3C",
+	0x2FC2:  "\u9B5A",
+	0x2FC3:  "\u9CE5",
+	0x2FC4:  "\u9E75",
+	0x2FC5:  "\u9E7F",
+	0x2FC6:  "\u9EA5",
+	0x2FC7:  "\u9EBB",
+	0x2FC8:  "\u9EC3",
+	0x2FC9:  "\u9ECD",
+	0x2FCA:  "\u9ED1",
+	0x2FCB:  "\u9EF9",
+	0x2FCC:  "\u9EFD",
+	0x2FCD:  "\u9F0E",
+	0x2FCE:  "\u9F13",
+	0x2FCF:  "\u9F20",
+	0x2FD0:  "\u9F3B",
+	0x2FD1:  "\u9F4A",
+	0x2FD2:  "\u9F52",
+	0x2FD3:  "\u9F8D",
+	0x2FD4:  "\u9F9C",
+	0x2FD5:  "\u9FA0",
+	0x3002:  "\u02F3",
+	0x3003:  "''",
+	0x3007:  "O",
+	0x3008:  "\u276C",
+	0x3009:  "\u276D",
+	0x3012:  "\u20B8",
+	0x3014:  "(",
+	0x3015:  ")",
+	0x301A:  "\u27E6",
+	0x301B:  "\u27E7",
+	0x302C:  "\u0309",
+	0x302D:  "\u0325",
+	0x3033:  "/",
+	0x3036:  "\u20B8",
+	0x3038:  "\u5341",
+	0x3039:  "\u5344",
+	0x303A:  "\u5345",
+	0x304F:  "\u276C",
+	0x309A:  "\u030A",
+	0x309B:  "\uFF9E",
+	0x309C:  "\uFF9F",
+	0x30A0:  "=",
+	0x30A4:  "\u4EBB",
+	0x30A8:  "\u5DE5",
+	0x30AB:  "\u529B",
+	0x30BF:  "\u5915",
+	0x30C8:  "\u535C",
+	0x30CB:  "\u4E8C",
+	0x30CE:  "/",
+	0x30CF:  "\u516B",
+	0x30D8:  "\u3078",
+	0x30ED:  "\u53E3",
+	0x30FB:  "\u00B7",
+	0x3126:  "\u513F",
+	0x3131:  "\u1100",
+	0x3132:  "\u1100\u1100",
+	0x3133:  "\u1100\u1109",
+	0x3134:  "\u1102",
+	0x3135:  "\u1102\u110C",
+	0x3136:  "\u1102\u1112",
+	0x3137:  "\u1103",
+	0x3138:  "\u1103\u1103",
+	0x3139:  "\u1105",
+	0x313A:  "\u1105\u1100",
+	0x313B:  "\u1105\u1106",
+	0x313C:  "\u1105\u1107",
+	0x313D:  "\u1105\u1109",
+	0x313E:  "\u1105\u1110",
+	0x313F:  "\u1105\u1111",
+	0x3140:  "\u1105\u1112",
+	0x3141:  "\u1106",
+	0x3142:  "\u1107",
+	0x3143:  "\u1107\u1107",
+	0x3144:  "\u1107\u1109",
+	0x3145:  "\u1109",
+	0x3146:  "\u1109\u1109",
+	0x3147:  "\u110B",
+	0x3148:  "\u110C",
+	0x3149:  "\u110C\u110C",
+	0x314A:  "\u110E",
+	0x314B:  "\u110F",
+	0x314C:  "\u1110",
+	0x314D:  "\u1111",
+	0x314E:  "\u1112",
+	0x314F:  "\u1161",
+	0x3150:  "\u1161\u4E28",
+	0x3151:  "\u1163",
+	0x3152:  "\u1163\u4E28",
+	0x3153:  "\u1165",
+	0x3154:  "\u1165\u4E28",
+	0x3155:  "\u1167",
+	0x3156:  "\u1167\u4E28",
+	0x3157:  "\u1169",
+	0x3158:  "\u1169\u1161",
+	0x3159:  "\u1169\u1161\u4E28",
+	0x315A:  "\u1169\u4E28",
+	0x315B:  "\u116D",
+	0x315C:  "\u116E",
+	0x315D:  "\u116E\u1165",
+	0x315E:  "\u116E\u1165\u4E28",
+	0x315F:  "\u116E\u4E28",
+	0x3160:  "\u1172",
+	0x3161:  "\u30FC",
+	0x3162:  "\u30FC\u4E28",
+	0x3163:  "\u4E28",
+	0x3164:  "\u1160",
+	0x3165:  "\u1102\u1102",
+	0x3166:  "\u1102\u1103",
+	0x3167:  "\u1102\u1109",
+	0x3168:  "\u1102\u1140",
+	0x3169:  "\u1105\u1100\u1109",
+	0x316A:  "\u1105\u1103",
+	0x316B:  "\u1105\u1107\u1109",
+	0x316C:  "\u1105\u1140",
+	0x316D:  "\u1105\u1159",
+	0x316E:  "\u1106\u1107",
+	0x316F:  "\u1106\u1109",
+	0x3170:  "\u1106\u1140",
+	0x3171:  "\u1106\u110B",
+	0x3172:  "\u1107\u1100",
+	0x3173:  "\u1107\u1103",
+	0x3174:  "\u1107\u1109\u1100",
+	0x3175:  "\u1107\u1109\u1103",
+	0x3176:  "\u1107\u110C",
+	0x3177:  "\u1107\u1110",
+	0x3178:  "\u1107\u110B",
+	0x3179:  "\u1107\u1107\u110B",
+	0x317A:  "\u1109\u1100",
+	0x317B:  "\u1109\u1102",
+	0x317C:  "\u1109\u1103",
+	0x317D:  "\u1109\u1107",
+	0x317E:  "\u1109\u110C",
+	0x317F:  "\u1140",
+	0x3180:  "\u110B\u110B",
+	0x3181:  "\u114C",
+	0x3182:  "\u110B\u1109",
+	0x3183:  "\u110B\u1140",
+	0x3184:  "\u1111\u110B",
+	0x3185:  "\u1112\u1112",
+	0x3186:  "\u1159",
+	0x3187:  "\u116D\u1163",
+	0x3188:  "\u116D\u1163\u4E28",
+	0x3189:  "\u116D\u4E28",
+	0x318A:  "\u1172\u1167",
+	0x318B:  "\u1172\u1167\u4E28",
+	0x318C:  "\u1172\u4E28",
+	0x318D:  "\u119E",
+	0x318E:  "\u119E\u4E28",
+	0x31D0:  "\u30FC",
+	0x31D1:  "\u4E28",
+	0x31D3:  "/",
+	0x31D4:  "\u005C",
+	0x31D6:  "\u4E5B",
+	0x31DA:  "\u4E85",
+	0x31DB:  "\u276C",
+	0x31DF:  "\u4E5A",
+	0x31E0:  "\u4E59",
+	0x3200:  "(\u1100)",
+	0x3201:  "(\u1102)",
+	0x3202:  "(\u1103)",
+	0x3203:  "(\u1105)",
+	0x3204:  "(\u1106)",
+	0x3205:  "(\u1107)",
+	0x3206:  "(\u1109)",
+	0x3207:  "(\u110B)",
+	0x3208:  "(\u110C)",
+	0x3209:  "(\u110E)",
+	0x320A:  "(\u110F)",
+	0x320B:  "(\u1110)",
+	0x320C:  "(\u1111)",
+	0x320D:  "(\u1112)",
+	0x320E:  "(\uAC00)",
+	0x320F:  "(\uB098)",
+	0x3210:  "(\uB2E4)",
+	0x3211:  "(\uB77C)",
+	0x3212:  "(\uB9C8)",
+	0x3213:  "(\uBC14)",
+	0x3214:  "(\uC0AC)",
+	0x3215:  "(\uC544)",
+	0x3216:  "(\uC790)",
+	0x3217:  "(\uCC28)",
+	0x3218:  "(\uCE74)",
+	0x3219:  "(\uD0C0)",
+	0x321A:  "(\uD30C)",
+	0x321B:  "(\uD558)",
+	0x321C:  "(\uC8FC)",
+	0x321D:  "(\uC624\uC804)",
+	0x321E:  "(\uC624\uD6C4)",
+	0x3220:  "(\u30FC)",
+	0x3221:  "(\u4E8C)",
+	0x3222:  "(\u4E09)",
+	0x3223:  "(\u56DB)",
+	0x3224:  "(\u4E94)",
+	0x3225:  "(\u516D)",
+	0x3226:  "(\u4E03)",
+	0x3227:  "(\u516B)",
+	0x3228:  "(\u4E5D)",
+	0x3229:  "(\u5341)",
+	0x322A:  "(\u6708)",
+	0x322B:  "(\u706B)",
+	0x322C:  "(\u6C34)",
+	0x322D:  "(\u6728)",
+	0x322E:  "(\u91D1)",
+	0x322F:  "(\u571F)",
+	0x3230:  "(\u65E5)",
+	0x3231:  "(\u682A)",
+	0x3232:  "(\u6709)",
+	0x3233:  "(\u793E)",
+	0x3234:  "(\u540D)",
+	0x3235:  "(\u7279)",
+	0x3236:  "(\u8CA1)",
+	0x3237:  "(\u795D)",
+	0x3238:  "(\u52B4)",
+	0x3239:  "(\u4EE3)",
+	0x323A:  "(\u547C)",
+	0x323B:  "(\u5B66)",
+	0x323C:  "(\u76E3)",
+	0x323D:  "(\u4F01)",
+	0x323E:  "(\u8CC7)",
+	0x323F:  "(\u5354)",
+	0x3240:  "(\u796D)",
+	0x3241:  "(\u4F11)",
+	0x3242:  "(\u81EA)",
+	0x3243:  "(\u81F3)",
+	0x32C0:  "l\u6708",
+	0x32C1:  "2\u6708",
+	0x32C2:  "3\u6708",
+	0x32C3:  "4\u6708",
+	0x32C4:  "5\u6708",
+	0x32C5:  "6\u6708",
+	0x32C6:  "7\u6708",
+	0x32C7:  "8\u6708",
+	0x32C8:  "9\u6708",
+	0x32C9:  "lO\u6708",
+	0x32CA:  "ll\u6708",
+	0x32CB:  "l2\u6708",
+	0x3358:  "O\u70B9",
+	0x3359:  "l\u70B9",
+	0x335A:  "2\u70B9",
+	0x335B:  "3\u70B9",
+	0x335C:  "4\u70B9",
+	0x335D:  "5\u70B9",
+	0x335E:  "6\u70B9",
+	0x335F:  "7\u70B9",
+	0x3360:  "8\u70B9",
+	0x3361:  "9\u70B9",
+	0x3362:  "lO\u70B9",
+	0x3363:  "ll\u70B9",
+	0x3364:  "l2\u70B9",
+	0x3365:  "l3\u70B9",
+	0x3366:  "l4\u70B9",
+	0x3367:  "l5\u70B9",
+	0x3368:  "l6\u70B9",
+	0x3369:  "l7\u70B9",
+	0x336A:  "l8\u70B9",
+	0x336B:  "l9\u70B9",
+	0x336C:  "2O\u70B9",
+	0x336D:  "2l\u70B9",
+	0x336E:  "22\u70B9",
+	0x336F:  "23\u70B9",
+	0x3370:  "24\u70B9",
+	0x33E0:  "l\u65E5",
+	0x33E1:  "2\u65E5",
+	0x33E2:  "3\u65E5",
+	0x33E3:  "4\u65E5",
+	0x33E4:  "5\u65E5",
+	0x33E5:  "6\u65E5",
+	0x33E6:  "7\u65E5",
+	0x33E7:  "8\u65E5",
+	0x33E8:  "9\u65E5",
+	0x33E9:  "lO\u65E5",
+	0x33EA:  "ll\u65E5",
+	0x33EB:  "l2\u65E5",
+	0x33EC:  "l3\u65E5",
+	0x33ED:  "l4\u65E5",
+	0x33EE:  "l5\u65E5",
+	0x33EF:  "l6\u65E5",
+	0x33F0:  "l7\u65E5",
+	0x33F1:  "l8\u65E5",
+	0x33F2:  "l9\u65E5",
+	0x33F3:  "2O\u65E5",
+	0x33F4:  "2l\u65E5",
+	0x33F5:  "22\u65E5",
+	0x33F6:  "23\u65E5",
+	0x33F7:  "24\u65E5",
+	0x33F8:  "25\u65E5",
+	0x33F9:  "26\u65E5",
+	0x33FA:  "27\u65E5",
+	0x33FB:  "28\u65E5",
+	0x33FC:  "29\u65E5",
+	0x33FD:  "3O\u65E5",
+	0x33FE:  "3l\u65E5",
+	0x39B3:  "\u363D",
+	0x439B:  "\u3588",
+	0x4420:  "\u3B3B",
+	0x4695:  "\U000278AE",
+	0x4E00:  "\u30FC",
+	0x4E15:  "\u110C\u1169",
+	0x4E1B:  "\u1109\u1109\u30FC",
+	0x4E36:  "\u005C",
+	0x4E3F:  "/",
+	0x4E8E:  "\U0001B122",
+	0x4ECA:  "\u1109\u30FC\u1100",
+	0x5002:  "\u4F75",
+	0x503C:  "\u5024",
+	0x5152:  "\U00016FF3",
+	0x535F:  "\u1106\u1161",
+	0x5408:  "\u1109\u30FC\u1106",
+	0x555F:  "\u5553",
+	0x56D7:  "\u53E3",
+	0x586B:  "\u5861",
+	0x58EB:  "\u571F",
+	0x58FF:  "\u58AB",
+	0x5B00:  "\u5AAF",
+	0x5E32:  "\u5E21",
+	0x5E50:  "\u3B3A",
+	0x6138:  "\U0002B73F",
+	0x6238:  "\u6236",
+	0x6409:  "\u3A41",
+	0x6663:  "\u403F",
+	0x6669:  "\u665A",
+	0x66F6:  "\u3ADA",
+	0x6726:  "\u4443",
+	0x67FF:  "\u676E",
+	0x69E9:  "\u3BA3",
+	0x6A27:  "\u699D",
+	0x6F59:  "\u6E88",
+	0x723F:  "\u1102\u116E\u4E28",
+	0x784F:  "\u7814",
+	0x7D76:  "\u7D55",
+	0x80A6:  "\u670C",
+	0x80CA:  "\u6710",
+	0x80D0:  "\u670F",
+	0x80F6:  "\u3B35",
+	0x8101:  "\u6713",
+	0x8127:  "\u6718",
+	0x8141:  "\u80FC",
+	0x81A7:  "\u6723",
+	0x853F:  "\u848D",
+	0x8641:  "\u8637",
+	0x8A1E:  "\u46B6",
+	0x8A7D:  "\u8A2E",
+	0x8B8F:  "\u8B86",
+	0x8C63:  "\u8C5C",
+	0x8D86:  "\u8D7F",
+	0x8DFA:  "\u8DE5",
+	0x8E9B:  "\u8E97",
+	0x8F27:  "\u8EFF",
+	0x90DE:  "\u90CE",
+	0x93AE:  "\u93AD",
+	0x9577:  "\u1110\u30FC\u1102\u110C",
+	0x96B8:  "\u96B7",
+	0x9E43:  "\u9E42",
+	0x9ED2:  "\u9ED1",
+	0x9FC3:  "\u4039",
+	0xA494:  "\uA2CD",
+	0xA49C:  "\uA0C0",
+	0xA49E:  "\uA04A",
+	0xA4A7:  "\uA458",
+	0xA4A8:  "\uA132",
+	0xA4AC:  "\uA050",
+	0xA4B0:  "\uA3C2",
+	0xA4BA:  "\uA3BF",
+	0xA4BE:  "\uA2B1",
+	0xA4BF:  "\uA259",
+	0xA4C0:  "\uA3AB",
+	0xA4C2:  "\uA3B5",
+	0xA4D0:  "B",
+	0xA4D1:  "P",
+	0xA4D2:  "d",
+	0xA4D3:  "D",
+	0xA4D4:  "T",
+	0xA4D6:  "G",
+	0xA4D7:  "K",
+	0xA4D9:  "J",
+	0xA4DA:  "C",
+	0xA4DB:  "\u0186",
+	0xA4DC:  "Z",
+	0xA4DD:  "F",
+	0xA4DE:  "\u2132",
+	0xA4DF:  "M",
+	0xA4E0:  "N",
+	0xA4E1:  "L",
+	0xA4E2:  "S",
+	0xA4E3:  "R",
+	0xA4E5:  "\u0245",
+	0xA4E6:  "V",
+	0xA4E7:  "H",
+	0xA4EA:  "W",
+	0xA4EB:  "X",
+	0xA4EC:  "Y",
+	0xA4ED:  "\u1660",
+	0xA4EE:  "A",
+	0xA4EF:  "\u2C6F",
+	0xA4F0:  "E",
+	0xA4F1:  "\u018E",
+	0xA4F2:  "l",
+	0xA4F3:  "O",
+	0xA4F4:  "U",
+	0xA4F5:  "\u0548",
+	0xA4F7:  "\u15E1",
+	0xA4F8:  ".",
+	0xA4F9:  ",",
+	0xA4FA:  "..",
+	0xA4FB:  ".,",
+	0xA4FD:  ":",
+	0xA4FE:  "-.",
+	0xA4FF:  "=",
+	0xA60E:  ".",
+	0xA644:  "2",
+	0xA645:  "\u01A8",
+	0xA647:  "i",
+	0xA64D:  "\u03C9",
+	0xA650:  "\u042Al",
+	0xA651:  "\u02C9bi",
+	0xA668:  "\u0298",
+	0xA66F:  "\u20E9",
+	0xA67C:  "\u0306",
+	0xA67E:  "\u02C7",
+	0xA695:  "h\u0314",
+	0xA698:  "OO",
+	0xA699:  "oo",
+	0xA69A:  "\U000102A8",
+	0xA6A1:  "\u0418",
+	0xA6B0:  "\u16B9",
+	0xA6B1:  "\u2C75",
+	0xA6CD:  "\u02A1",
+	0xA6CE:  "\u0245",
+	0xA6DB:  "\u03A0",
+	0xA6DF:  "V",
+	0xA6EB:  "?",
+	0xA6EF:  "2",
+	0xA6F0:  "\u0302",
+	0xA6F1:  "\u0304",
+	0xA6F4:  "\uA6F3\uA6F3",
+	0xA714:  "\u02EB",
+	0xA716:  "\u02EA",
+	0xA728:  "T3",
+	0xA729:  "t\u021D",
+	0xA731:  "s",
+	0xA732:  "AA",
+	0xA733:  "aa",
+	0xA734:  "AO",
+	0xA735:  "ao",
+	0xA736:  "AU",
+	0xA737:  "au",
+	0xA738:  "AV",
+	0xA739:  "av",
+	0xA73A:  "AV",
+	0xA73B:  "av",
+	0xA73C:  "AY",
+	0xA73D:  "ay",
+	0xA740:  "K\u0335",
+	0xA74A:  "O\u0335",
+	0xA74B:  "o\u0335",
+	0xA74E:  "OO",
+	0xA74F:  "oo",
+	0xA75A:  "2",
+	0xA761:  "w\u0326",
+	0xA76A:  "3",
+	0xA76B:  "\u021D",
+	0xA76E:  "9",
+	0xA777:  "tf",
+	0xA778:  "&",
+	0xA77A:  "\uA779",
+	0xA789:  ":",
+	0xA78C:  "'",
+	0xA78F:  "\u00B7",
+	0xA795:  "\uA727",
+	0xA798:  "F",
+	0xA799:  "f",
+	0xA79A:  "\U00010412",
+	0xA79B:  "\U0001043A",
+	0xA79D:  "\u029A",
+	0xA79E:  "\uA4E4",
+	0xA79F:  "u",
+	0xA7AB:  "3",
+	0xA7B1:  "\uA4D5",
+	0xA7B2:  "J",
+	0xA7B3:  "X",
+	0xA7B4:  "B",
+	0xA7B5:  "\u00DF",
+	0xA7B6:  "\uA64C",
+	0xA7B7:  "\u03C9",
+	0xA7CF:  "\uA7CE",
+	0xA7D2:  "\uA7D3",
+	0xA7D4:  "\uA7D5",
+	0xA7D6:  "\u00DF",
+	0xA7DA:  "\u0245",
+	0xA7DB:  "\u03BB",
+	0xA7DC:  "\u0245\u0338",
+	0xA7F1:  "\u18F5",
+	0xA7F7:  "\u30FC",
+	0xA830:  "\u0964",
+	0xA960:  "\u1103\u1106",
+	0xA961:  "\u1103\u1107",
+	0xA962:  "\u1103\u1109",
+	0xA963:  "\u1103\u110C",
+	0xA964:  "\u1105\u1100",
+	0xA965:  "\u1105\u1100\u1100",
+	0xA966:  "\u1105\u1103",
+	0xA967:  "\u1105\u1103\u1103",
+	0xA968:  "\u1105\u1106",
+	0xA969:  "\u1105\u1107",
+	0xA96A:  "\u1105\u1107\u1107",
+	0xA96B:  "\u1105\u1107\u110B",
+	0xA96C:  "\u1105\u1109",
+	0xA96D:  "\u1105\u110C",
+	0xA96E:  "\u1105\u110F",
+	0xA96F:  "\u1106\u1100",
+	0xA970:  "\u1106\u1103",
+	0xA971:  "\u1106\u1109",
+	0xA972:  "\u1107\u1109\u1110",
+	0xA973:  "\u1107\u110F",
+	0xA974:  "\u1107\u1112",
+	0xA975:  "\u1109\u1109\u1107",
+	0xA976:  "\u110B\u1105",
+	0xA977:  "\u110B\u1112",
+	0xA978:  "\u110C\u110C\u1112",
+	0xA979:  "\u1110\u1110",
+	0xA97A:  "\u1111\u1112",
+	0xA97B:  "\u1112\u1109",
+	0xA97C:  "\u1159\u1159",
+	0xA992:  "\u2C3F",
+	0xA9A3:  "\uA99D",
+	0xA9C6:  "\uA9D0",
+	0xA9CF:  "\u0662",
+	0xAA53:  "\uAA01",
+	0xAA56:  "\uAA23",
+	0xAB32:  "e",
+	0xAB35:  "f",
+	0xAB3D:  "o",
+	0xAB3E:  "o\u0338",
+	0xAB3F:  "\u0254\u0338",
+	0xAB41:  "\u01DDo\u0338",
+	0xAB42:  "\u01DDo\u0335",
+	0xAB47:  "r",
+	0xAB48:  "r",
+	0xAB4D:  "\u0283",
+	0xAB4E:  "u",
+	0xAB52:  "u",
+	0xAB53:  "\u03C7",
+	0xAB55:  "\u03C7",
+	0xAB5A:  "y",
+	0xAB60:  "\u0459",
+	0xAB62:  "\u0254e",
+	0xAB63:  "uo",
+	0xAB70:  "\u1D05",
+	0xAB71:  "\u0280",
+	0xAB72:  "\u1D1B",
+	0xAB74:  "o\u031B",
+	0xAB75:  "i",
+	0xAB7A:  "\u1D00",
+	0xAB7B:  "\u1D0A",
+	0xAB7C:  "\u1D07",
+	0xAB7E:  "\u0242",
+	0xAB80:  "\u2C76",
+	0xAB81:  "r",
+	0xAB83:  "w",
+	0xAB87:  "\u028D",
+	0xAB8B:  "\u029C",
+	0xAB8E:  "o\u0335",
+	0xAB90:  "\u0262",
+	0xAB93:  "z",
+	0xAB9B:  "\uA793",
+	0xAB9C:  "u\u0335",
+	0xAB9F:  "\u0185",
+	0xABA2:  "\u0280",
+	0xABA9:  "v",
+	0xABAA:  "s",
+	0xABAE:  "\u029F",
+	0xABAF:  "c",
+	0xABB2:  "\u1D18",
+	0xABB6:  "\u0138",
+	0xABBB:  "o\u0335",
+	0xD7B0:  "\u1169\u1167",
+	0xD7B1:  "\u1169\u1169\u4E28",
+	0xD7B2:  "\u116D\u1161",
+	0xD7B3:  "\u116D\u1161\u4E28",
+	0xD7B4:  "\u116D\u1165",
+	0xD7B5:  "\u116E\u1167",
+	0xD7B6:  "\u116E\u4E28\u4E28",
+	0xD7B7:  "\u1172\u1161\u4E28",
+	0xD7B8:  "\u1172\u1169",
+	0xD7B9:  "\u30FC\u1161",
+	0xD7BA:  "\u30FC\u1165",
+	0xD7BB:  "\u30FC\u1165\u4E28",
+	0xD7BC:  "\u30FC\u1169",
+	0xD7BD:  "\u4E28\u1163\u1169",
+	0xD7BE:  "\u4E28\u1163\u4E28",
+	0xD7BF:  "\u4E28\u1167",
+	0xD7C0:  "\u4E28\u1167\u4E28",
+	0xD7C1:  "\u4E28\u1169\u4E28",
+	0xD7C2:  "\u4E28\u116D",
+	0xD7C3:  "\u4E28\u1172",
+	0xD7C4:  "\u4E28\u4E28",
+	0xD7C5:  "\u119E\u1161",
+	0xD7C6:  "\u119E\u1165\u4E28",
+	0xD7CB:  "\u1102\u1105",
+	0xD7CC:  "\u1102\u110E",
+	0xD7CD:  "\u1103\u1103",
+	0xD7CE:  "\u1103\u1103\u1107",
+	0xD7CF:  "\u1103\u1107",
+	0xD7D0:  "\u1103\u1109",
+	0xD7D1:  "\u1103\u1109\u1100",
+	0xD7D2:  "\u1103\u110C",
+	0xD7D3:  "\u1103\u110E",
+	0xD7D4:  "\u1103\u1110",
+	0xD7D5:  "\u1105\u1100\u1100",
+	0xD7D6:  "\u1105\u1100\u1112",
+	0xD7D7:  "\u1105\u1105\u110F",
+	0xD7D8:  "\u1105\u1106\u1112",
+	0xD7D9:  "\u1105\u1107\u1103",
+	0xD7DA:  "\u1105\u1107\u1111",
+	0xD7DB:  "\u1105\u114C",
+	0xD7DC:  "\u1105\u1159\u1112",
+	0xD7DD:  "\u1105\u110B",
+	0xD7DE:  "\u1106\u1102",
+	0xD7DF:  "\u1106\u1102\u1102",
+	0xD7E0:  "\u1106\u1106",
+	0xD7E1:  "\u1106\u1107\u1109",
+	0xD7E2:  "\u1106\u110C",
+	0xD7E3:  "\u1107\u1103",
+	0xD7E4:  "\u1107\u1105\u1111",
+	0xD7E5:  "\u1107\u1106",
+	0xD7E6:  "\u1107\u1107",
+	0xD7E7:  "\u1107\u1109\u1103",
+	0xD7E8:  "\u1107\u110C",
+	0xD7E9:  "\u1107\u110E",
+	0xD7EA:  "\u1109\u1106",
+	0xD7EB:  "\u1109\u1107\u110B",
+	0xD7EC:  "\u1109\u1109\u1100",
+	0xD7ED:  "\u1109\u1109\u1103",
+	0xD7EE:  "\u1109\u1140",
+	0xD7EF:  "\u1109\u110C",
+	0xD7F0:  "\u1109\u110E",
+	0xD7F1:  "\u1109\u1110",
+	0xD7F2:  "\u1105\u1112",
+	0xD7F3:  "\u1140\u1107",
+	0xD7F4:  "\u1140\u1107\u110B",
+	0xD7F5:  "\u114C\u1106",
+	0xD7F6:  "\u114C\u1112",
+	0xD7F7:  "\u110C\u1107",
+	0xD7F8:  "\u110C\u1107\u1107",
+	0xD7F9:  "\u110C\u110C",
+	0xD7FA:  "\u1111\u1109",
+	0xD7FB:  "\u1111\u1110",
+	0xF900:  "\u8C48",
+	0xF901:  "\u66F4",
+	0xF902:  "\u8ECA",
+	0xF903:  "\u8CC8",
+	0xF904:  "\u6ED1",
+	0xF905:  "\u4E32",
+	0xF906:  "\u53E5",
+	0xF907:  "\u9F9C",
+	0xF908:  "\u9F9C",
+	0xF909:  "\u5951",
+	0xF90A:  "\u91D1",
+	0xF90B:  "\u5587",
+	0xF90C:  "\u5948",
+	0xF90D:  "\u61F6",
+	0xF90E:  "\u7669",
+	0xF90F:  "\u7F85",
+	0xF910:  "\u863F",
+	0xF911:  "\u87BA",
+	0xF912:  "\u88F8",
+	0xF913:  "\u908F",
+	0xF914:  "\u6A02",
+	0xF915:  "\u6D1B",
+	0xF916:  "\u70D9",
+	0xF917:  "\u73DE",
+	0xF918:  "\u843D",
+	0xF919:  "\u916A",
+	0xF91A:  "\u99F1",
+	0xF91B:  "\u4E82",
+	0xF91C:  "\u5375",
+	0xF91D:  "\u6B04",
+	0xF91E:  "\u721B",
+	0xF91F:  "\u862D",
+	0xF920:  "\u9E1E",
+	0xF921:  "\u5D50",
+	0xF922:  "\u6FEB",
+	0xF923:  "\u85CD",
+	0xF924:  "\u8964",
+	0xF925:  "\u62C9",
+	0xF926:  "\u81D8",
+	0xF927:  "\u881F",
+	0xF928:  "\u5ECA",
+	0xF929:  "\u6717",
+	0xF92A:  "\u6D6A",
+	0xF92B:  "\u72FC",
+	0xF92C:  "\u90CE",
+	0xF92D:  "\u4F86",
+	0xF92E:  "\u51B7",
+	0xF92F:  "\u52DE",
+	0xF930:  "\u64C4",
+	0xF931:  "\u6AD3",
+	0xF932:  "\u7210",
+	0xF933:  "\u76E7",
+	0xF934:  "\u8001",
+	0xF935:  "\u8606",
+	0xF936:  "\u865C",
+	0xF937:  "\u8DEF",
+	0xF938:  "\u9732",
+	0xF939:  "\u9B6F",
+	0xF93A:  "\u9DFA",
+	0xF93B:  "\u788C",
+	0xF93C:  "\u797F",
+	0xF93D:  "\u7DA0",
+	0xF93E:  "\u83C9",
+	0xF93F:  "\u9304",
+	0xF940:  "\u9E7F",
+	0xF941:  "\u8AD6",
+	0xF942:  "\u58DF",
+	0xF943:  "\u5F04",
+	0xF944:  "\u7C60",
+	0xF945:  "\u807E",
+	0xF946:  "\u7262",
+	0xF947:  "\u78CA",
+	0xF948:  "\u8CC2",
+	0xF949:  "\u96F7",
+	0xF94A:  "\u58D8",
+	0xF94B:  "\u5C62",
+	0xF94C:  "\u6A13",
+	0xF94D:  "\u6DDA",
+	0xF94E:  "\u6F0F",
+	0xF94F:  "\u7D2F",
+	0xF950:  "\u7E37",
+	0xF951:  "\u964B",
+	0xF952:  "\u52D2",
+	0xF953:  "\u808B",
+	0xF954:  "\u51DC",
+	0xF955:  "\u51CC",
+	0xF956:  "\u7A1C",
+	0xF957:  "\u7DBE",
+	0xF958:  "\u83F1",
+	0xF959:  "\u9675",
+	0xF95A:  "\u8B80",
+	0xF95B:  "\u62CF",
+	0xF95C:  "\u6A02",
+	0xF95D:  "\u8AFE",
+	0xF95E:  "\u4E39",
+	0xF95F:  "\u5BE7",
+	0xF960:  "\u6012",
+	0xF961:  "\u7387",
+	0xF962:  "\u7570",
+	0xF963:  "\u5317",
+	0xF964:  "\u78FB",
+	0xF965:  "\u4FBF",
+	0xF966:  "\u5FA9",
+	0xF967:  "\u4E0D",
+	0xF968:  "\u6CCC",
+	0xF969:  "\u6578",
+	0xF96A:  "\u7D22",
+	0xF96B:  "\u53C3",
+	0xF96C:  "\u585E",
+	0xF96D:  "\u7701",
+	0xF96E:  "\u8449",
+	0xF96F:  "\u8AAA",
+	0xF970:  "\u6BBA",
+	0xF971:  "\u8FB0",
+	0xF972:  "\u6C88",
+	0xF973:  "\u62FE",
+	0xF974:  "\u82E5",
+	0xF975:  "\u63A0",
+	0xF976:  "\u7565",
+	0xF977:  "\u4EAE",
+	0xF978:  "\u5169",
+	0xF979:  "\u51C9",
+	0xF97A:  "\u6881",
+	0xF97B:  "\u7CE7",
+	0xF97C:  "\u826F",
+	0xF97D:  "\u8AD2",
+	0xF97E:  "\u91CF",
+	0xF97F:  "\u52F5",
+	0xF980:  "\u5442",
+	0xF981:  "\u5973",
+	0xF982:  "\u5EEC",
+	0xF983:  "\u65C5",
+	0xF984:  "\u6FFE",
+	0xF985:  "\u792A",
+	0xF986:  "\u95AD",
+	0xF987:  "\u9A6A",
+	0xF988:  "\u9E97",
+	0xF989:  "\u9ECE",
+	0xF98A:  "\u529B",
+	0xF98B:  "\u66C6",
+	0xF98C:  "\u6B77",
+	0xF98D:  "\u8F62",
+	0xF98E:  "\u5E74",
+	0xF98F:  "\u6190",
+	0xF990:  "\u6200",
+	0xF991:  "\u649A",
+	0xF992:  "\u6F23",
+	0xF993:  "\u7149",
+	0xF994:  "\u7489",
+	0xF995:  "\u79CA",
+	0xF996:  "\u7DF4",
+	0xF997:  "\u806F",
+	0xF998:  "\u8F26",
+	0xF999:  "\u84EE",
+	0xF99A:  "\u9023",
+	0xF99B:  "\u934A",
+	0xF99C:  "\u5217",
+	0xF99D:  "\u52A3",
+	0xF99E:  "\u54BD",
+	0xF99F:  "\u70C8",
+	0xF9A0:  "\u88C2",
+	0xF9A1:  "\u8AAA",
+	0xF9A2:  "\u5EC9",
+	0xF9A3:  "\u5FF5",
+	0xF9A4:  "\u637B",
+	0xF9A5:  "\u6BAE",
+	0xF9A6:  "\u7C3E",
+	0xF9A7:  "\u7375",
+	0xF9A8:  "\u4EE4",
+	0xF9A9:  "\u56F9",
+	0xF9AA:  "\u5BE7",
+	0xF9AB:  "\u5DBA",
+	0xF9AC:  "\u601C",
+	0xF9AD:  "\u73B2",
+	0xF9AE:  "\u7469",
+	0xF9AF:  "\u7F9A",
+	0xF9B0:  "\u8046",
+	0xF9B1:  "\u9234",
+	0xF9B2:  "\u96F6",
+	0xF9B3:  "\u9748",
+	0xF9B4:  "\u9818",
+	0xF9B5:  "\u4F8B",
+	0xF9B6:  "\u79AE",
+	0xF9B7:  "\u91B4",
+	0xF9B8:  "\u96B7",
+	0xF9B9:  "\u60E1",
+	0xF9BA:  "\u4E86",
+	0xF9BB:  "\u50DA",
+	0xF9BC:  "\u5BEE",
+	0xF9BD:  "\u5C3F",
+	0xF9BE:  "\u6599",
+	0xF9BF:  "\u6A02",
+	0xF9C0:  "\u71CE",
+	0xF9C1:  "\u7642",
+	0xF9C2:  "\u84FC",
+	0xF9C3:  "\u907C",
+	0xF9C4:  "\u9F8D",
+	0xF9C5:  "\u6688",
+	0xF9C6:  "\u962E",
+	0xF9C7:  "\u5289",
+	0xF9C8:  "\u677B",
+	0xF9C9:  "\u67F3",
+	0xF9CA:  "\u6D41",
+	0xF9CB:  "\u6E9C",
+	0xF9CC:  "\u7409",
+	0xF9CD:  "\u7559",
+	0xF9CE:  "\u786B",
+	0xF9CF:  "\u7D10",
+	0xF9D0:  "\u985E",
+	0xF9D1:  "\u516D",
+	0xF9D2:  "\u622E",
+	0xF9D3:  "\u9678",
+	0xF9D4:  "\u502B",
+	0xF9D5:  "\u5D19",
+	0xF9D6:  "\u6DEA",
+	0xF9D7:  "\u8F2A",
+	0xF9D8:  "\u5F8B",
+	0xF9D9:  "\u6144",
+	0xF9DA:  "\u6817",
+	0xF9DB:  "\u7387",
+	0xF9DC:  "\u9686",
+	0xF9DD:  "\u5229",
+	0xF9DE:  "\u540F",
+	0xF9DF:  "\u5C65",
+	0xF9E0:  "\u6613",
+	0xF9E1:  "\u674E",
+	0xF9E2:  "\u68A8",
+	0xF9E3:  "\u6CE5",
+	0xF9E4:  "\u7406",
+	0xF9E5:  "\u75E2",
+	0xF9E6:  "\u7F79",
+	0xF9E7:  "\u88CF",
+	0xF9E8:  "\u88E1",
+	0xF9E9:  "\u91CC",
+	0xF9EA:  "\u96E2",
+	0xF9EB:  "\u533F",
+	0xF9EC:  "\u6EBA",
+	0xF9ED:  "\u541D",
+	0xF9EE:  "\u71D0",
+	0xF9EF:  "\u7498",
+	0xF9F0:  "\u85FA",
+	0xF9F1:  "\u96A3",
+	0xF9F2:  "\u9C57",
+	0xF9F3:  "\u9E9F",
+	0xF9F4:  "\u6797",
+	0xF9F5:  "\u6DCB",
+	0xF9F6:  "\u81E8",
+	0xF9F7:  "\u7ACB",
+	0xF9F8:  "\u7B20",
+	0xF9F9:  "\u7C92",
+	0xF9FA:  "\u72C0",
+	0xF9FB:  "\u7099",
+	0xF9FC:  "\u8B58",
+	0xF9FD:  "\u4EC0",
+	0xF9FE:  "\u8336",
+	0xF9FF:  "\u523A",
+	0xFA00:  "\u5207",
+	0xFA01:  "\u5EA6",
+	0xFA02:  "\u62D3",
+	0xFA03:  "\u7CD6",
+	0xFA04:  "\u5B85",
+	0xFA05:  "\u6D1E",
+	0xFA06:  "\u66B4",
+	0xFA07:  "\u8F3B",
+	0xFA08:  "\u884C",
+	0xFA09:  "\u964D",
+	0xFA0A:  "\u898B",
+	0xFA0B:  "\u5ED3",
+	0xFA0C:  "\u5140",
+	0xFA0D:  "\u55C0",
+	0xFA10:  "\u585A",
+	0xFA12:  "\u6674",
+	0xFA15:  "\u51DE",
+	0xFA16:  "\u732A",
+	0xFA17:  "\u76CA",
+	0xFA18:  "\u793C",
+	0xFA19:  "\u795E",
+	0xFA1A:  "\u7965",
+	0xFA1B:  "\u798F",
+	0xFA1C:  "\u9756",
+	0xFA1D:  "\u7CBE",
+	0xFA1E:  "\u7FBD",
+	0xFA20:  "\u8612",
+	0xFA22:  "\u8AF8",
+	0xFA25:  "\u9038",
+	0xFA26:  "\u90FD",
+	0xFA2A:  "\u98EF",
+	0xFA2B:  "\u98FC",
+	0xFA2C:  "\u9928",
+	0xFA2D:  "\u9DB4",
+	0xFA2E:  "\u90CE",
+	0xFA2F:  "\u96B7",
+	0xFA30:  "\u4FAE",
+	0xFA31:  "\u50E7",
+	0xFA32:  "\u514D",
+	0xFA33:  "\u52C9",
+	0xFA34:  "\u52E4",
+	0xFA35:  "\u5351",
+	0xFA36:  "\u559D",
+	0xFA37:  "\u5606",
+	0xFA38:  "\u5668",
+	0xFA39:  "\u5840",
+	0xFA3A:  "\u58A8",
+	0xFA3B:  "\u5C64",
+	0xFA3C:  "\u5C6E",
+	0xFA3D:  "\u6094",
+	0xFA3E:  "\u6168",
+	0xFA3F:  "\u618E",
+	0xFA40:  "\u61F2",
+	0xFA41:  "\u654F",
+	0xFA42:  "\u65E2",
+	0xFA43:  "\u6691",
+	0xFA44:  "\u6885",
+	0xFA45:  "\u6D77",
+	0xFA46:  "\u6E1A",
+	0xFA47:  "\u6F22",
+	0xFA48:  "\u716E",
+	0xFA49:  "\u722B",
+	0xFA4A:  "\u7422",
+	0xFA4B:  "\u7891",
+	0xFA4C:  "\u793E",
+	0xFA4D:  "\u7949",
+	0xFA4E:  "\u7948",
+	0xFA4F:  "\u7950",
+	0xFA50:  "\u7956",
+	0xFA51:  "\u795D",
+	0xFA52:  "\u798D",
+	0xFA53:  "\u798E",
+	0xFA54:  "\u7A40",
+	0xFA55:  "\u7A81",
+	0xFA56:  "\u7BC0",
+	0xFA57:  "\u7DF4",
+	0xFA58:  "\u7E09",
+	0xFA59:  "\u7E41",
+	0xFA5A:  "\u7F72",
+	0xFA5B:  "\u8005",
+	0xFA5C:  "\u81ED",
+	0xFA5D:  "\u8279",
+	0xFA5E:  "\u8279",
+	0xFA5F:  "\u8457",
+	0xFA60:  "\u8910",
+	0xFA61:  "\u8996",
+	0xFA62:  "\u8B01",
+	0xFA63:  "\u8B39",
+	0xFA64:  "\u8CD3",
+	0xFA65:  "\u8D08",
+	0xFA66:  "\u8FB6",
+	0xFA67:  "\u9038",
+	0xFA68:  "\u96E3",
+	0xFA69:  "\u97FF",
+	0xFA6A:  "\u983B",
+	0xFA6B:  "\u6075",
+	0xFA6C:  "\U000242EE",
+	0xFA6D:  "\u8218",
+	0xFA70:  "\u4E26",
+	0xFA71:  "\u51B5",
+	0xFA72:  "\u5168",
+	0xFA73:  "\u4F80",
+	0xFA74:  "\u5145",
+	0xFA75:  "\u5180",
+	0xFA76:  "\u52C7",
+	0xFA77:  "\u52FA",
+	0xFA78:  "\u559D",
+	0xFA79:  "\u5555",
+	0xFA7A:  "\u5599",
+	0xFA7B:  "\u55E2",
+	0xFA7C:  "\u585A",
+	0xFA7D:  "\u58B3",
+	0xFA7E:  "\u5944",
+	0xFA7F:  "\u5954",
+	0xFA80:  "\u5A62",
+	0xFA81:  "\u5B28",
+	0xFA82:  "\u5ED2",
+	0xFA83:  "\u5ED9",
+	0xFA84:  "\u5F69",
+	0xFA85:  "\u5FAD",
+	0xFA86:  "\u60D8",
+	0xFA87:  "\u614E",
+	0xFA88:  "\u6108",
+	0xFA89:  "\u618E",
+	0xFA8A:  "\u6160",
+	0xFA8B:  "\u61F2",
+	0xFA8C:  "\u6234",
+	0xFA8D:  "\u63C4",
+	0xFA8E:  "\u641C",
+	0xFA8F:  "\u6452",
+	0xFA90:  "\u6556",
+	0xFA91:  "\u6674",
+	0xFA92:  "\u6717",
+	0xFA93:  "\u671B",
+	0xFA94:  "\u6756",
+	0xFA95:  "\u6B79",
+	0xFA96:  "\u6BBA",
+	0xFA97:  "\u6D41",
+	0xFA98:  "\u6EDB",
+	0xFA99:  "\u6ECB",
+	0xFA9A:  "\u6F22",
+	0xFA9B:  "\u701E",
+	0xFA9C:  "\u716E",
+	0xFA9D:  "\u77A7",
+	0xFA9E:  "\u7235",
+	0xFA9F:  "\u72AF",
+	0xFAA0:  "\u732A",
+	0xFAA1:  "\u7471",
+	0xFAA2:  "\u7506",
+	0xFAA3:  "\u753B",
+	0xFAA4:  "\u761D",
+	0xFAA5:  "\u761F",
+	0xFAA6:  "\u76CA",
+	0xFAA7:  "\u76DB",
+	0xFAA8:  "\u76F4",
+	0xFAA9:  "\u774A",
+	0xFAAA:  "\u7740",
+	0xFAAB:  "\u78CC",
+	0xFAAC:  "\u7AB1",
+	0xFAAD:  "\u7BC0",
+	0xFAAE:  "\u7C7B",
+	0xFAAF:  "\u7D5B",
+	0xFAB0:  "\u7DF4",
+	0xFAB1:  "\u7F3E",
+	0xFAB2:  "\u8005",
+	0xFAB3:  "\u8352",
+	0xFAB4:  "\u83EF",
+	0xFAB5:  "\u8779",
+	0xFAB6:  "\u8941",
+	0xFAB7:  "\u8986",
+	0xFAB8:  "\u8996",
+	0xFAB9:  "\u8ABF",
+	0xFABA:  "\u8AF8",
+	0xFABB:  "\u8ACB",
+	0xFABC:  "\u8B01",
+	0xFABD:  "\u8AFE",
+	0xFABE:  "\u8AED",
+	0xFABF:  "\u8B39",
+	0xFAC0:  "\u8B8A",
+	0xFAC1:  "\u8D08",
+	0xFAC2:  "\u8F38",
+	0xFAC3:  "\u9072",
+	0xFAC4:  "\u9199",
+	0xFAC5:  "\u9276",
+	0xFAC6:  "\u967C",
+	0xFAC7:  "\u96E3",
+	0xFAC8:  "\u9756",
+	0xFAC9:  "\u97DB",
+	0xFACA:  "\u97FF",
+	0xFACB:  "\u980B",
+	0xFACC:  "\u983B",
+	0xFACD:  "\u9B12",
+	0xFACE:  "\u9F9C",
+	0xFACF:  "\U0002284A",
+	0xFAD0:  "\U00022844",
+	0xFAD1:  "\U000233D5",
+	0xFAD2:  "\u3B9D",
+	0xFAD3:  "\u4018",
+	0xFAD4:  "\u4039",
+	0xFAD5:  "\U00025249",
+	0xFAD6:  "\U00025CD0",
+	0xFAD7:  "\U00027ED3",
+	0xFAD8:  "\u9F43",
+	0xFAD9:  "\u9F8E",
+	0xFB00:  "ff",
+	0xFB01:  "fi",
+	0xFB02:  "fl",
+	0xFB03:  "ffi",
+	0xFB04:  "ffl",
+	0xFB06:  "st",
+	0xFB13:  "\u0574\u0576",
+	0xFB14:  "\u0574\u0565",
+	0xFB15:  "\u0574\u056B",
+	0xFB16:  "\u057E\u0576",
+	0xFB17:  "\u0574\u056D",
+	0xFB20:  "\u05E2",
+	0xFB21:  "\u05D0",
+	0xFB22:  "\u05D3",
+	0xFB23:  "\u05D4",
+	0xFB24:  "\u05DB",
+	0xFB25:  "\u05DC",
+	0xFB26:  "\u05DD",
+	0xFB27:  "\u05E8",
+	0xFB28:  "\u05EA",
+	0xFB29:  "-\u0307",
+	0xFB2B:  "\uFB2A",
+	0xFB2D:  "\uFB2C",
+	0xFB2F:  "\uFB2E",
+	0xFB30:  "\uFB2E",
+	0xFB39:  "\uFB1D",
+	0xFB49:  "\uFB2A",
+	0xFB4F:  "\u05D0\u05DC",
+	0xFB50:  "\u0671",
+	0xFB51:  "\u0671",
+	0xFB52:  "\u067B",
+	0xFB53:  "\u067B",
+	0xFB54:  "\u067B",
+	0xFB55:  "\u067B",
+	0xFB56:  "\u0649\u06DB",
+	0xFB57:  "\u0649\u06DB",
+	0xFB58:  "\u0649\u06DB",
+	0xFB59:  "\u0649\u06DB",
+	0xFB5A:  "\u0680",
+	0xFB5B:  "\u0680",
+	0xFB5C:  "\u0680",
+	0xFB5D:  "\u0680",
+	0xFB5E:  "\u062A",
+	0xFB5F:  "\u062A",
+	0xFB60:  "\u062A",
+	0xFB61:  "\u062A",
+	0xFB62:  "\u067F",
+	0xFB63:  "\u067F",
+	0xFB64:  "\u067F",
+	0xFB65:  "\u067F",
+	0xFB66:  "\u0649\u0615",
+	0xFB67:  "\u0649\u0615",
+	0xFB68:  "\u0649\u0615",
+	0xFB69:  "\u0649\u0615",
+	0xFB6A:  "\u06A1\u06DB",
+	0xFB6B:  "\u06A1\u06DB",
+	0xFB6C:  "\u06A1\u06DB",
+	0xFB6D:  "\u06A1\u06DB",
+	0xFB6E:  "\u06A6",
+	0xFB6F:  "\u06A6",
+	0xFB70:  "\u06A6",
+	0xFB71:  "\u06A6",
+	0xFB72:  "\u0684",
+	0xFB73:  "\u0684",
+	0xFB74:  "\u0684",
+	0xFB75:  "\u0684",
+	0xFB76:  "\u0683",
+	0xFB77:  "\u0683",
+	0xFB78:  "\u0683",
+	0xFB79:  "\u0683",
+	0xFB7A:  "\u0686",
+	0xFB7B:  "\u0686",
+	0xFB7C:  "\u0686",
+	0xFB7D:  "\u0686",
+	0xFB7E:  "\u0687",
+	0xFB7F:  "\u0687",
+	0xFB80:  "\u0687",
+	0xFB81:  "\u0687",
+	0xFB82:  "\u068D",
+	0xFB83:  "\u068D",
+	0xFB84:  "\u068C",
+	0xFB85:  "\u068C",
+	0xFB86:  "\u062F\u06DB",
+	0xFB87:  "\u062F\u06DB",
+	0xFB88:  "\u062F\u0615",
+	0xFB89:  "\u062F\u0615",
+	0xFB8A:  "\u0631\u06DB",
+	0xFB8B:  "\u0631\u06DB",
+	0xFB8C:  "\u0631\u0615",
+	0xFB8D:  "\u0631\u0615",
+	0xFB8E:  "\u0643",
+	0xFB8F:  "\u0643",
+	0xFB90:  "\u0643",
+	0xFB91:  "\u0643",
+	0xFB92:  "\u06AF",
+	0xFB93:  "\u06AF",
+	0xFB94:  "\u06AF",
+	0xFB95:  "\u06AF",
+	0xFB96:  "\u06B3",
+	0xFB97:  "\u06B3",
+	0xFB98:  "\u06B3",
+	0xFB99:  "\u06B3",
+	0xFB9A:  "\u06B1",
+	0xFB9B:  "\u06B1",
+	0xFB9C:  "\u06B1",
+	0xFB9D:  "\u06B1",
+	0xFB9E:  "\u0649",
+	0xFB9F:  "\u0649",
+	0xFBA0:  "\u0649\u0615",
+	0xFBA1:  "\u0649\u0615",
+	0xFBA2:  "\u0649\u0615",
+	0xFBA3:  "\u0649\u0615",
+	0xFBA4:  "\u06C0",
+	0xFBA5:  "\u06C0",
+	0xFBA6:  "o",
+	0xFBA7:  "o",
+	0xFBA8:  "o",
+	0xFBA9:  "o",
+	0xFBAA:  "o",
+	0xFBAB:  "o",
+	0xFBAC:  "o",
+	0xFBAD:  "o",
+	0xFBAE:  "\u0649",
+	0xFBAF:  "\u0649",
+	0xFBB0:  "\u06D3",
+	0xFBB1:  "\u06D3",
+	0xFBD3:  "\u0643\u06DB",
+	0xFBD4:  "\u0643\u06DB",
+	0xFBD5:  "\u0643\u06DB",
+	0xFBD6:  "\u0643\u06DB",
+	0xFBD7:  "\u0648\u0313",
+	0xFBD8:  "\u0648\u0313",
+	0xFBD9:  "\u0648\u0306",
+	0xFBDA:  "\u0648\u0306",
+	0xFBDB:  "\u0648\u0670",
+	0xFBDC:  "\u0648\u0670",
+	0xFBDD:  "\u0648\u0313\u0674",
+	0xFBDE:  "\u0648\u06DB",
+	0xFBDF:  "\u0648\u06DB",
+	0xFBE0:  "\u06C5",
+	0xFBE1:  "\u06C5",
+	0xFBE2:  "\u0648\u0302",
+	0xFBE3:  "\u0648\u0302",
+	0xFBE4:  "\u067B",
+	0xFBE5:  "\u067B",
+	0xFBE6:  "\u067B",
+	0xFBE7:  "\u067B",
+	0xFBE8:  "\u0649",
+	0xFBE9:  "\u0649",
+	0xFBEA:  "\u0649\u0674l",
+	0xFBEB:  "\u0649\u0674l",
+	0xFBEC:  "\u0649\u0674o",
+	0xFBED:  "\u0649\u0674o",
+	0xFBEE:  "\u0649\u0674\u0648",
+	0xFBEF:  "\u0649\u0674\u0648",
+	0xFBF0:  "\u0649\u0674\u0648\u0313",
+	0xFBF1:  "\u0649\u0674\u0648\u0313",
+	0xFBF2:  "\u0649\u0674\u0648\u0306",
+	0xFBF3:  "\u0649\u0674\u0648\u0306",
+	0xFBF4:  "\u0649\u0674\u0648\u0670",
+	0xFBF5:  "\u0649\u0674\u0648\u0670",
+	0xFBF6:  "\u0649\u0674\u067B",
+	0xFBF7:  "\u0649\u0674\u067B",
+	0xFBF8:  "\u0649\u0674\u067B",
+	0xFBF9:  "\u0649\u0674\u0649",
+	0xFBFA:  "\u0649\u0674\u0649",
+	0xFBFB:  "\u0649\u0674\u0649",
+	0xFBFC:  "\u0649",
+	0xFBFD:  "\u0649",
+	0xFBFE:  "\u0649",
+	0xFBFF:  "\u0649",
+	0xFC00:  "\u0649\u0674\u062C",
+	0xFC01:  "\u0649\u0674\u062D",
+	0xFC02:  "\u0649\u0674\u0645",
+	0xFC03:  "\u0649\u0674\u0649",
+	0xFC04:  "\u0649\u0674\u0649",
+	0xFC05:  "\u0628\u062C",
+	0xFC06:  "\u0628\u062D",
+	0xFC07:  "\u0628\u062E",
+	0xFC08:  "\u0628\u0645",
+	0xFC09:  "\u0628\u0649",
+	0xFC0A:  "\u0628\u0649",
+	0xFC0B:  "\u062A\u062C",
+	0xFC0C:  "\u062A\u062D",
+	0xFC0D:  "\u062A\u062E",
+	0xFC0E:  "\u062A\u0645",
+	0xFC0F:  "\u062A\u0649",
+	0xFC10:  "\u062A\u0649",
+	0xFC11:  "\u0649\u06DB\u062C",
+	0xFC12:  "\u0649\u06DB\u0645",
+	0xFC13:  "\u0649\u06DB\u0649",
+	0xFC14:  "\u0649\u06DB\u0649",
+	0xFC15:  "\u062C\u062D",
+	0xFC16:  "\u062C\u0645",
+	0xFC17:  "\u062D\u062C",
+	0xFC18:  "\u062D\u0645",
+	0xFC19:  "\u062E\u062C",
+	0xFC1A:  "\u062E\u062D",
+	0xFC1B:  "\u062E\u0645",
+	0xFC1C:  "\u0633\u062C",
+	0xFC1D:  "\u0633\u062D",
+	0xFC1E:  "\u0633\u062E",
+	0xFC1F:  "\u0633\u0645",
+	0xFC20:  "\u0635\u062D",
+	0xFC21:  "\u0635\u0645",
+	0xFC22:  "\u0636\u062C",
+	0xFC23:  "\u0636\u062D",
+	0xFC24:  "\u0636\u062E",
+	0xFC25:  "\u0636\u0645",
+	0xFC26:  "\u0637\u062D",
+	0xFC27:  "\u0637\u0645",
+	0xFC28:  "\u0638\u0645",
+	0xFC29:  "\u0639\u062C",
+	0xFC2A:  "\u0639\u0645",
+	0xFC2B:  "\u063A\u062C",
+	0xFC2C:  "\u063A\u0645",
+	0xFC2D:  "\u0641\u062C",
+	0xFC2E:  "\u0641\u062D",
+	0xFC2F:  "\u0641\u062E",
+	0xFC30:  "\u0641\u0645",
+	0xFC31:  "\u0641\u0649",
+	0xFC32:  "\u0641\u0649",
+	0xFC33:  "\u0642\u062D",
+	0xFC34:  "\u0642\u0645",
+	0xFC35:  "\u0642\u0649",
+	0xFC36:  "\u0642\u0649",
+	0xFC37:  "\u0643l",
+	0xFC38:  "\u0643\u062C",
+	0xFC39:  "\u0643\u062D",
+	0xFC3A:  "\u0643\u062E",
+	0xFC3B:  "\u0643\u0644",
+	0xFC3C:  "\u0643\u0645",
+	0xFC3D:  "\u0643\u0649",
+	0xFC3E:  "\u0643\u0649",
+	0xFC3F:  "\u0644\u062C",
+	0xFC40:  "\u0644\u062D",
+	0xFC41:  "\u0644\u062E",
+	0xFC42:  "\u0644\u0645",
+	0xFC43:  "\u0644\u0649",
+	0xFC44:  "\u0644\u0649",
+	0xFC45:  "\u0645\u062C",
+	0xFC46:  "\u0645\u062D",
+	0xFC47:  "\u0645\u062E",
+	0xFC48:  "\u0645\u0645",
+	0xFC49:  "\u0645\u0649",
+	0xFC4A:  "\u0645\u0649",
+	0xFC4B:  "\u0628\u062E",
+	0xFC4C:  "\u0646\u062D",
+	0xFC4D:  "\u0646\u062E",
+	0xFC4E:  "\u0646\u0645",
+	0xFC4F:  "\u0646\u0649",
+	0xFC50:  "\u0646\u0649",
+	0xFC51:  "o\u062C",
+	0xFC52:  "o\u0645",
+	0xFC53:  "o\u0649",
+	0xFC54:  "o\u0649",
+	0xFC55:  "\u0649\u062C",
+	0xFC56:  "\u0649\u062D",
+	0xFC57:  "\u0649\u062E",
+	0xFC58:  "\u0649\u0645",
+	0xFC59:  "\u0649\u0649",
+	0xFC5A:  "\u0649\u0649",
+	0xFC5B:  "\u0630\u0670",
+	0xFC5C:  "\u0631\u0670",
+	0xFC5D:  "\u0649\u0670",
+	0xFC5E:  "\uFE72\u0651",
+	0xFC5F:  "\uFE74\u0651",
+	0xFC60:  "\uFE76\u0651",
+	0xFC61:  "\uFE78\u0651",
+	0xFC62:  "\uFE7A\u0651",
+	0xFC63:  "\uFE7C\u0670",
+	0xFC64:  "\u0649\u0674\u0631",
+	0xFC65:  "\u0649\u0674\u0632",
+	0xFC66:  "\u0649\u0674\u0645",
+	0xFC67:  "\u0649\u0674\u0646",
+	0xFC68:  "\u0649\u0674\u0649",
+	0xFC69:  "\u0649\u0674\u0649",
+	0xFC6A:  "\u0628\u0631",
+	0xFC6B:  "\u0628\u0632",
+	0xFC6C:  "\u0628\u0645",
+	0xFC6D:  "\u0628\u0646",
+	0xFC6E:  "\u0628\u0649",
+	0xFC6F:  "\u0628\u0649",
+	0xFC70:  "\u062A\u0631",
+	0xFC71:  "\u062A\u0632",
+	0xFC72:  "\u062A\u0645",
+	0xFC73:  "\u062A\u0646",
+	0xFC74:  "\u062A\u0649",
+	0xFC75:  "\u062A\u0649",
+	0xFC76:  "\u0649\u06DB\u0631",
+	0xFC77:  "\u0649\u06DB\u0632",
+	0xFC78:  "\u0649\u06DB\u0645",
+	0xFC79:  "\u0649\u06DB\u0646",
+	0xFC7A:  "\u0649\u06DB\u0649",
+	0xFC7B:  "\u0649\u06DB\u0649",
+	0xFC7C:  "\u0641\u0649",
+	0xFC7D:  "\u0641\u0649",
+	0xFC7E:  "\u0642\u0649",
+	0xFC7F:  "\u0642\u0649",
+	0xFC80:  "\u0643l",
+	0xFC81:  "\u0643\u0644",
+	0xFC82:  "\u0643\u0645",
+	0xFC83:  "\u0643\u0649",
+	0xFC84:  "\u0643\u0649",
+	0xFC85:  "\u0644\u0645",
+	0xFC86:  "\u0644\u0649",
+	0xFC87:  "\u0644\u0649",
+	0xFC88:  "\u0645l",
+	0xFC89:  "\u0645\u0645",
+	0xFC8A:  "\u0646\u0631",
+	0xFC8B:  "\u0646\u0632",
+	0xFC8C:  "\u0646\u0645",
+	0xFC8D:  "\u0646\u0646",
+	0xFC8E:  "\u0646\u0649",
+	0xFC8F:  "\u0646\u0649",
+	0xFC90:  "\u0649\u0670",
+	0xFC91:  "\u0649\u0631",
+	0xFC92:  "\u0649\u0632",
+	0xFC93:  "\u0649\u0645",
+	0xFC94:  "\u0649\u0646",
+	0xFC95:  "\u0649\u0649",
+	0xFC96:  "\u0649\u0649",
+	0xFC97:  "\u0649\u0674\u062C",
+	0xFC98:  "\u0649\u0674\u062D",
+	0xFC99:  "\u0649\u0674\u062E",
+	0xFC9A:  "\u0649\u0674\u0645",
+	0xFC9B:  "\u0649\u0674o",
+	0xFC9C:  "\u0628\u062C",
+	0xFC9D:  "\u0628\u062D",
+	0xFC9E:  "\u0628\u062E",
+	0xFC9F:  "\u0628\u0645",
+	0xFCA0:  "\u0628o",
+	0xFCA1:  "\u062A\u062C",
+	0xFCA2:  "\u062A\u062D",
+	0xFCA3:  "\u062A\u062E",
+	0xFCA4:  "\u062A\u0645",
+	0xFCA5:  "\u062Ao",
+	0xFCA6:  "\u0649\u06DB\u0645",
+	0xFCA7:  "\u062C\u062D",
+	0xFCA8:  "\u062C\u0645",
+	0xFCA9:  "\u062D\u062C",
+	0xFCAA:  "\u062D\u0645",
+	0xFCAB:  "\u062E\u062C",
+	0xFCAC:  "\u062E\u0645",
+	0xFCAD:  "\u0633\u062C",
+	0xFCAE:  "\u0633\u062D",
+	0xFCAF:  "\u0633\u062E",
+	0xFCB0:  "\u0633\u0645",
+	0xFCB1:  "\u0635\u062D",
+	0xFCB2:  "\u0635\u062E",
+	0xFCB3:  "\u0635\u0645",
+	0xFCB4:  "\u0636\u062C",
+	0xFCB5:  "\u0636\u062D",
+	0xFCB6:  "\u0636\u062E",
+	0xFCB7:  "\u0636\u0645",
+	0xFCB8:  "\u0637\u062D",
+	0xFCB9:  "\u0638\u0645",
+	0xFCBA:  "\u0639\u062C",
+	0xFCBB:  "\u0639\u0645",
+	0xFCBC:  "\u063A\u062C",
+	0xFCBD:  "\u063A\u0645",
+	0xFCBE:  "\u0641\u062C",
+	0xFCBF:  "\u0641\u062D",
+	0xFCC0:  "\u0641\u062E",
+	0xFCC1:  "\u0641\u0645",
+	0xFCC2:  "\u0642\u062D",
+	0xFCC3:  "\u0642\u0645",
+	0xFCC4:  "\u0643\u062C",
+	0xFCC5:  "\u0643\u062D",
+	0xFCC6:  "\u0643\u062E",
+	0xFCC7:  "\u0643\u0644",
+	0xFCC8:  "\u0643\u0645",
+	0xFCC9:  "\u0644\u062C",
+	0xFCCA:  "\u0644\u062D",
+	0xFCCB:  "\u0644\u062E",
+	0xFCCC:  "\u0644\u0645",
+	0xFCCD:  "\u0644o",
+	0xFCCE:  "\u0645\u062C",
+	0xFCCF:  "\u0645\u062D",
+	0xFCD0:  "\u0645\u062E",
+	0xFCD1:  "\u0645\u0645",
+	0xFCD2:  "\u0628\u062E",
+	0xFCD3:  "\u0646\u062D",
+	0xFCD4:  "\u0646\u062E",
+	0xFCD5:  "\u0646\u0645",
+	0xFCD6:  "\u0646o",
+	0xFCD7:  "o\u062C",
+	0xFCD8:  "o\u0645",
+	0xFCD9:  "o\u0670",
+	0xFCDA:  "\u0649\u062C",
+	0xFCDB:  "\u0649\u062D",
+	0xFCDC:  "\u0649\u062E",
+	0xFCDD:  "\u0649\u0645",
+	0xFCDE:  "\u0649o",
+	0xFCDF:  "\u0649\u0674\u0645",
+	0xFCE0:  "\u0649\u0674o",
+	0xFCE1:  "\u0628\u0645",
+	0xFCE2:  "\u0628o",
+	0xFCE3:  "\u062A\u0645",
+	0xFCE4:  "\u062Ao",
+	0xFCE5:  "\u0649\u06DB\u0645",
+	0xFCE6:  "\u0649\u06DBo",
+	0xFCE7:  "\u0633\u0645",
+	0xFCE8:  "\u0633o",
+	0xFCE9:  "\u0633\u06DB\u0645",
+	0xFCEA:  "\u0633\u06DBo",
+	0xFCEB:  "\u0643\u0644",
+	0xFCEC:  "\u0643\u0645",
+	0xFCED:  "\u0644\u0645",
+	0xFCEE:  "\u0646\u0645",
+	0xFCEF:  "\u0646o",
+	0xFCF0:  "\u0649\u0645",
+	0xFCF1:  "\u0649o",
+	0xFCF2:  "\uFE77\u0651",
+	0xFCF3:  "\uFE79\u0651",
+	0xFCF4:  "\uFE7B\u0651",
+	0xFCF5:  "\u0637\u0649",
+	0xFCF6:  "\u0637\u0649",
+	0xFCF7:  "\u0639\u0649",
+	0xFCF8:  "\u0639\u0649",
+	0xFCF9:  "\u063A\u0649",
+	0xFCFA:  "\u063A\u0649",
+	0xFCFB:  "\u0633\u0649",
+	0xFCFC:  "\u0633\u0649",
+	0xFCFD:  "\u0633\u06DB\u0649",
+	0xFCFE:  "\u0633\u06DB\u0649",
+	0xFCFF:  "\u062D\u0649",
+	0xFD00:  "\u062D\u0649",
+	0xFD01:  "\u062C\u0649",
+	0xFD02:  "\u062C\u0649",
+	0xFD03:  "\u062E\u0649",
+	0xFD04:  "\u062E\u0649",
+	0xFD05:  "\u0635\u0649",
+	0xFD06:  "\u0635\u0649",
+	0xFD07:  "\u0636\u0649",
+	0xFD08:  "\u0636\u0649",
+	0xFD09:  "\u0633\u06DB\u062C",
+	0xFD0A:  "\u0633\u06DB\u062D",
+	0xFD0B:  "\u0633\u06DB\u062E",
+	0xFD0C:  "\u0633\u06DB\u0645",
+	0xFD0D:  "\u0633\u06DB\u0631",
+	0xFD0E:  "\u0633\u0631",
+	0xFD0F:  "\u0635\u0631",
+	0xFD10:  "\u0636\u0631",
+	0xFD11:  "\u0637\u0649",
+	0xFD12:  "\u0637\u0649",
+	0xFD13:  "\u0639\u0649",
+	0xFD14:  "\u0639\u0649",
+	0xFD15:  "\u063A\u0649",
+	0xFD16:  "\u063A\u0649",
+	0xFD17:  "\u0633\u0649",
+	0xFD18:  "\u0633\u0649",
+	0xFD19:  "\u0633\u06DB\u0649",
+	0xFD1A:  "\u0633\u06DB\u0649",
+	0xFD1B:  "\u062D\u0649",
+	0xFD1C:  "\u062D\u0649",
+	0xFD1D:  "\u062C\u0649",
+	0xFD1E:  "\u062C\u0649",
+	0xFD1F:  "\u062E\u0649",
+	0xFD20:  "\u062E\u0649",
+	0xFD21:  "\u0635\u0649",
+	0xFD22:  "\u0635\u0649",
+	0xFD23:  "\u0636\u0649",
+	0xFD24:  "\u0636\u0649",
+	0xFD25:  "\u0633\u06DB\u062C",
+	0xFD26:  "\u0633\u06DB\u062D",
+	0xFD27:  "\u0633\u06DB\u062E",
+	0xFD28:  "\u0633\u06DB\u0645",
+	0xFD29:  "\u0633\u06DB\u0631",
+	0xFD2A:  "\u0633\u0631",
+	0xFD2B:  "\u0635\u0631",
+	0xFD2C:  "\u0636\u0631",
+	0xFD2D:  "\u0633\u06DB\u062C",
+	0xFD2E:  "\u0633\u06DB\u062D",
+	0xFD2F:  "\u0633\u06DB\u062E",
+	0xFD30:  "\u0633\u06DB\u0645",
+	0xFD31:  "\u0633o",
+	0xFD32:  "\u0633\u06DBo",
+	0xFD33:  "\u0637\u0645",
+	0xFD34:  "\u0633\u062C",
+	0xFD35:  "\u0633\u062D",
+	0xFD36:  "\u0633\u062E",
+	0xFD37:  "\u0633\u06DB\u062C",
+	0xFD38:  "\u0633\u06DB\u062D",
+	0xFD39:  "\u0633\u06DB\u062E",
+	0xFD3A:  "\u0637\u0645",
+	0xFD3B:  "\u0638\u0645",
+	0xFD3C:  "l\u030B",
+	0xFD3D:  "l\u030B",
+	0xFD3E:  "(",
+	0xFD3F:  ")",
+	0xFD50:  "\u062A\u062C\u0645",
+	0xFD51:  "\u062A\u062D\u062C",
+	0xFD52:  "\u062A\u062D\u062C",
+	0xFD53:  "\u062A\u062D\u0645",
+	0xFD54:  "\u062A\u062E\u0645",
+	0xFD55:  "\u062A\u0645\u062C",
+	0xFD56:  "\u062A\u0645\u062D",
+	0xFD57:  "\u062A\u0645\u062E",
+	0xFD58:  "\u062C\u0645\u062D",
+	0xFD59:  "\u062C\u0645\u062D",
+	0xFD5A:  "\u062D\u0645\u0649",
+	0xFD5B:  "\u062D\u0645\u0649",
+	0xFD5C:  "\u0633\u062D\u062C",
+	0xFD5D:  "\u0633\u062C\u062D",
+	0xFD5E:  "\u0633\u062C\u0649",
+	0xFD5F:  "\u0633\u0645\u062D",
+	0xFD60:  "\u0633\u0645\u062D",
+	0xFD61:  "\u0633\u0645\u062C",
+	0xFD62:  "\u0633\u0645\u0645",
+	0xFD63:  "\u0633\u0645\u0645",
+	0xFD64:  "\u0635\u062D\u062D",
+	0xFD65:  "\u0635\u062D\u062D",
+	0xFD66:  "\u0635\u0645\u0645",
+	0xFD67:  "\u0633\u06DB\u062D\u0645",
+	0xFD68:  "\u0633\u06DB\u062D\u0645",
+	0xFD69:  "\u0633\u06DB\u062C\u0649",
+	0xFD6A:  "\u0633\u06DB\u0645\u062E",
+	0xFD6B:  "\u0633\u06DB\u0645\u062E",
+	0xFD6C:  "\u0633\u06DB\u0645\u0645",
+	0xFD6D:  "\u0633\u06DB\u0645\u0645",
+	0xFD6E:  "\u0636\u062D\u0649",
+	0xFD6F:  "\u0636\u062E\u0645",
+	0xFD70:  "\u0636\u062E\u0645",
+	0xFD71:  "\u0637\u0645\u062D",
+	0xFD72:  "\u0637\u0645\u062D",
+	0xFD73:  "\u0637\u0645\u0645",
+	0xFD74:  "\u0637\u0645\u0649",
+	0xFD75:  "\u0639\u062C\u0645",
+	0xFD76:  "\u0639\u0645\u0645",
+	0xFD77:  "\u0639\u0645\u0645",
+	0xFD78:  "\u0639\u0645\u0649",
+	0xFD79:  "\u063A\u0645\u0645",
+	0xFD7A:  "\u063A\u0645\u0649",
+	0xFD7B:  "\u063A\u0645\u0649",
+	0xFD7C:  "\u0641\u062E\u0645",
+	0xFD7D:  "\u0641\u062E\u0645",
+	0xFD7E:  "\u0642\u0645\u062D",
+	0xFD7F:  "\u0642\u0645\u0645",
+	0xFD80:  "\u0644\u062D\u0645",
+	0xFD81:  "\u0644\u062D\u0649",
+	0xFD82:  "\u0644\u062D\u0649",
+	0xFD83:  "\u0644\u062C\u062C",
+	0xFD84:  "\u0644\u062C\u062C",
+	0xFD85:  "\u0644\u062E\u0645",
+	0xFD86:  "\u0644\u062E\u0645",
+	0xFD87:  "\u0644\u0645\u062D",
+	0xFD88:  "\u0644\u0645\u062D",
+	0xFD89:  "\u0645\u062D\u062C",
+	0xFD8A:  "\u0645\u062D\u0645",
+	0xFD8B:  "\u0645\u062D\u0649",
+	0xFD8C:  "\u0645\u062C\u062D",
+	0xFD8D:  "\u0645\u062C\u0645",
+	0xFD8E:  "\u0645\u062E\u062C",
+	0xFD8F:  "\u0645\u062E\u0645",
+	0xFD92:  "\u0645\u062C\u062E",
+	0xFD93:  "o\u0645\u062C",
+	0xFD94:  "o\u0645\u0645",
+	0xFD95:  "\u0646\u062D\u0645",
+	0xFD96:  "\u0646\u062D\u0649",
+	0xFD97:  "\u0646\u062C\u0645",
+	0xFD98:  "\u0646\u062C\u0645",
+	0xFD99:  "\u0646\u062C\u0649",
+	0xFD9A:  "\u0646\u0645\u0649",
+	0xFD9B:  "\u0646\u0645\u0649",
+	0xFD9C:  "\u0649\u0645\u0645",
+	0xFD9D:  "\u0649\u0645\u0645",
+	0xFD9E:  "\u0628\u062E\u0649",
+	0xFD9F:  "\u062A\u062C\u0649",
+	0xFDA0:  "\u062A\u062C\u0649",
+	0xFDA1:  "\u062A\u062E\u0649",
+	0xFDA2:  "\u062A\u062E\u0649",
+	0xFDA3:  "\u062A\u0645\u0649",
+	0xFDA4:  "\u062A\u0645\u0649",
+	0xFDA5:  "\u062C\u0645\u0649",
+	0xFDA6:  "\u062C\u062D\u0649",
+	0xFDA7:  "\u062C\u0645\u0649",
+	0xFDA8:  "\u0633\u062E\u0649",
+	0xFDA9:  "\u0635\u062D\u0649",
+	0xFDAA:  "\u0633\u06DB\u062D\u0649",
+	0xFDAB:  "\u0636\u062D\u0649",
+	0xFDAC:  "\u0644\u062C\u0649",
+	0xFDAD:  "\u0644\u0645\u0649",
+	0xFDAE:  "\u0649\u062D\u0649",
+	0xFDAF:  "\u0649\u062C\u0649",
+	0xFDB0:  "\u0649\u0645\u0649",
+	0xFDB1:  "\u0645\u0645\u0649",
+	0xFDB2:  "\u0642\u0645\u0649",
+	0xFDB3:  "\u0646\u062D\u0649",
+	0xFDB4:  "\u0642\u0645\u062D",
+	0xFDB5:  "\u0644\u062D\u0645",
+	0xFDB6:  "\u0639\u0645\u0649",
+	0xFDB7:  "\u0643\u0645\u0649",
+	0xFDB8:  "\u0646\u062C\u062D",
+	0xFDB9:  "\u0645\u062E\u0649",
+	0xFDBA:  "\u0644\u062C\u0645",
+	0xFDBB:  "\u0643\u0645\u0645",
+	0xFDBC:  "\u0644\u062C\u0645",
+	0xFDBD:  "\u0646\u062C\u062D",
+	0xFDBE:  "\u062C\u062D\u0649",
+	0xFDBF:  "\u062D\u062C\u0649",
+	0xFDC0:  "\u0645\u062C\u0649",
+	0xFDC1:  "\u0641\u0645\u0649",
+	0xFDC2:  "\u0628\u062D\u0649",
+	0xFDC3:  "\u0643\u0645\u0645",
+	0xFDC4:  "\u0639\u062C\u0645",
+	0xFDC5:  "\u0635\u0645\u0645",
+	0xFDC6:  "\u0633\u062E\u0649",
+	0xFDC7:  "\u0646\u062C\u0649",
+	0xFDF0:  "\u0635\u0644\u0649",
+	0xFDF1:  "\u0642\u0644\u0649",
+	0xFDF2:  "l\u0644\u0644\u0651\u0670o",
+	0xFDF3:  "l\u0643\u0628\u0631",
+	0xFDF4:  "\u0645\u062D\u0645\u062F",
+	0xFDF5:  "\u0635\u0644\u0639\u0645",
+	0xFDF6:  "\u0631\u0633\u0648\u0644",
+	0xFDF7:  "\u0639\u0644\u0649o",
+	0xFDF8:  "\u0648\u0633\u0644\u0645",
+	0xFDF9:  "\u0635\u0644\u0649",
+	0xFDFA:  "\u0635\u0644\u0649 l\u0644\u0644o \u0639\u0644\u0649o \u0648\u0633\u0644\u0645",
+	0xFDFB:  "\u062C\u0644 \u062C\u0644l\u0644o",
+	0xFDFC:  "\u0631\u0649l\u0644",
+	0xFE19:  "\u2D57",
+	0xFE30:  ":",
+	0xFE31:  "\u2502",
+	0xFE34:  "\u2307",
+	0xFE35:  "\u23DC",
+	0xFE36:  "\u23DD",
+	0xFE37:  "\u23DE",
+	0xFE38:  "\u23DF",
+	0xFE39:  "\u23E0",
+	0xFE3A:  "\u23E1",
+	0xFE49:  "\u02C9",
+	0xFE4A:  "\u02C9",
+	0xFE4B:  "\u02C9",
+	0xFE4C:  "\u02C9",
+	0xFE4D:  "_",
+	0xFE4E:  "_",
+	0xFE4F:  "_",
+	0xFE58:  "-",
+	0xFE68:  "\u005C",
+	0xFE80:  "\u0621",
+	0xFE81:  "\u0622",
+	0xFE82:  "\u0622",
+	0xFE83:  "l\u0674",
+	0xFE84:  "l\u0674",
+	0xFE85:  "\u0648\u0674",
+	0xFE86:  "\u0648\u0674",
+	0xFE87:  "l\u0655",
+	0xFE88:  "l\u0655",
+	0xFE89:  "\u0649\u0674",
+	0xFE8A:  "\u0649\u0674",
+	0xFE8B:  "\u0649\u0674",
+	0xFE8C:  "\u0649\u0674",
+	0xFE8D:  "l",
+	0xFE8E:  "l",
+	0xFE8F:  "\u0628",
+	0xFE90:  "\u0628",
+	0xFE91:  "\u0628",
+	0xFE92:  "\u0628",
+	0xFE93:  "\u0629",
+	0xFE94:  "\u0629",
+	0xFE95:  "\u062A",
+	0xFE96:  "\u062A",
+	0xFE97:  "\u062A",
+	0xFE98:  "\u062A",
+	0xFE99:  "\u0649\u06DB",
+	0xFE9A:  "\u0649\u06DB",
+	0xFE9B:  "\u0649\u06DB",
+	0xFE9C:  "\u0649\u06DB",
+	0xFE9D:  "\u062C",
+	0xFE9E:  "\u062C",
+	0xFE9F:  "\u062C",
+	0xFEA0:  "\u062C",
+	0xFEA1:  "\u062D",
+	0xFEA2:  "\u062D",
+	0xFEA3:  "\u062D",
+	0xFEA4:  "\u062D",
+	0xFEA5:  "\u062E",
+	0xFEA6:  "\u062E",
+	0xFEA7:  "\u062E",
+	0xFEA8:  "\u062E",
+	0xFEA9:  "\u062F",
+	0xFEAA:  "\u062F",
+	0xFEAB:  "\u0630",
+	0xFEAC:  "\u0630",
+	0xFEAD:  "\u0631",
+	0xFEAE:  "\u0631",
+	0xFEAF:  "\u0632",
+	0xFEB0:  "\u0632",
+	0xFEB1:  "\u0633",
+	0xFEB2:  "\u0633",
+	0xFEB3:  "\u0633",
+	0xFEB4:  "\u0633",
+	0xFEB5:  "\u0633\u06DB",
+	0xFEB6:  "\u0633\u06DB",
+	0xFEB7:  "\u0633\u06DB",
+	0xFEB8:  "\u0633\u06DB",
+	0xFEB9:  "\u0635",
+	0xFEBA:  "\u0635",
+	0xFEBB:  "\u0635",
+	0xFEBC:  "\u0635",
+	0xFEBD:  "\u0636",
+	0xFEBE:  "\u0636",
+	0xFEBF:  "\u0636",
+	0xFEC0:  "\u0636",
+	0xFEC1:  "\u0637",
+	0xFEC2:  "\u0637",
+	0xFEC3:  "\u0637",
+	0xFEC4:  "\u0637",
+	0xFEC5:  "\u0638",
+	0xFEC6:  "\u0638",
+	0xFEC7:  "\u0638",
+	0xFEC8:  "\u0638",
+	0xFEC9:  "\u0639",
+	0xFECA:  "\u0639",
+	0xFECB:  "\u0639",
+	0xFECC:  "\u0639",
+	0xFECD:  "\u063A",
+	0xFECE:  "\u063A",
+	0xFECF:  "\u063A",
+	0xFED0:  "\u063A",
+	0xFED1:  "\u0641",
+	0xFED2:  "\u0641",
+	0xFED3:  "\u0641",
+	0xFED4:  "\u0641",
+	0xFED5:  "\u0642",
+	0xFED6:  "\u0642",
+	0xFED7:  "\u0642",
+	0xFED8:  "\u0642",
+	0xFED9:  "\u0643",
+	0xFEDA:  "\u0643",
+	0xFEDB:  "\u0643",
+	0xFEDC:  "\u0643",
+	0xFEDD:  "\u0644",
+	0xFEDE:  "\u0644",
+	0xFEDF:  "\u0644",
+	0xFEE0:  "\u0644",
+	0xFEE1:  "\u0645",
+	0xFEE2:  "\u0645",
+	0xFEE3:  "\u0645",
+	0xFEE4:  "\u0645",
+	0xFEE5:  "\u0646",
+	0xFEE6:  "\u0646",
+	0xFEE7:  "\u0646",
+	0xFEE8:  "\u0646",
+	0xFEE9:  "o",
+	0xFEEA:  "o",
+	0xFEEB:  "o",
+	0xFEEC:  "o",
+	0xFEED:  "\u0648",
+	0xFEEE:  "\u0648",
+	0xFEEF:  "\u0649",
+	0xFEF0:  "\u0649",
+	0xFEF1:  "\u0649",
+	0xFEF2:  "\u0649",
+	0xFEF3:  "\u0649",
+	0xFEF4:  "\u0649",
+	0xFEF5:  "\u0644\u0622",
+	0xFEF6:  "\u0644\u0622",
+	0xFEF7:  "\u0644l\u0674",
+	0xFEF8:  "\u0644l\u0674",
+	0xFEF9:  "\u0644l\u0655",
+	0xFEFA:  "\u0644l\u0655",
+	0xFEFB:  "\u0644l",
+	0xFEFC:  "\u0644l",
+	0xFF01:  "!",
+	0xFF02:  "''",
+	0xFF07:  "'",
+	0xFF0D:  "\u30FC",
+	0xFF1A:  ":",
+	0xFF21:  "A",
+	0xFF22:  "B",
+	0xFF23:  "C",
+	0xFF25:  "E",
+	0xFF28:  "H",
+	0xFF29:  "l",
+	0xFF2A:  "J",
+	0xFF2B:  "K",
+	0xFF2D:  "M",
+	0xFF2E:  "N",
+	0xFF2F:  "O",
+	0xFF30:  "P",
+	0xFF33:  "S",
+	0xFF34:  "T",
+	0xFF38:  "X",
+	0xFF39:  "Y",
+	0xFF3A:  "Z",
+	0xFF3B:  "(",
+	0xFF3C:  "\u005C",
+	0xFF3D:  ")",
+	0xFF3E:  "\uFE3F",
+	0xFF40:  "'",
+	0xFF41:  "a",
+	0xFF43:  "c",
+	0xFF45:  "e",
+	0xFF47:  "g",
+	0xFF48:  "h",
+	0xFF49:  "i",
+	0xFF4A:  "j",
+	0xFF4C:  "l",
+	0xFF4F:  "o",
+	0xFF50:  "p",
+	0xFF53:  "s",
+	0xFF56:  "v",
+	0xFF58:  "x",
+	0xFF59:  "y",
+	0xFF5C:  "\u2502",
+	0xFF5E:  "\u301C",
+	0xFF65:  "\u00B7",
+	0xFFE3:  "\u02C9",
+	0xFFE8:  "l",
+	0xFFED:  "\u25AA",
+	0x10101: "\u00B7",
+	0x1018E: "N\u030A",
+	0x10196: "X\u0335",
+	0x10197: "V\u0335",
+	0x10198: "l\u0335l\u0335S\u0335",
+	0x10199: "l\u0335l\u0335",
+	0x101A0: "\u0554",
+	0x10282: "B",
+	0x10285: "\u0394",
+	0x10286: "E",
+	0x10287: "F",
+	0x1028A: "l",
+	0x1028D: "\u0245",
+	0x10290: "X",
+	0x10292: "O",
+	0x10294: "\u16DC",
+	0x10295: "P",
+	0x10296: "S",
+	0x10297: "T",
+	0x1029B: "+",
+	0x102A0: "A",
+	0x102A1: "B",
+	0x102A2: "C",
+	0x102A3: "\u0394",
+	0x102A5: "F",
+	0x102AB: "O",
+	0x102AD: "\u03D8",
+	0x102B0: "M",
+	0x102B1: "T",
+	0x102B2: "Y",
+	0x102B3: "\u03A6",
+	0x102B4: "X",
+	0x102B5: "\u03A8",
+	0x102B6: "\u03A9",
+	0x102B8: "\u2D40",
+	0x102CF: "H",
+	0x102E1: "\u062F",
+	0x102E4: "\u0648",
+	0x102E8: "\u0637",
+	0x102F2: "\u0635",
+	0x102F5: "Z",
+	0x10301: "B",
+	0x10302: "C",
+	0x10309: "l",
+	0x10311: "M",
+	0x10312: "\u03D8",
+	0x10315: "T",
+	0x10317: "X",
+	0x1031A: "8",
+	0x1031F: "*",
+	0x10320: "l",
+	0x10322: "X",
+	0x103D1: "\U00010382",
+	0x103D3: "\U00010393",
+	0x10401: "\u0190",
+	0x10404: "O",
+	0x10411: "\uA4F6",
+	0x10415: "C",
+	0x1041B: "L",
+	0x1041F: "\u2C70",
+	0x10420: "S",
+	0x10423: "\u0186",
+	0x10425: "\u0418",
+	0x10429: "\uA793",
+	0x1042A: "\u029A",
+	0x1042C: "o",
+	0x1043D: "c",
+	0x1043F: "\u0277",
+	0x10442: "\u025E",
+	0x10443: "\u029F",
+	0x10448: "s",
+	0x1044B: "\u0254",
+	0x1044D: "\u1D0E",
+	0x104A0: "\U00010486",
+	0x104B0: "\u0245",
+	0x104B4: "R",
+	0x104BC: "\u04C3",
+	0x104C2: "O",
+	0x104C3: "\u0298",
+	0x104C4: "\u00DE",
+	0x104CD: "\u040B",
+	0x104CE: "U",
+	0x104D0: "\u16E6",
+	0x104D1: "\u03A8",
+	0x104D2: "7",
+	0x104D8: "\u028C",
+	0x104DB: "\u03BB",
+	0x104EA: "o",
+	0x104EB: "\uA669",
+	0x104F6: "u",
+	0x104F9: "\u03C8",
+	0x10513: "N",
+	0x10516: "O",
+	0x10518: "K",
+	0x1051C: "C",
+	0x1051D: "V",
+	0x10525: "F",
+	0x10526: "L",
+	0x10527: "X",
+	0x10A3A: "\u0323",
+	0x10A50: ".",
+	0x10A57: "\U00010A56\U00010A56",
+	0x10CFA: "\U00010CA5",
+	0x10CFC: "\U00010C82",
+	0x10EC6: "\u0646",
+	0x10EC7: "\u0680",
+	0x10ED0: "\u00B0\u0332",
+	0x110BB: "\u0970",
+	0x111C7: "\u0970",
+	0x111CA: "\u0323",
+	0x111CB: "\u093A",
+	0x111DB: "\uA8FC",
+	0x111DC: "\uA8FB",
+	0x111DE: "\u2248",
+	0x11300: "\u030A",
+	0x11413: "\U00011434\U00011442\U00011412",
+	0x11419: "\U00011434\U00011442\U00011418",
+	0x11424: "\U00011434\U00011442\U00011423",
+	0x1142A: "\U00011434\U00011442\U00011429",
+	0x1142D: "\U00011434\U00011442\U0001142C",
+	0x1142F: "\U00011434\U00011442\U0001142E",
+	0x1144C: "\U0001144B\U0001144B",
+	0x11492: "\u0998",
+	0x11494: "\u099A",
+	0x11496: "\u099C",
+	0x11498: "\u099E",
+	0x11499: "\u099F",
+	0x1149B: "\u09A1",
+	0x1149D: "\u09B2",
+	0x1149E: "\u09A4",
+	0x1149F: "\u09A5",
+	0x114A0: "\u09A6",
+	0x114A1: "\u09A7",
+	0x114A2: "\u09A8",
+	0x114A3: "\u09AA",
+	0x114A7: "\u09AE",
+	0x114A8: "\u09AF",
+	0x114A9: "\u09AC",
+	0x114AA: "\u09A3",
+	0x114AB: "\u09B0",
+	0x114AD: "\u09B7",
+	0x114AE: "\u09B8",
+	0x114B0: "\u09BE",
+	0x114B1: "\u09BF",
+	0x114B9: "\u09C7",
+	0x114BC: "\u09CB",
+	0x114BD: "\u09D7",
+	0x114BE: "\u09CC",
+	0x114BF: "\u0306\u0307",
+	0x114C1: "\u0983",
+	0x114C2: "\u09CD",
+	0x114C3: "\u0323",
+	0x114C4: "\u09BD",
+	0x114C5: "w\u0307",
+	0x114D0: "o",
+	0x114D1: "\u09E7",
+	0x114D2: "\u09E8",
+	0x114D6: "\u09EC",
+	0x115D8: "\U00011582",
+	0x115D9: "\U00011582",
+	0x115DA: "\U00011583",
+	0x115DB: "\U00011584",
+	0x115DC: "\U000115B2",
+	0x115DD: "\U000115B3",
+	0x11642: "\U00011641\U00011641",
+	0x11700: "rn",
+	0x11706: "v",
+	0x1170A: "w",
+	0x1170E: "w",
+	0x1170F: "w",
+	0x118A0: "V",
+	0x118A2: "F",
+	0x118A3: "L",
+	0x118A4: "Y",
+	0x118A6: "E",
+	0x118A8: "\u2207",
+	0x118A9: "Z",
+	0x118AC: "9",
+	0x118AE: "E",
+	0x118AF: "4",
+	0x118B2: "L",
+	0x118B5: "O",
+	0x118B7: "\u16DC",
+	0x118B8: "U",
+	0x118BB: "5",
+	0x118BC: "T",
+	0x118C0: "v",
+	0x118C1: "s",
+	0x118C2: "F",
+	0x118C3: "i",
+	0x118C4: "z",
+	0x118C6: "7",
+	0x118C8: "o",
+	0x118CA: "3",
+	0x118CC: "9",
+	0x118CE: "\uA793",
+	0x118D5: "6",
+	0x118D6: "9",
+	0x118D7: "o",
+	0x118D8: "u",
+	0x118DC: "y",
+	0x118E0: "O",
+	0x118E3: "rn",
+	0x118E4: "\u0669",
+	0x118E5: "Z",
+	0x118E6: "W",
+	0x118E9: "C",
+	0x118EC: "X",
+	0x118EF: "W",
+	0x118F2: "C",
+	0x11AE6: "\U00011AE5\U00011AEF",
+	0x11AE7: "\U00011AE5\U00011AF0",
+	0x11AE8: "\U00011AE5\U00011AE5",
+	0x11AE9: "\U00011AE5\U00011AE5\U00011AEF",
+	0x11AEA: "\U00011AE5\U00011AE5\U00011AF0",
+	0x11AEC: "\U00011AEB\U00011AEF",
+	0x11AED: "\U00011AEB\U00011AEB",
+	0x11AEE: "\U00011AEB\U00011AEB\U00011AEF",
+	0x11AF4: "\U00011AF3\U00011AEF",
+	0x11AF5: "\U00011AF3\U00011AF0",
+	0x11AF6: "\U00011AF3\U00011AF3",
+	0x11AF7: "\U00011AF3\U00011AF3\U00011AEF",
+	0x11AF8: "\U00011AF3\U00011AF3\U00011AF0",
+	0x11B60: "\u093A",
+	0x11B66: "\u0306",
+	0x11C42: "\U00011C41\U00011C41",
+	0x11CB2: "\U00011CAA",
+	0x11DD9: ":",
+	0x11DDA: "l",
+	0x11DE0: "O",
+	0x11DE1: "l",
+	0x12038: "\U0001039A",
+	0x132F9: "\U0001099E",
+	0x16EA6: "\u03A0",
+	0x16EAA: "l",
+	0x16EB6: "b",
+	0x16EC1: "\u03C0",
+	0x16ED1: "\u0185",
+	0x16F07: "\u0393",
+	0x16F08: "V",
+	0x16F0A: "T",
+	0x16F16: "L",
+	0x16F1A: "\u0394",
+	0x16F1C: "\uA658",
+	0x16F26: "\uA4F6",
+	0x16F28: "l",
+	0x16F2D: "\u0190",
+	0x16F35: "R",
+	0x16F3A: "S",
+	0x16F3B: "3",
+	0x16F3D: "\u0245",
+	0x16F3F: ">",
+	0x16F40: "A",
+	0x16F42: "U",
+	0x16F43: "Y",
+	0x16F51: "'",
+	0x16F52: "'",
+	0x16FF2: "\u513F",
+	0x1CCD6: "A",
+	0x1CCD7: "B",
+	0x1CCD8: "C",
+	0x1CCD9: "D",
+	0x1CCDA: "E",
+	0x1CCDB: "F",
+	0x1CCDC: "G",
+	0x1CCDD: "H",
+	0x1CCDE: "l",
+	0x1CCDF: "J",
+	0x1CCE0: "K",
+	0x1CCE1: "L",
+	0x1CCE2: "M",
+	0x1CCE3: "N",
+	0x1CCE4: "O",
+	0x1CCE5: "P",
+	0x1CCE6: "Q",
+	0x1CCE7: "R",
+	0x1CCE8: "S",
+	0x1CCE9: "T",
+	0x1CCEA: "U",
+	0x1CCEB: "V",
+	0x1CCEC: "W",
+	0x1CCED: "X",
+	0x1CCEE: "Y",
+	0x1CCEF: "Z",
+	0x1CCF0: "O",
+	0x1CCF1: "l",
+	0x1CCF2: "2",
+	0x1CCF3: "3",
+	0x1CCF4: "4",
+	0x1CCF5: "5",
+	0x1CCF6: "6",
+	0x1CCF7: "7",
+	0x1CCF8: "8",
+	0x1CCF9: "9",
+	0x1CCFB: "\U0001F6F8",
+	0x1CEEF: "\u2D42",
+	0x1D114: "{",
+	0x1D16D: ".",
+	0x1D202: "\u04FE",
+	0x1D206: "3",
+	0x1D20B: "\u0418",
+	0x1D20D: "V",
+	0x1D20F: "\u005C",
+	0x1D212: "7",
+	0x1D213: "F",
+	0x1D214: "\U000102BC",
+	0x1D215: "\uA4F6",
+	0x1D216: "R",
+	0x1D217: "\u2C6F",
+	0x1D21A: "O\u0335",
+	0x1D21B: "\u2144",
+	0x1D21C: "\uA4D5",
+	0x1D221: "\u0190",
+	0x1D222: "\u0460",
+	0x1D22A: "L",
+	0x1D22B: "\uA4F6",
+	0x1D230: "\uA7FB",
+	0x1D236: "<",
+	0x1D237: ">",
+	0x1D238: "\u228F",
+	0x1D239: "\u2290",
+	0x1D23A: "/",
+	0x1D23B: "\u005C",
+	0x1D23F: "\u16CB",
+	0x1D245: "\u0548",
+	0x1D400: "A",
+	0x1D401: "B",
+	0x1D402: "C",
+	0x1D403: "D",
+	0x1D404: "E",
+	0x1D405: "F",
+	0x1D406: "G",
+	0x1D407: "H",
+	0x1D408: "l",
+	0x1D409: "J",
+	0x1D40A: "K",
+	0x1D40B: "L",
+	0x1D40C: "M",
+	0x1D40D: "N",
+	0x1D40E: "O",
+	0x1D40F: "P",
+	0x1D410: "Q",
+	0x1D411: "R",
+	0x1D412: "S",
+	0x1D413: "T",
+	0x1D414: "U",
+	0x1D415: "V",
+	0x1D416: "W",
+	0x1D417: "X",
+	0x1D418: "Y",
+	0x1D419: "Z",
+	0x1D41A: "a",
+	0x1D41B: "b",
+	0x1D41C: "c",
+	0x1D41D: "d",
+	0x1D41E: "e",
+	0x1D41F: "f",
+	0x1D420: "g",
+	0x1D421: "h",
+	0x1D422: "i",
+	0x1D423: "j",
+	0x1D424: "k",
+	0x1D425: "l",
+	0x1D426: "rn",
+	0x1D427: "n",
+	0x1D428: "o",
+	0x1D429: "p",
+	0x1D42A: "q",
+	0x1D42B: "r",
+	0x1D42C: "s",
+	0x1D42D: "t",
+	0x1D42E: "u",
+	0x1D42F: "v",
+	0x1D430: "w",
+	0x1D431: "x",
+	0x1D432: "y",
+	0x1D433: "z",
+	0x1D434: "A",
+	0x1D435: "B",
+	0x1D436: "C",
+	0x1D437: "D",
+	0x1D438: "E",
+	0x1D439: "F",
+	0x1D43A: "G",
+	0x1D43B: "H",
+	0x1D43C: "l",
+	0x1D43D: "J",
+	0x1D43E: "K",
+	0x1D43F: "L",
+	0x1D440: "M",
+	0x1D441: "N",
+	0x1D442: "O",
+	0x1D443: "P",
+	0x1D444: "Q",
+	0x1D445: "R",
+	0x1D446: "S",
+	0x1D447: "T",
+	0x1D448: "U",
+	0x1D449: "V",
+	0x1D44A: "W",
+	0x1D44B: "X",
+	0x1D44C: "Y",
+	0x1D44D: "Z",
+	0x1D44E: "a",
+	0x1D44F: "b",
+	0x1D450: "c",
+	0x1D451: "d",
+	0x1D452: "e",
+	0x1D453: "f",
+	0x1D454: "g",
+	0x1D456: "i",
+	0x1D457: "j",
+	0x1D458: "k",
+	0x1D459: "l",
+	0x1D45A: "rn",
+	0x1D45B: "n",
+	0x1D45C: "o",
+	0x1D45D: "p",
+	0x1D45E: "q",
+	0x1D45F: "r",
+	0x1D460: "s",
+	0x1D461: "t",
+	0x1D462: "u",
+	0x1D463: "v",
+	0x1D464: "w",
+	0x1D465: "x",
+	0x1D466: "y",
+	0x1D467: "z",
+	0x1D468: "A",
+	0x1D469: "B",
+	0x1D46A: "C",
+	0x1D46B: "D",
+	0x1D46C: "E",
+	0x1D46D: "F",
+	0x1D46E: "G",
+	0x1D46F: "H",
+	0x1D470: "l",
+	0x1D471: "J",
+	0x1D472: "K",
+	0x1D473: "L",
+	0x1D474: "M",
+	0x1D475: "N",
+	0x1D476: "O",
+	0x1D477: "P",
+	0x1D478: "Q",
+	0x1D479: "R",
+	0x1D47A: "S",
+	0x1D47B: "T",
+	0x1D47C: "U",
+	0x1D47D: "V",
+	0x1D47E: "W",
+	0x1D47F: "X",
+	0x1D480: "Y",
+	0x1D481: "Z",
+	0x1D482: "a",
+	0x1D483: "b",
+	0x1D484: "c",
+	0x1D485: "d",
+	0x1D486: "e",
+	0x1D487: "f",
+	0x1D488: "g",
+	0x1D489: "h",
+	0x1D48A: "i",
+	0x1D48B: "j",
+	0x1D48C: "k",
+	0x1D48D: "l",
+	0x1D48E: "rn",
+	0x1D48F: "n",
+	0x1D490: "o",
+	0x1D491: "p",
+	0x1D492: "q",
+	0x1D493: "r",
+	0x1D494: "s",
+	0x1D495: "t",
+	0x1D496: "u",
+	0x1D497: "v",
+	0x1D498: "w",
+	0x1D499: "x",
+	0x1D49A: "y",
+	0x1D49B: "z",
+	0x1D49C: "A",
+	0x1D49E: "C",
+	0x1D49F: "D",
+	0x1D4A2: "G",
+	0x1D4A5: "J",
+	0x1D4A6: "K",
+	0x1D4A9: "N",
+	0x1D4AA: "O",
+	0x1D4AB: "P",
+	0x1D4AC: "Q",
+	0x1D4AE: "S",
+	0x1D4AF: "T",
+	0x1D4B0: "U",
+	0x1D4B1: "V",
+	0x1D4B2: "W",
+	0x1D4B3: "X",
+	0x1D4B4: "Y",
+	0x1D4B5: "Z",
+	0x1D4B6: "a",
+	0x1D4B7: "b",
+	0x1D4B8: "c",
+	0x1D4B9: "d",
+	0x1D4BB: "f",
+	0x1D4BD: "h",
+	0x1D4BE: "i",
+	0x1D4BF: "j",
+	0x1D4C0: "k",
+	0x1D4C1: "l",
+	0x1D4C2: "rn",
+	0x1D4C3: "n",
+	0x1D4C5: "p",
+	0x1D4C6: "q",
+	0x1D4C7: "r",
+	0x1D4C8: "s",
+	0x1D4C9: "t",
+	0x1D4CA: "u",
+	0x1D4CB: "v",
+	0x1D4CC: "w",
+	0x1D4CD: "x",
+	0x1D4CE: "y",
+	0x1D4CF: "z",
+	0x1D4D0: "A",
+	0x1D4D1: "B",
+	0x1D4D2: "C",
+	0x1D4D3: "D",
+	0x1D4D4: "E",
+	0x1D4D5: "F",
+	0x1D4D6: "G",
+	0x1D4D7: "H",
+	0x1D4D8: "l",
+	0x1D4D9: "J",
+	0x1D4DA: "K",
+	0x1D4DB: "L",
+	0x1D4DC: "M",
+	0x1D4DD: "N",
+	0x1D4DE: "O",
+	0x1D4DF: "P",
+	0x1D4E0: "Q",
+	0x1D4E1: "R",
+	0x1D4E2: "S",
+	0x1D4E3: "T",
+	0x1D4E4: "U",
+	0x1D4E5: "V",
+	0x1D4E6: "W",
+	0x1D4E7: "X",
+	0x1D4E8: "Y",
+	0x1D4E9: "Z",
+	0x1D4EA: "a",
+	0x1D4EB: "b",
+	0x1D4EC: "c",
+	0x1D4ED: "d",
+	0x1D4EE: "e",
+	0x1D4EF: "f",
+	0x1D4F0: "g",
+	0x1D4F1: "h",
+	0x1D4F2: "i",
+	0x1D4F3: "j",
+	0x1D4F4: "k",
+	0x1D4F5: "l",
+	0x1D4F6: "rn",
+	0x1D4F7: "n",
+	0x1D4F8: "o",
+	0x1D4F9: "p",
+	0x1D4FA: "q",
+	0x1D4FB: "r",
+	0x1D4FC: "s",
+	0x1D4FD: "t",
+	0x1D4FE: "u",
+	0x1D4FF: "v",
+	0x1D500: "w",
+	0x1D501: "x",
+	0x1D502: "y",
+	0x1D503: "z",
+	0x1D504: "A",
+	0x1D505: "B",
+	0x1D507: "D",
+	0x1D508: "E",
+	0x1D509: "F",
+	0x1D50A: "G",
+	0x1D50D: "J",
+	0x1D50E: "K",
+	0x1D50F: "L",
+	0x1D510: "M",
+	0x1D511: "N",
+	0x1D512: "O",
+	0x1D513: "P",
+	0x1D514: "Q",
+	0x1D516: "S",
+	0x1D517: "T",
+	0x1D518: "U",
+	0x1D519: "V",
+	0x1D51A: "W",
+	0x1D51B: "X",
+	0x1D51C: "Y",
+	0x1D51E: "a",
+	0x1D51F: "b",
+	0x1D520: "c",
+	0x1D521: "d",
+	0x1D522: "e",
+	0x1D523: "f",
+	0x1D524: "g",
+	0x1D525: "h",
+	0x1D526: "i",
+	0x1D527: "j",
+	0x1D528: "k",
+	0x1D529: "l",
+	0x1D52A: "rn",
+	0x1D52B: "n",
+	0x1D52C: "o",
+	0x1D52D: "p",
+	0x1D52E: "q",
+	0x1D52F: "r",
+	0x1D530: "s",
+	0x1D531: "t",
+	0x1D532: "u",
+	0x1D533: "v",
+	0x1D534: "w",
+	0x1D535: "x",
+	0x1D536: "y",
+	0x1D537: "z",
+	0x1D538: "A",
+	0x1D539: "B",
+	0x1D53B: "D",
+	0x1D53C: "E",
+	0x1D53D: "F",
+	0x1D53E: "G",
+	0x1D540: "l",
+	0x1D541: "J",
+	0x1D542: "K",
+	0x1D543: "L",
+	0x1D544: "M",
+	0x1D546: "O",
+	0x1D54A: "S",
+	0x1D54B: "T",
+	0x1D54C: "U",
+	0x1D54D: "V",
+	0x1D54E: "W",
+	0x1D54F: "X",
+	0x1D550: "Y",
+	0x1D552: "a",
+	0x1D553: "b",
+	0x1D554: "c",
+	0x1D555: "d",
+	0x1D556: "e",
+	0x1D557: "f",
+	0x1D558: "g",
+	0x1D559: "h",
+	0x1D55A: "i",
+	0x1D55B: "j",
+	0x1D55C: "k",
+	0x1D55D: "l",
+	0x1D55E: "rn",
+	0x1D55F: "n",
+	0x1D560: "o",
+	0x1D561: "p",
+	0x1D562: "q",
+	0x1D563: "r",
+	0x1D564: "s",
+	0x1D565: "t",
+	0x1D566: "u",
+	0x1D567: "v",
+	0x1D568: "w",
+	0x1D569: "x",
+	0x1D56A: "y",
+	0x1D56B: "z",
+	0x1D56C: "A",
+	0x1D56D: "B",
+	0x1D56E: "C",
+	0x1D56F: "D",
+	0x1D570: "E",
+	0x1D571: "F",
+	0x1D572: "G",
+	0x1D573: "H",
+	0x1D574: "l",
+	0x1D575: "J",
+	0x1D576: "K",
+	0x1D577: "L",
+	0x1D578: "M",
+	0x1D579: "N",
+	0x1D57A: "O",
+	0x1D57B: "P",
+	0x1D57C: "Q",
+	0x1D57D: "R",
+	0x1D57E: "S",
+	0x1D57F: "T",
+	0x1D580: "U",
+	0x1D581: "V",
+	0x1D582: "W",
+	0x1D583: "X",
+	0x1D584: "Y",
+	0x1D585: "Z",
+	0x1D586: "a",
+	0x1D587: "b",
+	0x1D588: "c",
+	0x1D589: "d",
+	0x1D58A: "e",
+	0x1D58B: "f",
+	0x1D58C: "g",
+	0x1D58D: "h",
+	0x1D58E: "i",
+	0x1D58F: "j",
+	0x1D590: "k",
+	0x1D591: "l",
+	0x1D592: "rn",
+	0x1D593: "n",
+	0x1D594: "o",
+	0x1D595: "p",
+	0x1D596: "q",
+	0x1D597: "r",
+	0x1D598: "s",
+	0x1D599: "t",
+	0x1D59A: "u",
+	0x1D59B: "v",
+	0x1D59C: "w",
+	0x1D59D: "x",
+	0x1D59E: "y",
+	0x1D59F: "z",
+	0x1D5A0: "A",
+	0x1D5A1: "B",
+	0x1D5A2: "C",
+	0x1D5A3: "D",
+	0x1D5A4: "E",
+	0x1D5A5: "F",
+	0x1D5A6: "G",
+	0x1D5A7: "H",
+	0x1D5A8: "l",
+	0x1D5A9: "J",
+	0x1D5AA: "K",
+	0x1D5AB: "L",
+	0x1D5AC: "M",
+	0x1D5AD: "N",
+	0x1D5AE: "O",
+	0x1D5AF: "P",
+	0x1D5B0: "Q",
+	0x1D5B1: "R",
+	0x1D5B2: "S",
+	0x1D5B3: "T",
+	0x1D5B4: "U",
+	0x1D5B5: "V",
+	0x1D5B6: "W",
+	0x1D5B7: "X",
+	0x1D5B8: "Y",
+	0x1D5B9: "Z",
+	0x1D5BA: "a",
+	0x1D5BB: "b",
+	0x1D5BC: "c",
+	0x1D5BD: "d",
+	0x1D5BE: "e",
+	0x1D5BF: "f",
+	0x1D5C0: "g",
+	0x1D5C1: "h",
+	0x1D5C2: "i",
+	0x1D5C3: "j",
+	0x1D5C4: "k",
+	0x1D5C5: "l",
+	0x1D5C6: "rn",
+	0x1D5C7: "n",
+	0x1D5C8: "o",
+	0x1D5C9: "p",
+	0x1D5CA: "q",
+	0x1D5CB: "r",
+	0x1D5CC: "s",
+	0x1D5CD: "t",
+	0x1D5CE: "u",
+	0x1D5CF: "v",
+	0x1D5D0: "w",
+	0x1D5D1: "x",
+	0x1D5D2: "y",
+	0x1D5D3: "z",
+	0x1D5D4: "A",
+	0x1D5D5: "B",
+	0x1D5D6: "C",
+	0x1D5D7: "D",
+	0x1D5D8: "E",
+	0x1D5D9: "F",
+	0x1D5DA: "G",
+	0x1D5DB: "H",
+	0x1D5DC: "l",
+	0x1D5DD: "J",
+	0x1D5DE: "K",
+	0x1D5DF: "L",
+	0x1D5E0: "M",
+	0x1D5E1: "N",
+	0x1D5E2: "O",
+	0x1D5E3: "P",
+	0x1D5E4: "Q",
+	0x1D5E5: "R",
+	0x1D5E6: "S",
+	0x1D5E7: "T",
+	0x1D5E8: "U",
+	0x1D5E9: "V",
+	0x1D5EA: "W",
+	0x1D5EB: "X",
+	0x1D5EC: "Y",
+	0x1D5ED: "Z",
+	0x1D5EE: "a",
+	0x1D5EF: "b",
+	0x1D5F0: "c",
+	0x1D5F1: "d",
+	0x1D5F2: "e",
+	0x1D5F3: "f",
+	0x1D5F4: "g",
+	0x1D5F5: "h",
+	0x1D5F6: "i",
+	0x1D5F7: "j",
+	0x1D5F8: "k",
+	0x1D5F9: "l",
+	0x1D5FA: "rn",
+	0x1D5FB: "n",
+	0x1D5FC: "o",
+	0x1D5FD: "p",
+	0x1D5FE: "q",
+	0x1D5FF: "r",
+	0x1D600: "s",
+	0x1D601: "t",
+	0x1D602: "u",
+	0x1D603: "v",
+	0x1D604: "w",
+	0x1D605: "x",
+	0x1D606: "y",
+	0x1D607: "z",
+	0x1D608: "A",
+	0x1D609: "B",
+	0x1D60A: "C",
+	0x1D60B: "D",
+	0x1D60C: "E",
+	0x1D60D: "F",
+	0x1D60E: "G",
+	0x1D60F: "H",
+	0x1D610: "l",
+	0x1D611: "J",
+	0x1D612: "K",
+	0x1D613: "L",
+	0x1D614: "M",
+	0x1D615: "N",
+	0x1D616: "O",
+	0x1D617: "P",
+	0x1D618: "Q",
+	0x1D619: "R",
+	0x1D61A: "S",
+	0x1D61B: "T",
+	0x1D61C: "U",
+	0x1D61D: "V",
+	0x1D61E: "W",
+	0x1D61F: "X",
+	0x1D620: "Y",
+	0x1D621: "Z",
+	0x1D622: "a",
+	0x1D623: "b",
+	0x1D624: "c",
+	0x1D625: "d",
+	0x1D626: "e",
+	0x1D627: "f",
+	0x1D628: "g",
+	0x1D629: "h",
+	0x1D62A: "i",
+	0x1D62B: "j",
+	0x1D62C: "k",
+	0x1D62D: "l",
+	0x1D62E: "rn",
+	0x1D62F: "n",
+	0x1D630: "o",
+	0x1D631: "p",
+	0x1D632: "q",
+	0x1D633: "r",
+	0x1D634: "s",
+	0x1D635: "t",
+	0x1D636: "u",
+	0x1D637: "v",
+	0x1D638: "w",
+	0x1D639: "x",
+	0x1D63A: "y",
+	0x1D63B: "z",
+	0x1D63C: "A",
+	0x1D63D: "B",
+	0x1D63E: "C",
+	0x1D63F: "D",
+	0x1D640: "E",
+	0x1D641: "F",
+	0x1D642: "G",
+	0x1D643: "H",
+	0x1D644: "l",
+	0x1D645: "J",
+	0x1D646: "K",
+	0x1D647: "L",
+	0x1D648: "M",
+	0x1D649: "N",
+	0x1D64A: "O",
+	0x1D64B: "P",
+	0x1D64C: "Q",
+	0x1D64D: "R",
+	0x1D64E: "S",
+	0x1D64F: "T",
+	0x1D650: "U",
+	0x1D651: "V",
+	0x1D652: "W",
+	0x1D653: "X",
+	0x1D654: "Y",
+	0x1D655: "Z",
+	0x1D656: "a",
+	0x1D657: "b",
+	0x1D658: "c",
+	0x1D659: "d",
+	0x1D65A: "e",
+	0x1D65B: "f",
+	0x1D65C: "g",
+	0x1D65D: "h",
+	0x1D65E: "i",
+	0x1D65F: "j",
+	0x1D660: "k",
+	0x1D661: "l",
+	0x1D662: "rn",
+	0x1D663: "n",
+	0x1D664: "o",
+	0x1D665: "p",
+	0x1D666: "q",
+	0x1D667: "r",
+	0x1D668: "s",
+	0x1D669: "t",
+	0x1D66A: "u",
+	0x1D66B: "v",
+	0x1D66C: "w",
+	0x1D66D: "x",
+	0x1D66E: "y",
+	0x1D66F: "z",
+	0x1D670: "A",
+	0x1D671: "B",
+	0x1D672: "C",
+	0x1D673: "D",
+	0x1D674: "E",
+	0x1D675: "F",
+	0x1D676: "G",
+	0x1D677: "H",
+	0x1D678: "l",
+	0x1D679: "J",
+	0x1D67A: "K",
+	0x1D67B: "L",
+	0x1D67C: "M",
+	0x1D67D: "N",
+	0x1D67E: "O",
+	0x1D67F: "P",
+	0x1D680: "Q",
+	0x1D681: "R",
+	0x1D682: "S",
+	0x1D683: "T",
+	0x1D684: "U",
+	0x1D685: "V",
+	0x1D686: "W",
+	0x1D687: "X",
+	0x1D688: "Y",
+	0x1D689: "Z",
+	0x1D68A: "a",
+	0x1D68B: "b",
+	0x1D68C: "c",
+	0x1D68D: "d",
+	0x1D68E: "e",
+	0x1D68F: "f",
+	0x1D690: "g",
+	0x1D691: "h",
+	0x1D692: "i",
+	0x1D693: "j",
+	0x1D694: "k",
+	0x1D695: "l",
+	0x1D696: "rn",
+	0x1D697: "n",
+	0x1D698: "o",
+	0x1D699: "p",
+	0x1D69A: "q",
+	0x1D69B: "r",
+	0x1D69C: "s",
+	0x1D69D: "t",
+	0x1D69E: "u",
+	0x1D69F: "v",
+	0x1D6A0: "w",
+	0x1D6A1: "x",
+	0x1D6A2: "y",
+	0x1D6A3: "z",
+	0x1D6A4: "i",
+	0x1D6A5: "\u0237",
+	0x1D6A8: "A",
+	0x1D6A9: "B",
+	0x1D6AA: "\u0393",
+	0x1D6AB: "\u0394",
+	0x1D6AC: "E",
+	0x1D6AD: "Z",
+	0x1D6AE: "H",
+	0x1D6AF: "O\u0335",
+	0x1D6B0: "l",
+	0x1D6B1: "K",
+	0x1D6B2: "\u0245",
+	0x1D6B3: "M",
+	0x1D6B4: "N",
+	0x1D6B5: "\u039E",
+	0x1D6B6: "O",
+	0x1D6B7: "\u03A0",
+	0x1D6B8: "P",
+	0x1D6B9: "O\u0335",
+	0x1D6BA: "\u01A9",
+	0x1D6BB: "T",
+	0x1D6BC: "Y",
+	0x1D6BD: "\u03A6",
+	0x1D6BE: "X",
+	0x1D6BF: "\u03A8",
+	0x1D6C0: "\u03A9",
+	0x1D6C1: "\u2207",
+	0x1D6C2: "a",
+	0x1D6C3: "\u00DF",
+	0x1D6C4: "y",
+	0x1D6C5: "\u1E9F",
+	0x1D6C6: "\uA793",
+	0x1D6C7: "\u03B6",
+	0x1D6C8: "n\u0329",
+	0x1D6C9: "O\u0335",
+	0x1D6CA: "i",
+	0x1D6CB: "\u0138",
+	0x1D6CC: "\u03BB",
+	0x1D6CD: "\u03BC",
+	0x1D6CE: "v",
+	0x1D6CF: "\u03BE",
+	0x1D6D0: "o",
+	0x1D6D1: "\u03C0",
+	0x1D6D2: "p",
+	0x1D6D3: "\u03C2",
+	0x1D6D4: "o",
+	0x1D6D5: "\u1D1B",
+	0x1D6D6: "u",
+	0x1D6D7: "\u0278",
+	0x1D6D8: "\u03C7",
+	0x1D6D9: "\u03C8",
+	0x1D6DA: "\u03C9",
+	0x1D6DB: "\u2202",
+	0x1D6DC: "\uA793",
+	0x1D6DD: "O\u0335",
+	0x1D6DE: "\u0138",
+	0x1D6DF: "\u0278",
+	0x1D6E0: "p",
+	0x1D6E1: "\u03C0",
+	0x1D6E2: "A",
+	0x1D6E3: "B",
+	0x1D6E4: "\u0393",
+	0x1D6E5: "\u0394",
+	0x1D6E6: "E",
+	0x1D6E7: "Z",
+	0x1D6E8: "H",
+	0x1D6E9: "O\u0335",
+	0x1D6EA: "l",
+	0x1D6EB: "K",
+	0x1D6EC: "\u0245",
+	0x1D6ED: "M",
+	0x1D6EE: "N",
+	0x1D6EF: "\u039E",
+	0x1D6F0: "O",
+	0x1D6F1: "\u03A0",
+	0x1D6F2: "P",
+	0x1D6F3: "O\u0335",
+	0x1D6F4: "\u01A9",
+	0x1D6F5: "T",
+	0x1D6F6: "Y",
+	0x1D6F7: "\u03A6",
+	0x1D6F8: "X",
+	0x1D6F9: "\u03A8",
+	0x1D6FA: "\u03A9",
+	0x1D6FB: "\u2207",
+	0x1D6FC: "a",
+	0x1D6FD: "\u00DF",
+	0x1D6FE: "y",
+	0x1D6FF: "\u1E9F",
+	0x1D700: "\uA793",
+	0x1D701: "\u03B6",
+	0x1D702: "n\u0329",
+	0x1D703: "O\u0335",
+	0x1D704: "i",
+	0x1D705: "\u0138",
+	0x1D706: "\u03BB",
+	0x1D707: "\u03BC",
+	0x1D708: "v",
+	0x1D709: "\u03BE",
+	0x1D70A: "o",
+	0x1D70B: "\u03C0",
+	0x1D70C: "p",
+	0x1D70D: "\u03C2",
+	0x1D70E: "o",
+	0x1D70F: "\u1D1B",
+	0x1D710: "u",
+	0x1D711: "\u0278",
+	0x1D712: "\u03C7",
+	0x1D713: "\u03C8",
+	0x1D714: "\u03C9",
+	0x1D715: "\u2202",
+	0x1D716: "\uA793",
+	0x1D717: "O\u0335",
+	0x1D718: "\u0138",
+	0x1D719: "\u0278",
+	0x1D71A: "p",
+	0x1D71B: "\u03C0",
+	0x1D71C: "A",
+	0x1D71D: "B",
+	0x1D71E: "\u0393",
+	0x1D71F: "\u0394",
+	0x1D720: "E",
+	0x1D721: "Z",
+	0x1D722: "H",
+	0x1D723: "O\u0335",
+	0x1D724: "l",
+	0x1D725: "K",
+	0x1D726: "\u0245",
+	0x1D727: "M",
+	0x1D728: "N",
+	0x1D729: "\u039E",
+	0x1D72A: "O",
+	0x1D72B: "\u03A0",
+	0x1D72C: "P",
+	0x1D72D: "O\u0335",
+	0x1D72E: "\u01A9",
+	0x1D72F: "T",
+	0x1D730: "Y",
+	0x1D731: "\u03A6",
+	0x1D732: "X",
+	0x1D733: "\u03A8",
+	0x1D734: "\u03A9",
+	0x1D735: "\u2207",
+	0x1D736: "a",
+	0x1D737: "\u00DF",
+	0x1D738: "y",
+	0x1D739: "\u1E9F",
+	0x1D73A: "\uA793",
+	0x1D73B: "\u03B6",
+	0x1D73C: "n\u0329",
+	0x1D73D: "O\u0335",
+	0x1D73E: "i",
+	0x1D73F: "\u0138",
+	0x1D740: "\u03BB",
+	0x1D741: "\u03BC",
+	0x1D742: "v",
+	0x1D743: "\u03BE",
+	0x1D744: "o",
+	0x1D745: "\u03C0",
+	0x1D746: "p",
+	0x1D747: "\u03C2",
+	0x1D748: "o",
+	0x1D749: "\u1D1B",
+	0x1D74A: "u",
+	0x1D74B: "\u0278",
+	0x1D74C: "\u03C7",
+	0x1D74D: "\u03C8",
+	0x1D74E: "\u03C9",
+	0x1D74F: "\u2202",
+	0x1D750: "\uA793",
+	0x1D751: "O\u0335",
+	0x1D752: "\u0138",
+	0x1D753: "\u0278",
+	0x1D754: "p",
+	0x1D755: "\u03C0",
+	0x1D756: "A",
+	0x1D757: "B",
+	0x1D758: "\u0393",
+	0x1D759: "\u0394",
+	0x1D75A: "E",
+	0x1D75B: "Z",
+	0x1D75C: "H",
+	0x1D75D: "O\u0335",
+	0x1D75E: "l",
+	0x1D75F: "K",
+	0x1D760: "\u0245",
+	0x1D761: "M",
+	0x1D762: "N",
+	0x1D763: "\u039E",
+	0x1D764: "O",
+	0x1D765: "\u03A0",
+	0x1D766: "P",
+	0x1D767: "O\u0335",
+	0x1D768: "\u01A9",
+	0x1D769: "T",
+	0x1D76A: "Y",
+	0x1D76B: "\u03A6",
+	0x1D76C: "X",
+	0x1D76D: "\u03A8",
+	0x1D76E: "\u03A9",
+	0x1D76F: "\u2207",
+	0x1D770: "a",
+	0x1D771: "\u00DF",
+	0x1D772: "y",
+	0x1D773: "\u1E9F",
+	0x1D774: "\uA793",
+	0x1D775: "\u03B6",
+	0x1D776: "n\u0329",
+	0x1D777: "O\u0335",
+	0x1D778: "i",
+	0x1D779: "\u0138",
+	0x1D77A: "\u03BB",
+	0x1D77B: "\u03BC",
+	0x1D77C: "v",
+	0x1D77D: "\u03BE",
+	0x1D77E: "o",
+	0x1D77F: "\u03C0",
+	0x1D780: "p",
+	0x1D781: "\u03C2",
+	0x1D782: "o",
+	0x1D783: "\u1D1B",
+	0x1D784: "u",
+	0x1D785: "\u0278",
+	0x1D786: "\u03C7",
+	0x1D787: "\u03C8",
+	0x1D788: "\u03C9",
+	0x1D789: "\u2202",
+	0x1D78A: "\uA793",
+	0x1D78B: "O\u0335",
+	0x1D78C: "\u0138",
+	0x1D78D: "\u0278",
+	0x1D78E: "p",
+	0x1D78F: "\u03C0",
+	0x1D790: "A",
+	0x1D791: "B",
+	0x1D792: "\u0393",
+	0x1D793: "\u0394",
+	0x1D794: "E",
+	0x1D795: "Z",
+	0x1D796: "H",
+	0x1D797: "O\u0335",
+	0x1D798: "l",
+	0x1D799: "K",
+	0x1D79A: "\u0245",
+	0x1D79B: "M",
+	0x1D79C: "N",
+	0x1D79D: "\u039E",
+	0x1D79E: "O",
+	0x1D79F: "\u03A0",
+	0x1D7A0: "P",
+	0x1D7A1: "O\u0335",
+	0x1D7A2: "\u01A9",
+	0x1D7A3: "T",
+	0x1D7A4: "Y",
+	0x1D7A5: "\u03A6",
+	0x1D7A6: "X",
+	0x1D7A7: "\u03A8",
+	0x1D7A8: "\u03A9",
+	0x1D7A9: "\u2207",
+	0x1D7AA: "a",
+	0x1D7AB: "\u00DF",
+	0x1D7AC: "y",
+	0x1D7AD: "\u1E9F",
+	0x1D7AE: "\uA793",
+	0x1D7AF: "\u03B6",
+	0x1D7B0: "n\u0329",
+	0x1D7B1: "O\u0335",
+	0x1D7B2: "i",
+	0x1D7B3: "\u0138",
+	0x1D7B4: "\u03BB",
+	0x1D7B5: "\u03BC",
+	0x1D7B6: "v",
+	0x1D7B7: "\u03BE",
+	0x1D7B8: "o",
+	0x1D7B9: "\u03C0",
+	0x1D7BA: "p",
+	0x1D7BB: "\u03C2",
+	0x1D7BC: "o",
+	0x1D7BD: "\u1D1B",
+	0x1D7BE: "u",
+	0x1D7BF: "\u0278",
+	0x1D7C0: "\u03C7",
+	0x1D7C1: "\u03C8",
+	0x1D7C2: "\u03C9",
+	0x1D7C3: "\u2202",
+	0x1D7C4: "\uA793",
+	0x1D7C5: "O\u0335",
+	0x1D7C6: "\u0138",
+	0x1D7C7: "\u0278",
+	0x1D7C8: "p",
+	0x1D7C9: "\u03C0",
+	0x1D7CA: "F",
+	0x1D7CB: "\u03DD",
+	0x1D7CE: "O",
+	0x1D7CF: "l",
+	0x1D7D0: "2",
+	0x1D7D1: "3",
+	0x1D7D2: "4",
+	0x1D7D3: "5",
+	0x1D7D4: "6",
+	0x1D7D5: "7",
+	0x1D7D6: "8",
+	0x1D7D7: "9",
+	0x1D7D8: "O",
+	0x1D7D9: "l",
+	0x1D7DA: "2",
+	0x1D7DB: "3",
+	0x1D7DC: "4",
+	0x1D7DD: "5",
+	0x1D7DE: "6",
+	0x1D7DF: "7",
+	0x1D7E0: "8",
+	0x1D7E1: "9",
+	0x1D7E2: "O",
+	0x1D7E3: "l",
+	0x1D7E4: "2",
+	0x1D7E5: "3",
+	0x1D7E6: "4",
+	0x1D7E7: "5",
+	0x1D7E8: "6",
+	0x1D7E9: "7",
+	0x1D7EA: "8",
+	0x1D7EB: "9",
+	0x1D7EC: "O",
+	0x1D7ED: "l",
+	0x1D7EE: "2",
+	0x1D7EF: "3",
+	0x1D7F0: "4",
+	0x1D7F1: "5",
+	0x1D7F2: "6",
+	0x1D7F3: "7",
+	0x1D7F4: "8",
+	0x1D7F5: "9",
+	0x1D7F6: "O",
+	0x1D7F7: "l",
+	0x1D7F8: "2",
+	0x1D7F9: "3",
+	0x1D7FA: "4",
+	0x1D7FB: "5",
+	0x1D7FC: "6",
+	0x1D7FD: "7",
+	0x1D7FE: "8",
+	0x1D7FF: "9",
+	0x1E6E9: "+",
+	0x1E6EE: "\u1AC8",
+	0x1E8C7: "l",
+	0x1E8C8: "\u2220",
+	0x1E8C9: "\u0663",
+	0x1E8CB: "8",
+	0x1E8CC: "\u2202",
+	0x1E8CD: "\u2202\u0335",
+	0x1EE00: "l",
+	0x1EE01: "\u0628",
+	0x1EE02: "\u062C",
+	0x1EE03: "\u062F",
+	0x1EE05: "\u0648",
+	0x1EE06: "\u0632",
+	0x1EE07: "\u062D",
+	0x1EE08: "\u0637",
+	0x1EE09: "\u0649",
+	0x1EE0A: "\u0643",
+	0x1EE0B: "\u0644",
+	0x1EE0C: "\u0645",
+	0x1EE0D: "\u0646",
+	0x1EE0E: "\u0633",
+	0x1EE0F: "\u0639",
+	0x1EE10: "\u0641",
+	0x1EE11: "\u0635",
+	0x1EE12: "\u0642",
+	0x1EE13: "\u0631",
+	0x1EE14: "\u0633\u06DB",
+	0x1EE15: "\u062A",
+	0x1EE16: "\u0649\u06DB",
+	0x1EE17: "\u062E",
+	0x1EE18: "\u0630",
+	0x1EE19: "\u0636",
+	0x1EE1A: "\u0638",
+	0x1EE1B: "\u063A",
+	0x1EE1C: "\u0649",
+	0x1EE1D: "\u0649",
+	0x1EE1E: "\u06A1",
+	0x1EE1F: "\u06A1",
+	0x1EE21: "\u0628",
+	0x1EE22: "\u062C",
+	0x1EE24: "o",
+	0x1EE27: "\u062D",
+	0x1EE29: "\u0649",
+	0x1EE2A: "\u0643",
+	0x1EE2B: "\u0644",
+	0x1EE2C: "\u0645",
+	0x1EE2D: "\u0646",
+	0x1EE2E: "\u0633",
+	0x1EE2F: "\u0639",
+	0x1EE30: "\u0641",
+	0x1EE31: "\u0635",
+	0x1EE32: "\u0642",
+	0x1EE34: "\u0633\u06DB",
+	0x1EE35: "\u062A",
+	0x1EE36: "\u0649\u06DB",
+	0x1EE37: "\u062E",
+	0x1EE39: "\u0636",
+	0x1EE3B: "\u063A",
+	0x1EE42: "\u062C",
+	0x1EE47: "\u062D",
+	0x1EE49: "\u0649",
+	0x1EE4B: "\u0644",
+	0x1EE4D: "\u0646",
+	0x1EE4E: "\u0633",
+	0x1EE4F: "\u0639",
+	0x1EE51: "\u0635",
+	0x1EE52: "\u0642",
+	0x1EE54: "\u0633\u06DB",
+	0x1EE57: "\u062E",
+	0x1EE59: "\u0636",
+	0x1EE5B: "\u063A",
+	0x1EE5D: "\u0649",
+	0x1EE5F: "\u06A1",
+	0x1EE61: "\u0628",
+	0x1EE62: "\u062C",
+	0x1EE64: "o",
+	0x1EE67: "\u062D",
+	0x1EE68: "\u0637",
+	0x1EE69: "\u0649",
+	0x1EE6A: "\u0643",
+	0x1EE6C: "\u0645",
+	0x1EE6D: "\u0646",
+	0x1EE6E: "\u0633",
+	0x1EE6F: "\u0639",
+	0x1EE70: "\u0641",
+	0x1EE71: "\u0635",
+	0x1EE72: "\u0642",
+	0x1EE74: "\u0633\u06DB",
+	0x1EE75: "\u062A",
+	0x1EE76: "\u0649\u06DB",
+	0x1EE77: "\u062E",
+	0x1EE79: "\u0636",
+	0x1EE7A: "\u0638",
+	0x1EE7B: "\u063A",
+	0x1EE7C: "\u0649",
+	0x1EE7E: "\u06A1",
+	0x1EE80: "l",
+	0x1EE81: "\u0628",
+	0x1EE82: "\u062C",
+	0x1EE83: "\u062F",
+	0x1EE84: "o",
+	0x1EE85: "\u0648",
+	0x1EE86: "\u0632",
+	0x1EE87: "\u062D",
+	0x1EE88: "\u0637",
+	0x1EE89: "\u0649",
+	0x1EE8B: "\u0644",
+	0x1EE8C: "\u0645",
+	0x1EE8D: "\u0646",
+	0x1EE8E: "\u0633",
+	0x1EE8F: "\u0639",
+	0x1EE90: "\u0641",
+	0x1EE91: "\u0635",
+	0x1EE92: "\u0642",
+	0x1EE93: "\u0631",
+	0x1EE94: "\u0633\u06DB",
+	0x1EE95: "\u062A",
+	0x1EE96: "\u0649\u06DB",
+	0x1EE97: "\u062E",
+	0x1EE98: "\u0630",
+	0x1EE99: "\u0636",
+	0x1EE9A: "\u0638",
+	0x1EE9B: "\u063A",
+	0x1EEA1: "\u0628",
+	0x1EEA2: "\u062C",
+	0x1EEA3: "\u062F",
+	0x1EEA5: "\u0648",
+	0x1EEA6: "\u0632",
+	0x1EEA7: "\u062D",
+	0x1EEA8: "\u0637",
+	0x1EEA9: "\u0649",
+	0x1EEAB: "\u0644",
+	0x1EEAC: "\u0645",
+	0x1EEAD: "\u0646",
+	0x1EEAE: "\u0633",
+	0x1EEAF: "\u0639",
+	0x1EEB0: "\u0641",
+	0x1EEB1: "\u0635",
+	0x1EEB2: "\u0642",
+	0x1EEB3: "\u0631",
+	0x1EEB4: "\u0633\u06DB",
+	0x1EEB5: "\u062A",
+	0x1EEB6: "\u0649\u06DB",
+	0x1EEB7: "\u062E",
+	0x1EEB8: "\u0630",
+	0x1EEB9: "\u0636",
+	0x1EEBA: "\u0638",
+	0x1EEBB: "\u063A",
+	0x1F100: "O.",
+	0x1F101: "O,",
+	0x1F102: "l,",
+	0x1F103: "2,",
+	0x1F104: "3,",
+	0x1F105: "4,",
+	0x1F106: "5,",
+	0x1F107: "6,",
+	0x1F108: "7,",
+	0x1F109: "8,",
+	0x1F10A: "9,",
+	0x1F10F: "$\u20E0",
+	0x1F110: "(A)",
+	0x1F111: "(B)",
+	0x1F112: "(C)",
+	0x1F113: "(D)",
+	0x1F114: "(E)",
+	0x1F115: "(F)",
+	0x1F116: "(G)",
+	0x1F117: "(H)",
+	0x1F118: "(l)",
+	0x1F119: "(J)",
+	0x1F11A: "(K)",
+	0x1F11B: "(L)",
+	0x1F11C: "(M)",
+	0x1F11D: "(N)",
+	0x1F11E: "(O)",
+	0x1F11F: "(P)",
+	0x1F120: "(Q)",
+	0x1F121: "(R)",
+	0x1F122: "(S)",
+	0x1F123: "(T)",
+	0x1F124: "(U)",
+	0x1F125: "(V)",
+	0x1F126: "(W)",
+	0x1F127: "(X)",
+	0x1F128: "(Y)",
+	0x1F129: "(Z)",
+	0x1F12A: "(S)",
+	0x1F16D: "\u33C4\u0009\u20DD",
+	0x1F16E: "C\u20E0",
+	0x1F240: "(\u672C)",
+	0x1F241: "(\u4E09)",
+	0x1F242: "(\u4E8C)",
+	0x1F243: "(\u5B89)",
+	0x1F244: "(\u70B9)",
+	0x1F245: "(\u6253)",
+	0x1F246: "(\u76D7)",
+	0x1F247: "(\u52DD)",
+	0x1F248: "(\u6557)",
+	0x1F312: "\u263D",
+	0x1F318: "\u263E",
+	0x1F319: "\u263D",
+	0x1F333: "\U0001CEBC",
+	0x1F34E: "\U0001CEBD",
+	0x1F34F: "\U0001CEBD",
+	0x1F352: "\U0001CEBE",
+	0x1F353: "\U0001CEBF",
+	0x1F377: "\U0001CEBA",
+	0x1F3E2: "\U0001CEBB",
+	0x1F40D: "\U0001CCFA",
+	0x1F443: "\U0001CCFC",
+	0x1F514: "\U0001FBFA",
+	0x1F700: "QE",
+	0x1F701: "\uA658",
+	0x1F702: "\u0394",
+	0x1F704: "\U000102BC",
+	0x1F707: "AR",
+	0x1F708: "V\u1DE4",
+	0x1F70A: "\u2629",
+	0x1F714: "O\u0335",
+	0x1F728: "\U000102A8",
+	0x1F73A: "\u29DF",
+	0x1F74C: "C",
+	0x1F754: "\u16DC",
+	0x1F755: "\u22A1",
+	0x1F75C: "sss",
+	0x1F75E: "\u224F",
+	0x1F768: "T",
+	0x1F76B: "MB",
+	0x1F76C: "VB",
+	0x1F771: "\u22A0",
+	0x1FBF0: "O",
+	0x1FBF1: "l",
+	0x1FBF2: "2",
+	0x1FBF3: "3",
+	0x1FBF4: "4",
+	0x1FBF5: "5",
+	0x1FBF6: "6",
+	0x1FBF7: "7",
+	0x1FBF8: "8",
+	0x1FBF9: "9",
+	0x20674: "\u51F5",
+	0x21533: "\u58F7",
+	0x21587: "\u591A",
+	0x216A7: "\U000216A8",
+	0x21FE8: "\u276C",
+	0x22505: "\u5F9A",
+	0x23D40: "\u6D85",
+	0x248FD: "\u73A5",
+	0x2511A: "\U00025119",
+	0x25AD4: "\u8D1B",
+	0x2659D: "\U000265A8",
+	0x26D06: "\U00026C36",
+	0x2AEC5: "\U00024814",
+	0x2B73A: "\u5CC0",
+	0x2B73E: "\U0002335F",
+	0x2D161: "\u5351",
+	0x2F800: "\u4E3D",
+	0x2F801: "\u4E38",
+	0x2F802: "\u4E41",
+	0x2F803: "\U00020122",
+	0x2F804: "\u4F60",
+	0x2F805: "\u4FAE",
+	0x2F806: "\u4FBB",
+	0x2F807: "\u4F75",
+	0x2F808: "\u507A",
+	0x2F809: "\u5099",
+	0x2F80A: "\u50E7",
+	0x2F80B: "\u50CF",
+	0x2F80C: "\u349E",
+	0x2F80D: "\U0002063A",
+	0x2F80E: "\u514D",
+	0x2F80F: "\u5154",
+	0x2F810: "\u5164",
+	0x2F811: "\u5177",
+	0x2F812: "\U0002051C",
+	0x2F813: "\u34B9",
+	0x2F814: "\u5167",
+	0x2F815: "\u518D",
+	0x2F816: "\U0002054B",
+	0x2F817: "\u5197",
+	0x2F818: "\u51A4",
+	0x2F819: "\u4ECC",
+	0x2F81A: "\u51AC",
+	0x2F81B: "\u51B5",
+	0x2F81C: "\U000291DF",
+	0x2F81D: "\u51F5",
+	0x2F81E: "\u5203",
+	0x2F81F: "\u34DF",
+	0x2F820: "\u523B",
+	0x2F821: "\u5246",
+	0x2F822: "\u5272",
+	0x2F823: "\u5277",
+	0x2F824: "\u3515",
+	0x2F825: "\u52C7",
+	0x2F826: "\u52C9",
+	0x2F827: "\u52E4",
+	0x2F828: "\u52FA",
+	0x2F829: "\u5305",
+	0x2F82A: "\u5306",
+	0x2F82B: "\u5317",
+	0x2F82C: "\u5349",
+	0x2F82D: "\u5351",
+	0x2F82E: "\u535A",
+	0x2F82F: "\u5373",
+	0x2F830: "\u537D",
+	0x2F831: "\u537F",
+	0x2F832: "\u537F",
+	0x2F833: "\u537F",
+	0x2F834: "\U00020A2C",
+	0x2F835: "\u7070",
+	0x2F836: "\u53CA",
+	0x2F837: "\u53DF",
+	0x2F838: "\U00020B63",
+	0x2F839: "\u53EB",
+	0x2F83A: "\u53F1",
+	0x2F83B: "\u5406",
+	0x2F83C: "\u549E",
+	0x2F83D: "\u5438",
+	0x2F83E: "\u5448",
+	0x2F83F: "\u5468",
+	0x2F840: "\u54A2",
+	0x2F841: "\u54F6",
+	0x2F842: "\u5510",
+	0x2F843: "\u5553",
+	0x2F844: "\u5563",
+	0x2F845: "\u5584",
+	0x2F846: "\u5584",
+	0x2F847: "\u5599",
+	0x2F848: "\u55AB",
+	0x2F849: "\u55B3",
+	0x2F84A: "\u55C2",
+	0x2F84B: "\u5716",
+	0x2F84C: "\u5606",
+	0x2F84D: "\u5717",
+	0x2F84E: "\u5651",
+	0x2F84F: "\u5674",
+	0x2F850: "\u5207",
+	0x2F851: "\u58EE",
+	0x2F852: "\u57CE",
+	0x2F853: "\u57F4",
+	0x2F854: "\u580D",
+	0x2F855: "\u578B",
+	0x2F856: "\u5832",
+	0x2F857: "\u5831",
+	0x2F858: "\u58AC",
+	0x2F859: "\U000214E4",
+	0x2F85A: "\u58F2",
+	0x2F85B: "\u58F7",
+	0x2F85C: "\u5906",
+	0x2F85D: "\u591A",
+	0x2F85E: "\u5922",
+	0x2F85F: "\u5962",
+	0x2F860: "\U000216A8",
+	0x2F861: "\U000216EA",
+	0x2F862: "\u59EC",
+	0x2F863: "\u5A1B",
+	0x2F864: "\u5A27",
+	0x2F865: "\u59D8",
+	0x2F866: "\u5A66",
+	0x2F867: "\u36EE",
+	0x2F868: "\u36FC",
+	0x2F869: "\u5B08",
+	0x2F86A: "\u5B3E",
+	0x2F86B: "\u5B3E",
+	0x2F86C: "\U000219C8",
+	0x2F86D: "\u5BC3",
+	0x2F86E: "\u5BD8",
+	0x2F86F: "\u5BE7",
+	0x2F870: "\u5BF3",
+	0x2F871: "\U00021B18",
+	0x2F872: "\u5BFF",
+	0x2F873: "\u5C06",
+	0x2F874: "\u5F53",
+	0x2F875: "\u5C22",
+	0x2F876: "\u3781",
+	0x2F877: "\u5C60",
+	0x2F878: "\u5C6E",
+	0x2F879: "\u5CC0",
+	0x2F87A: "\u5C8D",
+	0x2F87B: "\U00021DE4",
+	0x2F87C: "\u5D43",
+	0x2F87D: "\U00021DE6",
+	0x2F87E: "\u5D6E",
+	0x2F87F: "\u5D6B",
+	0x2F880: "\u5D7C",
+	0x2F881: "\u5DE1",
+	0x2F882: "\u5DE2",
+	0x2F883: "\u382F",
+	0x2F884: "\u5DFD",
+	0x2F885: "\u5E28",
+	0x2F886: "\u5E3D",
+	0x2F887: "\u5E69",
+	0x2F888: "\u3862",
+	0x2F889: "\U00022183",
+	0x2F88A: "\u387C",
+	0x2F88B: "\u5EB0",
+	0x2F88C: "\u5EB3",
+	0x2F88D: "\u5EB6",
+	0x2F88E: "\u5ECA",
+	0x2F88F: "\U0002A392",
+	0x2F890: "\u5EFE",
+	0x2F891: "\U00022331",
+	0x2F892: "\U00022331",
+	0x2F893: "\u8201",
+	0x2F894: "\u5F22",
+	0x2F895: "\u5F22",
+	0x2F896: "\u38C7",
+	0x2F897: "\U000232B8",
+	0x2F898: "\U000261DA",
+	0x2F899: "\u5F62",
+	0x2F89A: "\u5F6B",
+	0x2F89B: "\u38E3",
+	0x2F89C: "\u5F9A",
+	0x2F89D: "\u5FCD",
+	0x2F89E: "\u5FD7",
+	0x2F89F: "\u5FF9",
+	0x2F8A0: "\u6081",
+	0x2F8A1: "\u393A",
+	0x2F8A2: "\u391C",
+	0x2F8A3: "\u6094",
+	0x2F8A4: "\U000226D4",
+	0x2F8A5: "\u60C7",
+	0x2F8A6: "\u6148",
+	0x2F8A7: "\u614C",
+	0x2F8A8: "\u614E",
+	0x2F8A9: "\u614C",
+	0x2F8AA: "\u617A",
+	0x2F8AB: "\u618E",
+	0x2F8AC: "\u61B2",
+	0x2F8AD: "\u61A4",
+	0x2F8AE: "\u61AF",
+	0x2F8AF: "\u61DE",
+	0x2F8B0: "\u61F2",
+	0x2F8B1: "\u61F6",
+	0x2F8B2: "\u6210",
+	0x2F8B3: "\u621B",
+	0x2F8B4: "\u625D",
+	0x2F8B5: "\u62B1",
+	0x2F8B6: "\u62D4",
+	0x2F8B7: "\u6350",
+	0x2F8B8: "\U00022B0C",
+	0x2F8B9: "\u633D",
+	0x2F8BA: "\u62FC",
+	0x2F8BB: "\u6368",
+	0x2F8BC: "\u6383",
+	0x2F8BD: "\u63E4",
+	0x2F8BE: "\U00022BF1",
+	0x2F8BF: "\u6422",
+	0x2F8C0: "\u63C5",
+	0x2F8C1: "\u63A9",
+	0x2F8C2: "\u3A2E",
+	0x2F8C3: "\u6469",
+	0x2F8C4: "\u647E",
+	0x2F8C5: "\u649D",
+	0x2F8C6: "\u6477",
+	0x2F8C7: "\u3A6C",
+	0x2F8C8: "\u654F",
+	0x2F8C9: "\u656C",
+	0x2F8CA: "\U0002300A",
+	0x2F8CB: "\u65E3",
+	0x2F8CC: "\u66F8",
+	0x2F8CD: "\u6649",
+	0x2F8CE: "\u3B19",
+	0x2F8CF: "\u6691",
+	0x2F8D0: "\u3B08",
+	0x2F8D1: "\u3AE4",
+	0x2F8D2: "\u5192",
+	0x2F8D3: "\u5195",
+	0x2F8D4: "\u6700",
+	0x2F8D5: "\u669C",
+	0x2F8D6: "\u80AD",
+	0x2F8D7: "\u43D9",
+	0x2F8D8: "\u6717",
+	0x2F8D9: "\u671B",
+	0x2F8DA: "\u6721",
+	0x2F8DB: "\u675E",
+	0x2F8DC: "\u6753",
+	0x2F8DD: "\U000233C3",
+	0x2F8DE: "\u3B49",
+	0x2F8DF: "\u67FA",
+	0x2F8E0: "\u6785",
+	0x2F8E1: "\u6852",
+	0x2F8E2: "\u6885",
+	0x2F8E3: "\U0002346D",
+	0x2F8E4: "\u688E",
+	0x2F8E5: "\u681F",
+	0x2F8E6: "\u6914",
+	0x2F8E7: "\u3B9D",
+	0x2F8E8: "\u6942",
+	0x2F8E9: "\u69A3",
+	0x2F8EA: "\u69EA",
+	0x2F8EB: "\u6AA8",
+	0x2F8EC: "\U000236A3",
+	0x2F8ED: "\u6ADB",
+	0x2F8EE: "\u3C18",
+	0x2F8EF: "\u6B21",
+	0x2F8F0: "\U000238A7",
+	0x2F8F1: "\u6B54",
+	0x2F8F2: "\u3C4E",
+	0x2F8F3: "\u6B72",
+	0x2F8F4: "\u6B9F",
+	0x2F8F5: "\u6BBA",
+	0x2F8F6: "\u6BBB",
+	0x2F8F7: "\U00023A8D",
+	0x2F8F8: "\U00021D0B",
+	0x2F8F9: "\U00023AFA",
+	0x2F8FA: "\u6C4E",
+	0x2F8FB: "\U00023CBC",
+	0x2F8FC: "\u6CBF",
+	0x2F8FD: "\u6CCD",
+	0x2F8FE: "\u6C67",
+	0x2F8FF: "\u6D16",
+	0x2F900: "\u6D3E",
+	0x2F901: "\u6D77",
+	0x2F902: "\u6D41",
+	0x2F903: "\u6D69",
+	0x2F904: "\u6D78",
+	0x2F905: "\u6D85",
+	0x2F906: "\U00023D1E",
+	0x2F907: "\u6D34",
+	0x2F908: "\u6E2F",
+	0x2F909: "\u6E6E",
+	0x2F90A: "\u3D33",
+	0x2F90B: "\u6ECB",
+	0x2F90C: "\u6EC7",
+	0x2F90D: "\U00023ED1",
+	0x2F90E: "\u6DF9",
+	0x2F90F: "\u6F6E",
+	0x2F910: "\U00023F5E",
+	0x2F911: "\U00023F8E",
+	0x2F912: "\u6FC6",
+	0x2F913: "\u7039",
+	0x2F914: "\u701E",
+	0x2F915: "\u701B",
+	0x2F916: "\u3D96",
+	0x2F917: "\u704A",
+	0x2F918: "\u707D",
+	0x2F919: "\u7077",
+	0x2F91A: "\u70AD",
+	0x2F91B: "\U00020525",
+	0x2F91C: "\u7145",
+	0x2F91D: "\U00024263",
+	0x2F91E: "\u719C",
+	0x2F91F: "\U000243AB",
+	0x2F920: "\u7228",
+	0x2F921: "\u7235",
+	0x2F922: "\u7250",
+	0x2F923: "\U00024608",
+	0x2F924: "\u7280",
+	0x2F925: "\u7295",
+	0x2F926: "\U00024735",
+	0x2F927: "\U00024814",
+	0x2F928: "\u737A",
+	0x2F929: "\u738B",
+	0x2F92A: "\u3EAC",
+	0x2F92B: "\u73A5",
+	0x2F92C: "\u3EB8",
+	0x2F92D: "\u3EB8",
+	0x2F92E: "\u7447",
+	0x2F92F: "\u745C",
+	0x2F930: "\u7471",
+	0x2F931: "\u7485",
+	0x2F932: "\u74CA",
+	0x2F933: "\u3F1B",
+	0x2F934: "\u7524",
+	0x2F935: "\U00024C36",
+	0x2F936: "\u753E",
+	0x2F937: "\U00024C92",
+	0x2F938: "\u7570",
+	0x2F939: "\U0002219F",
+	0x2F93A: "\u7610",
+	0x2F93B: "\U00024FA1",
+	0x2F93C: "\U00024FB8",
+	0x2F93D: "\U00025044",
+	0x2F93E: "\u3FFC",
+	0x2F93F: "\u4008",
+	0x2F940: "\u76F4",
+	0x2F941: "\U000250F3",
+	0x2F942: "\U000250F2",
+	0x2F943: "\U00025119",
+	0x2F944: "\U00025133",
+	0x2F945: "\u771E",
+	0x2F946: "\u771F",
+	0x2F947: "\u771F",
+	0x2F948: "\u774A",
+	0x2F949: "\u4039",
+	0x2F94A: "\u778B",
+	0x2F94B: "\u4046",
+	0x2F94C: "\u4096",
+	0x2F94D: "\U0002541D",
+	0x2F94E: "\u784E",
+	0x2F94F: "\u788C",
+	0x2F950: "\u78CC",
+	0x2F951: "\u40E3",
+	0x2F952: "\U00025626",
+	0x2F953: "\u7956",
+	0x2F954: "\U0002569A",
+	0x2F955: "\U000256C5",
+	0x2F956: "\u798F",
+	0x2F957: "\u79EB",
+	0x2F958: "\u412F",
+	0x2F959: "\u7A40",
+	0x2F95A: "\u7A4A",
+	0x2F95B: "\u7A4F",
+	0x2F95C: "\U0002597C",
+	0x2F95D: "\U00025AA7",
+	0x2F95E: "\U00025AA7",
+	0x2F95F: "\u7AEE",
+	0x2F960: "\u4202",
+	0x2F961: "\U00025BAB",
+	0x2F962: "\u7BC6",
+	0x2F963: "\u7BC9",
+	0x2F964: "\u4227",
+	0x2F965: "\U00025C80",
+	0x2F966: "\u7CD2",
+	0x2F967: "\u42A0",
+	0x2F968: "\u7CE8",
+	0x2F969: "\u7CE3",
+	0x2F96A: "\u7D00",
+	0x2F96B: "\U00025F86",
+	0x2F96C: "\u7D63",
+	0x2F96D: "\u4301",
+	0x2F96E: "\u7DC7",
+	0x2F96F: "\u7E02",
+	0x2F970: "\u7E45",
+	0x2F971: "\u4334",
+	0x2F972: "\U00026228",
+	0x2F973: "\U00026247",
+	0x2F974: "\u4359",
+	0x2F975: "\U000262D9",
+	0x2F976: "\u7F7A",
+	0x2F977: "\U0002633E",
+	0x2F978: "\u7F95",
+	0x2F979: "\u7FFA",
+	0x2F97A: "\u8005",
+	0x2F97B: "\U000264DA",
+	0x2F97C: "\U00026523",
+	0x2F97D: "\u8060",
+	0x2F97E: "\U000265A8",
+	0x2F97F: "\u8070",
+	0x2F980: "\U0002335F",
+	0x2F981: "\u43D5",
+	0x2F982: "\u80B2",
+	0x2F983: "\u8103",
+	0x2F984: "\u440B",
+	0x2F985: "\u813E",
+	0x2F986: "\u5AB5",
+	0x2F987: "\U000267A7",
+	0x2F988: "\U000267B5",
+	0x2F989: "\U00023393",
+	0x2F98A: "\U0002339C",
+	0x2F98B: "\u8201",
+	0x2F98C: "\u8204",
+	0x2F98D: "\u8F9E",
+	0x2F98E: "\u446B",
+	0x2F98F: "\u8291",
+	0x2F990: "\u828B",
+	0x2F991: "\u829D",
+	0x2F992: "\u52B3",
+	0x2F993: "\u82B1",
+	0x2F994: "\u82B3",
+	0x2F995: "\u82BD",
+	0x2F996: "\u82E6",
+	0x2F997: "\U00026B3C",
+	0x2F998: "\u82E5",
+	0x2F999: "\u831D",
+	0x2F99A: "\u8363",
+	0x2F99B: "\u83AD",
+	0x2F99C: "\u8323",
+	0x2F99D: "\u83BD",
+	0x2F99E: "\u83E7",
+	0x2F99F: "\u8457",
+	0x2F9A0: "\u8353",
+	0x2F9A1: "\u83CA",
+	0x2F9A2: "\u83CC",
+	0x2F9A3: "\u83DC",
+	0x2F9A4: "\U00026C36",
+	0x2F9A5: "\U00026D6B",
+	0x2F9A6: "\U00026CD5",
+	0x2F9A7: "\u452B",
+	0x2F9A8: "\u84F1",
+	0x2F9A9: "\u84F3",
+	0x2F9AA: "\u8516",
+	0x2F9AB: "\U000273CA",
+	0x2F9AC: "\u8564",
+	0x2F9AD: "\U00026F2C",
+	0x2F9AE: "\u455D",
+	0x2F9AF: "\u4561",
+	0x2F9B0: "\U00026FB1",
+	0x2F9B1: "\U000270D2",
+	0x2F9B2: "\u456B",
+	0x2F9B3: "\u8650",
+	0x2F9B4: "\u865C",
+	0x2F9B5: "\u8667",
+	0x2F9B6: "\u8669",
+	0x2F9B7: "\u86A9",
+	0x2F9B8: "\u8688",
+	0x2F9B9: "\u870E",
+	0x2F9BA: "\u86E2",
+	0x2F9BB: "\u8779",
+	0x2F9BC: "\u8728",
+	0x2F9BD: "\u876B",
+	0x2F9BE: "\u8786",
+	0x2F9BF: "\u45D7",
+	0x2F9C0: "\u87E1",
+	0x2F9C1: "\u8801",
+	0x2F9C2: "\u45F9",
+	0x2F9C3: "\u8860",
+	0x2F9C4: "\u8863",
+	0x2F9C5: "\U00027667",
+	0x2F9C6: "\u88D7",
+	0x2F9C7: "\u88DE",
+	0x2F9C8: "\u4635",
+	0x2F9C9: "\u88FA",
+	0x2F9CA: "\u34BB",
+	0x2F9CB: "\U000278AE",
+	0x2F9CC: "\U00027966",
+	0x2F9CD: "\u46BE",
+	0x2F9CE: "\u46C7",
+	0x2F9CF: "\u8AA0",
+	0x2F9D0: "\u8AED",
+	0x2F9D1: "\u8B8A",
+	0x2F9D2: "\u8C55",
+	0x2F9D3: "\U00027CA8",
+	0x2F9D4: "\u8CAB",
+	0x2F9D5: "\u8CC1",
+	0x2F9D6: "\u8D1B",
+	0x2F9D7: "\u8D77",
+	0x2F9D8: "\U00027F2F",
+	0x2F9D9: "\U00020804",
+	0x2F9DA: "\u8DCB",
+	0x2F9DB: "\u8DBC",
+	0x2F9DC: "\u8DF0",
+	0x2F9DD: "\U000208DE",
+	0x2F9DE: "\u8ED4",
+	0x2F9DF: "\u8F38",
+	0x2F9E0: "\U000285D2",
+	0x2F9E1: "\U000285ED",
+	0x2F9E2: "\u9094",
+	0x2F9E3: "\u90F1",
+	0x2F9E4: "\u9111",
+	0x2F9E5: "\U0002872E",
+	0x2F9E6: "\u911B",
+	0x2F9E7: "\u9238",
+	0x2F9E8: "\u92D7",
+	0x2F9E9: "\u92D8",
+	0x2F9EA: "\u927C",
+	0x2F9EB: "\u93F9",
+	0x2F9EC: "\u9415",
+	0x2F9ED: "\U00028BFA",
+	0x2F9EE: "\u958B",
+	0x2F9EF: "\u4995",
+	0x2F9F0: "\u95B7",
+	0x2F9F1: "\U00028D77",
+	0x2F9F2: "\u49E6",
+	0x2F9F3: "\u96C3",
+	0x2F9F4: "\u5DB2",
+	0x2F9F5: "\u9723",
+	0x2F9F6: "\U00029145",
+	0x2F9F7: "\U0002921A",
+	0x2F9F8: "\u4A6E",
+	0x2F9F9: "\u4A76",
+	0x2F9FA: "\u97E0",
+	0x2F9FB: "\U0002940A",
+	0x2F9FC: "\u4AB2",
+	0x2F9FD: "\U00029496",
+	0x2F9FE: "\u980B",
+	0x2F9FF: "\u980B",
+	0x2FA00: "\u9829",
+	0x2FA01: "\U000295B6",
+	0x2FA02: "\u98E2",
+	0x2FA03: "\u4B33",
+	0x2FA04: "\u9929",
+	0x2FA05: "\u99A7",
+	0x2FA06: "\u99C2",
+	0x2FA07: "\u99FE",
+	0x2FA08: "\u4BCE",
+	0x2FA09: "\U00029B30",
+	0x2FA0A: "\u9B12",
+	0x2FA0B: "\u9C40",
+	0x2FA0C: "\u9CFD",
+	0x2FA0D: "\u4CCE",
+	0x2FA0E: "\u4CED",
+	0x2FA0F: "\u9D67",
+	0x2FA10: "\U0002A0CE",
+	0x2FA11: "\u4CF8",
+	0x2FA12: "\U0002A105",
+	0x2FA13: "\U0002A20E",
+	0x2FA14: "\U0002A291",
+	0x2FA15: "\u9EBB",
+	0x2FA16: "\u4D56",
+	0x2FA17: "\u9EF9",
+	0x2FA18: "\u9EFE",
+	0x2FA19: "\u9F05",
+	0x2FA1A: "\u9F0F",
+	0x2FA1B: "\u9F16",
+	0x2FA1C: "\u9F3B",
+	0x2FA1D: "\U0002A600",
+	0x31E7C: "\u7DC7",
+}
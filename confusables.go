@@ -0,0 +1,173 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+//go:generate go run gen-confusable-tables.go
+
+// Skeleton returns the UTS #39 skeleton of the text of r: the NFD of the
+// text without its default ignorable runes, such as the zero width space and
+// the soft hyphen, with each rune replaced by its confusable prototype,
+// normalized to NFD again. Two strings are visually confusable when their
+// skeletons are equal, such as "paypal" and "p\u0430ypal" with a Cyrillic a
+//
+// Skeleton was added by go-corelibs
+func Skeleton(r RuneReader) (skeleton string, err error) {
+	var text string
+	if text, _, err = readText(r); err != nil {
+		return "", err
+	}
+	return skeletonOf(text), nil
+}
+
+var (
+	defaultIgnorableOnce sync.Once
+	defaultIgnorable     *RuneSet
+)
+
+// isDefaultIgnorable returns true for the runes with the Unicode
+// Default_Ignorable_Code_Point property, derived from the properties of the
+// unicode package as it is in DerivedCoreProperties.txt
+func isDefaultIgnorable(ch rune) bool {
+	defaultIgnorableOnce.Do(func() {
+		defaultIgnorable = NewRuneSetTable(unicode.Other_Default_Ignorable_Code_Point, unicode.Cf, unicode.Variation_Selector).
+			Difference(NewRuneSetTable(unicode.White_Space, unicode.Prepended_Concatenation_Mark)).
+			Difference(NewRuneSetRange(0xFFF9, 0xFFFB)).
+			Difference(NewRuneSetRange(0x13430, 0x1343F))
+	})
+	return defaultIgnorable.Contains(ch)
+}
+
+// skeletonOf returns the UTS #39 skeleton of text
+func skeletonOf(text string) string {
+	var buf strings.Builder
+	for _, ch := range norm.NFD.String(text) {
+		if isDefaultIgnorable(ch) {
+			continue
+		} else if prototype, ok := confusablePrototypes[ch]; ok {
+			buf.WriteString(prototype)
+		} else {
+			buf.WriteRune(ch)
+		}
+	}
+	return norm.NFD.String(buf.String())
+}
+
+// AreConfusable returns true if the texts of a and b have the same UTS #39
+// skeleton, meaning that they may be mistaken for each other
+//
+// AreConfusable was added by go-corelibs
+func AreConfusable(a, b RuneReader) (confusable bool, err error) {
+	var sa, sb string
+	if sa, err = Skeleton(a); err != nil {
+		return false, err
+	} else if sb, err = Skeleton(b); err != nil {
+		return false, err
+	}
+	return sa == sb, nil
+}
+
+// MixedScriptRun is a run of letters, marks and numbers which is not
+// written in a single script, as reported by FindMixedScripts
+type MixedScriptRun struct {
+	// Index is the index of the run
+	Index int64
+	// Size is the width of the run in native units
+	Size int
+	// Scripts are the names of the scripts of the run, as used by
+	// unicode.Scripts, in the order of their first appearance
+	Scripts []string
+}
+
+// augmentedScripts returns the augmented script set of the script given, as
+// defined by UTS #39, so that Han may be mixed with the Japanese kana, the
+// Korean Hangul or the Chinese Bopomofo
+func augmentedScripts(script string) []string {
+	switch script {
+	case "Han":
+		return []string{"Han", "Hanb", "Jpan", "Kore"}
+	case "Hiragana", "Katakana":
+		return []string{script, "Jpan"}
+	case "Hangul":
+		return []string{script, "Kore"}
+	case "Bopomofo":
+		return []string{script, "Hanb"}
+	}
+	return []string{script}
+}
+
+// intersectScripts returns the scripts of a which are also in b
+func intersectScripts(a, b []string) (scripts []string) {
+	for _, script := range a {
+		if slices.Contains(b, script) {
+			scripts = append(scripts, script)
+		}
+	}
+	return
+}
+
+// FindMixedScripts returns the runs of letters, marks and numbers of the
+// text of r which are not written in a single script, in order. Runes of the
+// Common and Inherited scripts are allowed in any run, and the Han ideographs
+// may be mixed with the kana, Hangul or Bopomofo as defined by the UTS #39
+// augmented script sets
+//
+// FindMixedScripts was added by go-corelibs
+func FindMixedScripts(r RuneReader) (runs []MixedScriptRun, err error) {
+	var start, end int64 = -1, -1
+	var scripts, resolved []string
+	var mixed bool
+
+	flush := func() {
+		if start >= 0 && mixed {
+			runs = append(runs, MixedScriptRun{Index: start, Size: int(end - start), Scripts: scripts})
+		}
+		start, scripts, resolved, mixed = -1, nil, nil, false
+	}
+
+	size := r.Size()
+	for index := int64(0); index < size; {
+		var ch rune
+		var width int
+		if ch, width, err = r.ReadRuneAt(index); err != nil {
+			return nil, err
+		}
+		if !unicode.In(ch, unicode.L, unicode.M, unicode.N) {
+			flush()
+			index += int64(width)
+			continue
+		}
+		if start < 0 {
+			start = index
+		}
+		end = index + int64(width)
+		index = end
+
+		script := scriptOf(ch)
+		if script == "Common" || script == "Inherited" {
+			continue
+		}
+		if !slices.Contains(scripts, script) {
+			scripts = append(scripts, script)
+		}
+		if mixed {
+			continue
+		} else if augmented := augmentedScripts(script); resolved == nil {
+			resolved = augmented
+		} else if resolved = intersectScripts(resolved, augmented); len(resolved) == 0 {
+			mixed = true
+		}
+	}
+	flush()
+	return runs, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes_test
+
+import (
+	"bufio"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/unicode/rangetable"
+
+	. "github.com/go-corelibs/runes"
+)
+
+func TestConfusablesConformance(t *testing.T) {
+	fh, err := os.Open("testdata/confusables.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fh.Close()
+
+	// newer decompositions are not known to this version of the norm package
+	assigned := rangetable.Assigned(norm.Version)
+
+	var count int
+	s := bufio.NewScanner(fh)
+	for line := 1; s.Scan(); line++ {
+		text := strings.TrimPrefix(s.Text(), "\ufeff")
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+		fields := strings.Split(text, ";")
+		if len(fields) < 3 {
+			continue
+		}
+		source := parseCodePoints(t, fields[0])
+		target := parseCodePoints(t, fields[1])
+		if !assignedIn(assigned, source) || !assignedIn(assigned, target) {
+			continue
+		} else if !norm.NFD.IsNormalString(source) || !norm.NFD.IsNormalString(target) {
+			// skeletons map the decompositions, not the composed characters
+			continue
+		}
+		count++
+		for _, r := range newCaseReaders("x" + source + "y") {
+			if ok, err := AreConfusable(r, NewStringReader("x"+target+"y")); !ok || err != nil {
+				t.Errorf("line %d: AreConfusable(%+q, %+q) got %v,%v; want true", line, source, target, ok, err)
+			}
+		}
+	}
+	if err = s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if count < 5000 {
+		t.Errorf("only %d confusables tested", count)
+	}
+}
+
+func TestSkeleton(t *testing.T) {
+	for _, tc := range []struct {
+		a, b string
+		want bool
+	}{
+		{"paypal", "p\u0430ypal", true},
+		{"paypal", "paypa1", true},
+		{"rn", "m", true},
+		{"\u0441\u043e\u0440\u0435", "cope", true},
+		{"caf\u00e9", "cafe\u0301", true},
+		{"paypal", "paypai", false},
+		{"", "", true},
+		{"pay\u200bpal", "paypal", true},
+		{"pay\u200dpal", "paypal", true},
+		{"pay\u00adpal", "paypal", true},
+		{"pay\ufe0fpal", "paypal", true},
+		{"\u2060pay\u034fpal\U000E0041", "paypal", true},
+		{"pay pal", "paypal", false},
+	} {
+		for _, a := range newCaseReaders(tc.a) {
+			if got, err := AreConfusable(a, NewStringReader(tc.b)); got != tc.want || err != nil {
+				t.Errorf("AreConfusable(%+q, %+q): got %v,%v; want %v", tc.a, tc.b, got, err, tc.want)
+			}
+		}
+	}
+	if got, err := Skeleton(NewStringReader("p\u0430yp\u0430l")); got != "paypal" || err != nil {
+		t.Errorf("Skeleton: got %+q,%v", got, err)
+	}
+}
+
+func TestFindMixedScripts(t *testing.T) {
+	text := "paypal p\u0430ypal \u03b1\u03b2c-\u0434 \u6f22\u5b57\u304b\u306a \ud55c\u6f22 \u30ab\ud55c x\u0301 123\u0430"
+	want := []MixedScriptRun{
+		{Index: 7, Size: 7, Scripts: []string{"Latin", "Cyrillic"}},
+		{Index: 15, Size: 5, Scripts: []string{"Greek", "Latin"}},
+		{Index: 44, Size: 6, Scripts: []string{"Katakana", "Hangul"}},
+	}
+	found, err := FindMixedScripts(NewStringReader(text))
+	if err != nil || !reflect.DeepEqual(found, want) {
+		t.Errorf("got %+v,%v; want %+v", found, err, want)
+	}
+
+	found, err = FindMixedScripts(NewRunesReader([]rune(text)))
+	if err != nil || len(found) != 3 || found[0].Index != 7 || found[0].Size != 6 || found[2].Index != 28 || found[2].Size != 2 {
+		t.Errorf("runes: got %+v,%v", found, err)
+	}
+}
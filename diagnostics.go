@@ -0,0 +1,475 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Severity is the level of a Diagnostic
+type Severity uint8
+
+const (
+	SeverityError Severity = iota
+	SeverityWarning
+	SeverityNote
+	SeverityHelp
+)
+
+// String returns the name of the Severity, as shown in a rendered Diagnostic
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	case SeverityNote:
+		return "note"
+	case SeverityHelp:
+		return "help"
+	}
+	return "Severity(" + strconv.Itoa(int(s)) + ")"
+}
+
+// color returns the ANSI SGR parameters of the Severity
+func (s Severity) color() string {
+	switch s {
+	case SeverityWarning:
+		return "1;33"
+	case SeverityNote:
+		return "1;32"
+	case SeverityHelp:
+		return "1;36"
+	}
+	return "1;31"
+}
+
+// DiagnosticLabel marks a range of the source of a Diagnostic with a message
+type DiagnosticLabel struct {
+	// Start is the index of the first rune of the range
+	Start int64
+	// End is the index following the last rune of the range, when it is the
+	// same as Start the label points at a single position
+	End int64
+	// Message is shown beside the range, it may be empty
+	Message string
+	// Primary labels are underlined with carets and give the position of the
+	// Diagnostic, secondary labels are underlined with dashes
+	Primary bool
+}
+
+// Diagnostic is a message about a source text, such as a compiler error
+type Diagnostic struct {
+	Severity Severity
+	// Message is the headline of the Diagnostic
+	Message string
+	// Filename is shown with the position of the Diagnostic, it may be empty
+	Filename string
+	// Labels are the ranges of the source shown with the Diagnostic
+	Labels []DiagnosticLabel
+	// Notes are shown after the source excerpt, each as "= note: ..."
+	Notes []string
+}
+
+// DiagnosticRenderer renders Diagnostic messages with excerpts of the source
+// text, in the style of the Rust compiler:
+//
+//	error: mismatched types
+//	 --> main.rs:2:17
+//	  |
+//	2 |     let x: u8 = "text";
+//	  |            --   ^^^^^^ expected u8
+//	  |            |
+//	  |            expected due to this
+//
+// Lines are numbered from one, tabs are expanded and the underlines are
+// aligned with the display width of the text, including wide characters.
+// Labels may span multiple lines and lines between the labelled ones are
+// elided with "..."
+type DiagnosticRenderer struct {
+	// Context is the number of lines shown before and after each labelled
+	// line
+	Context int
+	// TabWidth is the distance between tab stops, in columns
+	TabWidth int
+	// Color enables ANSI colour in the rendered output
+	Color bool
+
+	r     RuneReader
+	li    *LineIndex
+	runes bool
+}
+
+// NewDiagnosticRenderer returns a new DiagnosticRenderer for the source text
+// given, with one line of context, a tab width of four and no colour
+//
+// NewDiagnosticRenderer was added by go-corelibs
+func NewDiagnosticRenderer(r RuneReader) *DiagnosticRenderer {
+	dr := &DiagnosticRenderer{Context: 1, TabWidth: 4, r: r, li: NewLineIndex(r, 0)}
+	if ri, ok := r.(runeIndexer); ok {
+		dr.runes = ri.runeIndexed()
+	}
+	return dr
+}
+
+// diagLine is the display layout of one line of source text
+type diagLine struct {
+	text    string         // the line with tabs expanded
+	start   int64          // native index of the line
+	display *DisplayReader // the layout of the line
+}
+
+// column returns the display column of the native index given, which must
+// be within or at the end of the line
+func (dl *diagLine) column(index int64) int {
+	col, _ := dl.display.Column(index - dl.start)
+	return col
+}
+
+// diagLabel is a DiagnosticLabel with its lines and columns
+type diagLabel struct {
+	DiagnosticLabel
+	startLine, endLine int
+	startCol, endCol   int
+	slot               int // gutter slot of a multi-line label
+}
+
+func (l *diagLabel) multiLine() bool { return l.startLine != l.endLine }
+
+// diagCell is one column of an annotation row
+type diagCell struct {
+	ch    rune
+	color string
+}
+
+// diagRow is an annotation row under a line of source text
+type diagRow []diagCell
+
+// set draws the text given at the column given
+func (row *diagRow) set(col int, text string, color string) {
+	for _, ch := range text {
+		for len(*row) <= col {
+			*row = append(*row, diagCell{ch: ' '})
+		}
+		(*row)[col] = diagCell{ch: ch, color: color}
+		col++
+	}
+}
+
+// Render returns the Diagnostic rendered with excerpts of the source text,
+// ending with a newline
+//
+// Render was added by go-corelibs
+func (dr *DiagnosticRenderer) Render(d Diagnostic) (output string, err error) {
+	var labels []*diagLabel
+	if labels, err = dr.prepare(d.Labels); err != nil {
+		return "", err
+	}
+
+	// the lines to show
+	count, _ := dr.li.LineCount()
+	shown := make(map[int]bool)
+	show := func(from, to int) {
+		for n := max(from, 0); n <= to && n < count; n++ {
+			shown[n] = true
+		}
+	}
+	var multi []*diagLabel
+	for _, l := range labels {
+		show(l.startLine-dr.Context, l.startLine+dr.Context)
+		show(l.endLine-dr.Context, l.endLine+dr.Context)
+		if l.endLine-l.startLine <= 4 {
+			show(l.startLine, l.endLine)
+		}
+		if l.multiLine() {
+			l.slot = len(multi)
+			multi = append(multi, l)
+		}
+	}
+	var lines []int
+	for n := range shown {
+		lines = append(lines, n)
+	}
+	sort.Ints(lines)
+	width := 1
+	if len(lines) > 0 {
+		width = len(strconv.Itoa(lines[len(lines)-1] + 1))
+	}
+
+	var buf strings.Builder
+	buf.WriteString(dr.paint(d.Severity.color(), d.Severity.String()))
+	buf.WriteString(dr.paint("1", ": "+d.Message))
+	buf.WriteByte('\n')
+	if location := dr.location(d, labels); location != "" {
+		buf.WriteString(strings.Repeat(" ", width))
+		buf.WriteString(dr.paint("1;34", "--> "))
+		buf.WriteString(location)
+		buf.WriteByte('\n')
+	}
+	if len(lines) > 0 {
+		dr.writeGutter(&buf, width, "")
+		buf.WriteByte('\n')
+	}
+
+	ended := make(map[*diagLabel]bool)
+	// bars returns the gutter of the multi-line labels active at line n
+	bars := func(n int, row *diagRow) {
+		for _, l := range multi {
+			if l.startLine < n && n <= l.endLine && !ended[l] {
+				row.set(l.slot*2, "|", dr.labelColor(d, l))
+			}
+		}
+	}
+
+	prev := -1
+	for _, n := range lines {
+		if prev >= 0 && n > prev+1 {
+			var row diagRow
+			bars(n, &row)
+			buf.WriteString(dr.paint("1;34", "..."))
+			buf.WriteString(strings.Repeat(" ", width-1))
+			dr.writeRow(&buf, row)
+		}
+		prev = n
+
+		var dl *diagLine
+		if dl, err = dr.line(n); err != nil {
+			return "", err
+		}
+		var row diagRow
+		bars(n, &row)
+		row.set(len(multi)*2, dl.text, "")
+		dr.writeGutter(&buf, width, strconv.Itoa(n+1))
+		dr.writeRow(&buf, row)
+
+		dr.writeInline(&buf, d, width, multi, n, labels, bars)
+
+		for _, l := range multi {
+			if l.startLine == n {
+				row = row[:0]
+				bars(n, &row)
+				row.set(l.slot*2+1, strings.Repeat("_", (len(multi)-l.slot-1)*2+1+l.startCol), dr.labelColor(d, l))
+				row.set(len(multi)*2+l.startCol, dr.marker(l), dr.labelColor(d, l))
+				dr.writeGutter(&buf, width, "")
+				dr.writeRow(&buf, row)
+			}
+		}
+		// the inner labels end first, so that their underlines do not cross
+		for k := len(multi) - 1; k >= 0; k-- {
+			if l := multi[k]; l.endLine == n {
+				row = row[:0]
+				bars(n, &row)
+				col := max(l.endCol-1, 0)
+				row.set(l.slot*2, "|"+strings.Repeat("_", (len(multi)-l.slot-1)*2+1+col), dr.labelColor(d, l))
+				row.set(len(multi)*2+col, dr.marker(l), dr.labelColor(d, l))
+				if l.Message != "" {
+					row.set(len(row)+1, l.Message, dr.labelColor(d, l))
+				}
+				ended[l] = true
+				dr.writeGutter(&buf, width, "")
+				dr.writeRow(&buf, row)
+			}
+		}
+	}
+
+	if len(d.Notes) > 0 {
+		if len(lines) > 0 {
+			dr.writeGutter(&buf, width, "")
+			buf.WriteByte('\n')
+		}
+		for _, note := range d.Notes {
+			buf.WriteString(strings.Repeat(" ", width+1))
+			buf.WriteString(dr.paint("1;34", "="))
+			buf.WriteString(dr.paint("1", " note"))
+			buf.WriteString(": " + note + "\n")
+		}
+	}
+	return buf.String(), nil
+}
+
+// prepare validates the labels and finds their lines and columns
+func (dr *DiagnosticRenderer) prepare(labels []DiagnosticLabel) (prepared []*diagLabel, err error) {
+	size := dr.r.Size()
+	for _, label := range labels {
+		if label.Start < 0 || label.End < label.Start || label.End > size {
+			return nil, errors.New("DiagnosticRenderer.Render: label out of range")
+		}
+		l := &diagLabel{DiagnosticLabel: label}
+		if l.startLine, err = dr.li.LineOf(label.Start); err != nil {
+			return nil, err
+		}
+		l.endLine = l.startLine
+		if label.End > label.Start {
+			// a range ending with a terminator ends on the line it terminates
+			if l.endLine, err = dr.li.LineOf(label.End - 1); err != nil {
+				return nil, err
+			}
+		}
+		var start, end *diagLine
+		if start, err = dr.line(l.startLine); err != nil {
+			return nil, err
+		} else if end, err = dr.line(l.endLine); err != nil {
+			return nil, err
+		}
+		lineEnd, _ := dr.li.LineEnd(l.endLine)
+		l.startCol = start.column(label.Start)
+		l.endCol = end.column(min(label.End, lineEnd))
+		if !l.multiLine() && l.endCol <= l.startCol {
+			l.endCol = l.startCol + 1
+		}
+		prepared = append(prepared, l)
+	}
+	return
+}
+
+// line returns the display layout of line n
+func (dr *DiagnosticRenderer) line(n int) (dl *diagLine, err error) {
+	dl = &diagLine{}
+	var raw string
+	if dl.start, err = dr.li.LineStart(n); err != nil {
+		return nil, err
+	} else if raw, err = dr.li.ReadLine(n); err != nil {
+		return nil, err
+	} else if dl.display, err = NewDisplayReader(textReaderFor(raw, dr.runes), max(dr.TabWidth, 1), ControlRaw); err != nil {
+		return nil, err
+	} else if dl.text, _, err = readText(dl.display); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// location returns the filename, line and column of the first primary label,
+// or of the first label when none are primary
+func (dr *DiagnosticRenderer) location(d Diagnostic, labels []*diagLabel) string {
+	location := d.Filename
+	var first *diagLabel
+	for _, l := range labels {
+		if l.Primary {
+			first = l
+			break
+		} else if first == nil {
+			first = l
+		}
+	}
+	if first != nil {
+		// the column is counted in runes from one
+		start, _ := dr.li.LineStart(first.startLine)
+		column := first.Start - start + 1
+		if !dr.runes && column > 1 {
+			prefix, _ := dr.r.ReadString(start, first.Start-start)
+			column = int64(utf8.RuneCountInString(prefix)) + 1
+		}
+		position := strconv.Itoa(first.startLine+1) + ":" + strconv.FormatInt(column, 10)
+		if location != "" {
+			location += ":"
+		}
+		location += position
+	}
+	return location
+}
+
+// writeInline writes the annotation rows of the single-line labels of line n
+func (dr *DiagnosticRenderer) writeInline(buf *strings.Builder, d Diagnostic, width int, multi []*diagLabel, n int, labels []*diagLabel, bars func(int, *diagRow)) {
+	var inline []*diagLabel
+	for _, l := range labels {
+		if !l.multiLine() && l.startLine == n {
+			inline = append(inline, l)
+		}
+	}
+	if len(inline) == 0 {
+		return
+	}
+	sort.SliceStable(inline, func(i, j int) bool { return inline[i].startCol < inline[j].startCol })
+	base := len(multi) * 2
+
+	var row diagRow
+	bars(n, &row)
+	for _, l := range inline {
+		row.set(base+l.startCol, strings.Repeat(dr.marker(l), l.endCol-l.startCol), dr.labelColor(d, l))
+	}
+	last := inline[len(inline)-1]
+	if last.Message != "" {
+		row.set(len(row)+1, last.Message, dr.labelColor(d, last))
+	}
+	dr.writeGutter(buf, width, "")
+	dr.writeRow(buf, row)
+
+	// the messages of the other labels hang below their underlines
+	for i := len(inline) - 2; i >= 0; i-- {
+		if inline[i].Message == "" {
+			continue
+		}
+		for _, message := range []bool{false, true} {
+			row = row[:0]
+			bars(n, &row)
+			for _, l := range inline[:i+1] {
+				if l.Message == "" {
+					continue
+				} else if message && l == inline[i] {
+					row.set(base+l.startCol, l.Message, dr.labelColor(d, l))
+				} else {
+					row.set(base+l.startCol, "|", dr.labelColor(d, l))
+				}
+			}
+			dr.writeGutter(buf, width, "")
+			dr.writeRow(buf, row)
+		}
+	}
+}
+
+// marker returns the underline character of the label
+func (dr *DiagnosticRenderer) marker(l *diagLabel) string {
+	if l.Primary {
+		return "^"
+	}
+	return "-"
+}
+
+// labelColor returns the ANSI SGR parameters of the label
+func (dr *DiagnosticRenderer) labelColor(d Diagnostic, l *diagLabel) string {
+	if l.Primary {
+		return d.Severity.color()
+	}
+	return "1;34"
+}
+
+// paint wraps s in the ANSI SGR sequence given, when colour is enabled
+func (dr *DiagnosticRenderer) paint(color, s string) string {
+	if !dr.Color || color == "" || s == "" {
+		return s
+	}
+	return "\x1b[" + color + "m" + s + "\x1b[0m"
+}
+
+// writeGutter writes the line number column and separator
+func (dr *DiagnosticRenderer) writeGutter(buf *strings.Builder, width int, number string) {
+	buf.WriteString(dr.paint("1;34", strings.Repeat(" ", width-len(number))+number+" |"))
+}
+
+// writeRow writes a row following the gutter, without trailing spaces
+func (dr *DiagnosticRenderer) writeRow(buf *strings.Builder, row diagRow) {
+	end := len(row)
+	for end > 0 && row[end-1].ch == ' ' && row[end-1].color == "" {
+		end--
+	}
+	if end > 0 {
+		buf.WriteByte(' ')
+	}
+	for start := 0; start < end; {
+		next := start
+		var run strings.Builder
+		for next < end && row[next].color == row[start].color {
+			run.WriteRune(row[next].ch)
+			next++
+		}
+		buf.WriteString(dr.paint(row[start].color, run.String()))
+		start = next
+	}
+	buf.WriteByte('\n')
+}
@@ -0,0 +1,126 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes_test
+
+import (
+	"testing"
+
+	. "github.com/go-corelibs/runes"
+)
+
+func checkRender(t *testing.T, r RuneReader, context int, d Diagnostic, want string) {
+	t.Helper()
+	dr := NewDiagnosticRenderer(r)
+	dr.Context = context
+	if got, err := dr.Render(d); got != want || err != nil {
+		t.Errorf("%s: got %v\n%s\nwant:\n%s", d.Message, err, got, want)
+	}
+}
+
+func TestDiagnosticRenderer(t *testing.T) {
+	checkRender(t, NewStringReader("fn main() {\n\tlet x: u8 = \"text\";\n    let y = 1;\n}\n"), 1, Diagnostic{
+		Message:  "mismatched types",
+		Filename: "main.rs",
+		Labels: []DiagnosticLabel{
+			{Start: 25, End: 31, Message: "expected u8", Primary: true},
+			{Start: 20, End: 22, Message: "expected due to this"},
+		},
+		Notes: []string{"see the docs"},
+	}, `error: mismatched types
+ --> main.rs:2:14
+  |
+1 | fn main() {
+2 |     let x: u8 = "text";
+  |            --   ^^^^^^ expected u8
+  |            |
+  |            expected due to this
+3 |     let y = 1;
+  |
+  = note: see the docs
+`)
+
+	src := "package main\n\nfunc main() {\n\tx := 1\n\ty := 2\n\tz := 3\n\tw := 4\n\tv := 5\n\tu := 6\n}\n"
+	checkRender(t, NewStringReader(src), 0, Diagnostic{
+		Severity: SeverityNote,
+		Message:  "long",
+		Filename: "main.go",
+		Labels: []DiagnosticLabel{
+			{Start: 26, End: 77, Message: "body", Primary: true},
+			{Start: 37, End: 43, Message: "inner", Primary: true},
+		},
+	}, `note: long
+  --> main.go:3:13
+   |
+ 3 |   func main() {
+   |  _____________^
+...  |
+ 5 | |     y := 2
+   | |     ^^^^^^ inner
+...  |
+10 | | }
+   | |_^ body
+`)
+
+	checkRender(t, NewRunesReader([]rune(src)), 0, Diagnostic{
+		Severity: SeverityWarning,
+		Message:  "nested",
+		Labels: []DiagnosticLabel{
+			{Start: 14, End: 50, Message: "outer"},
+			{Start: 33, End: 47, Message: "inner", Primary: true},
+		},
+	}, `warning: nested
+ --> 4:6
+  |
+3 |     func main() {
+  |  ___-
+4 | |       x := 1
+  | |  _________^
+5 | | |     y := 2
+6 | | |     z := 3
+  | | |______^ inner
+  | |___________- outer
+`)
+}
+
+func TestDiagnosticRendererWide(t *testing.T) {
+	want := "help: wide\n" +
+		" --> 1:7\n" +
+		"  |\n" +
+		"1 | // \u4e16\u754c x\n" +
+		"  |    ---- ^ point\n" +
+		"  |    |\n" +
+		"  |    wide\n"
+	text := "// \u4e16\u754c x\n"
+	checkRender(t, NewStringReader(text), 0, Diagnostic{Severity: SeverityHelp, Message: "wide", Labels: []DiagnosticLabel{
+		{Start: 3, End: 9, Message: "wide"}, {Start: 10, End: 10, Message: "point", Primary: true},
+	}}, want)
+	checkRender(t, NewRunesReader([]rune(text)), 0, Diagnostic{Severity: SeverityHelp, Message: "wide", Labels: []DiagnosticLabel{
+		{Start: 3, End: 5, Message: "wide"}, {Start: 6, End: 6, Message: "point", Primary: true},
+	}}, want)
+}
+
+func TestDiagnosticRendererColor(t *testing.T) {
+	dr := NewDiagnosticRenderer(NewStringReader("x = y\n"))
+	dr.Color = true
+	got, err := dr.Render(Diagnostic{Message: "bad", Labels: []DiagnosticLabel{{Start: 4, End: 5, Message: "here", Primary: true}}})
+	want := "\x1b[1;31merror\x1b[0m\x1b[1m: bad\x1b[0m\n" +
+		" \x1b[1;34m--> \x1b[0m1:5\n" +
+		"\x1b[1;34m  |\x1b[0m\n" +
+		"\x1b[1;34m1 |\x1b[0m x = y\n" +
+		"\x1b[1;34m  |\x1b[0m     \x1b[1;31m^\x1b[0m \x1b[1;31mhere\x1b[0m\n" +
+		"\x1b[1;34m2 |\x1b[0m\n"
+	if got != want || err != nil {
+		t.Errorf("got %q,%v; want %q", got, err, want)
+	}
+
+	for _, label := range []DiagnosticLabel{{Start: -1, End: 1}, {Start: 3, End: 2}, {Start: 0, End: 7}} {
+		if _, err = dr.Render(Diagnostic{Labels: []DiagnosticLabel{label}}); err == nil {
+			t.Errorf("%+v: expected error", label)
+		}
+	}
+	if got, err = dr.Render(Diagnostic{Severity: SeverityNote, Message: "plain"}); err != nil || got != "\x1b[1;32mnote\x1b[0m\x1b[1m: plain\x1b[0m\n" {
+		t.Errorf("no labels got %q,%v", got, err)
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/clipperhouse/displaywidth"
+)
+
+// ControlStyle selects how a DisplayReader shows control characters
+type ControlStyle uint8
+
+const (
+	// ControlCaret shows control characters in caret notation, such as ^M
+	// for a carriage return and ^? for delete. The C1 controls are shown as
+	// their 7-bit escape sequences, such as ^[E for next line
+	ControlCaret ControlStyle = iota
+	// ControlPictures shows control characters as the Unicode Control
+	// Pictures, such as U+240D for a carriage return. The C1 controls are
+	// shown as the picture of escape followed by the final character of
+	// their 7-bit escape sequences
+	ControlPictures
+	// ControlRaw leaves control characters as they are
+	ControlRaw
+)
+
+// displayCell records where one grapheme cluster of the source is shown
+type displayCell struct {
+	src int64 // native index in the source
+	out int64 // native index in the display text
+	col int   // display column within its line
+}
+
+// DisplayReader is a RuneReader presenting the text of another RuneReader as
+// it should be shown on a terminal, with tabs expanded to spaces at the tab
+// stops and control characters made visible. Line feeds are kept as they
+// are and begin a new line of columns
+//
+// SourceIndex and DisplayIndex convert between indices of the display text
+// and the source, Column returns the display column of a source index and
+// SourceAtColumn finds the source index shown at a display column. Columns
+// are measured in terminal cells, so wide characters occupy two columns
+//
+// The display text is produced when the DisplayReader is created and the
+// source reader is not used after that
+type DisplayReader struct {
+	textRuneReader
+	runes bool
+	size  int64
+	cells []displayCell
+	lines []int // index in cells of the first cell of each line
+}
+
+// NewDisplayReader returns a new DisplayReader for the text of src with tab
+// stops every tabWidth columns, or every eight columns when tabWidth is not
+// positive, and control characters shown in the style given
+//
+// NewDisplayReader was added by go-corelibs
+func NewDisplayReader(src RuneReader, tabWidth int, style ControlStyle) (r *DisplayReader, err error) {
+	var text string
+	r = &DisplayReader{size: src.Size()}
+	if text, r.runes, err = readText(src); err != nil {
+		return nil, err
+	}
+	if tabWidth <= 0 {
+		tabWidth = 8
+	}
+
+	var out strings.Builder
+	var srcIndex, outIndex int64
+	var col int
+	r.lines = append(r.lines, 0)
+	for len(text) > 0 {
+		line, rest, found := strings.Cut(text, "\n")
+		col = 0
+		gs := displaywidth.StringGraphemes(line)
+		for gs.Next() {
+			value := gs.Value()
+			r.cells = append(r.cells, displayCell{src: srcIndex, out: outIndex, col: col})
+			shown, width := value, gs.Width()
+			if value == "\t" {
+				width = tabWidth - col%tabWidth
+				shown = strings.Repeat(" ", width)
+			} else if ch, size := utf8.DecodeRuneInString(value); size == len(value) && isControl(ch) && style != ControlRaw {
+				shown = controlPicture(ch, style)
+				width = displaywidth.String(shown)
+			}
+			out.WriteString(shown)
+			col += width
+			srcIndex += r.units(value)
+			outIndex += r.units(shown)
+		}
+		if found {
+			r.cells = append(r.cells, displayCell{src: srcIndex, out: outIndex, col: col})
+			r.lines = append(r.lines, len(r.cells))
+			out.WriteByte('\n')
+			srcIndex++
+			outIndex++
+			col = 0
+		}
+		text = rest
+	}
+	// the end of the text
+	r.cells = append(r.cells, displayCell{src: srcIndex, out: outIndex, col: col})
+	r.textRuneReader = textReaderFor(out.String(), r.runes)
+	return r, nil
+}
+
+// units returns the length of s in native units
+func (r *DisplayReader) units(s string) int64 {
+	if r.runes {
+		return int64(utf8.RuneCountInString(s))
+	}
+	return int64(len(s))
+}
+
+// isControl returns true for the C0 and C1 control characters and delete,
+// except for the line feed
+func isControl(ch rune) bool {
+	return ch < 0x20 && ch != '\n' || 0x7f <= ch && ch <= 0x9f
+}
+
+// controlPicture returns how the control character is shown in the style
+// given
+func controlPicture(ch rune, style ControlStyle) string {
+	switch {
+	case ch == 0x7f && style == ControlPictures:
+		return "\u2421"
+	case ch == 0x7f:
+		return "^?"
+	case ch < 0x20 && style == ControlPictures:
+		return string(0x2400 + ch)
+	case ch < 0x20:
+		return "^" + string(ch+0x40)
+	case style == ControlPictures:
+		return "\u241b" + string(ch-0x40)
+	}
+	return "^[" + string(ch-0x40)
+}
+
+// runeIndexed returns true if the native units of the source are runes
+func (r *DisplayReader) runeIndexed() bool { return r.runes }
+
+// cellOf returns the cell shown at the source index given
+func (r *DisplayReader) cellOf(src int64) displayCell {
+	k := sort.Search(len(r.cells), func(i int) bool { return r.cells[i].src > src }) - 1
+	return r.cells[max(k, 0)]
+}
+
+// SourceIndex returns the index in the source of the display index given.
+// Indices within the expansion of a tab or control character map to the
+// source index of that character
+//
+// SourceIndex was added by go-corelibs
+func (r *DisplayReader) SourceIndex(index int64) (src int64, err error) {
+	if index < 0 || index > r.Size() {
+		return -1, errors.New("DisplayReader.SourceIndex: index out of range")
+	}
+	k := sort.Search(len(r.cells), func(i int) bool { return r.cells[i].out > index }) - 1
+	return r.cells[max(k, 0)].src, nil
+}
+
+// DisplayIndex returns the index in the display text of the source index
+// given. Indices within a grapheme cluster map to the start of its display
+//
+// DisplayIndex was added by go-corelibs
+func (r *DisplayReader) DisplayIndex(src int64) (index int64, err error) {
+	if src < 0 || src > r.size {
+		return -1, errors.New("DisplayReader.DisplayIndex: index out of range")
+	}
+	return r.cellOf(src).out, nil
+}
+
+// Column returns the display column, counted from zero, at which the source
+// index given is shown within its line. A line feed and the end of the text
+// are at the column following the end of their line
+//
+// Column was added by go-corelibs
+func (r *DisplayReader) Column(src int64) (col int, err error) {
+	if src < 0 || src > r.size {
+		return -1, errors.New("DisplayReader.Column: index out of range")
+	}
+	return r.cellOf(src).col, nil
+}
+
+// SourceAtColumn returns the source index of the character shown at the
+// display column given of line n, where lines are numbered from zero and
+// separated by line feeds. Columns within a tab, wide character or control
+// picture map to the index of that character, and columns past the end of
+// the line map to the index of its line feed or the end of the text
+//
+// SourceAtColumn was added by go-corelibs
+func (r *DisplayReader) SourceAtColumn(n, col int) (src int64, err error) {
+	if n < 0 || n >= len(r.lines) {
+		return -1, errors.New("DisplayReader.SourceAtColumn: line out of range")
+	} else if col < 0 {
+		return -1, errors.New("DisplayReader.SourceAtColumn: negative column")
+	}
+	first := r.lines[n]
+	last := len(r.cells) - 1
+	if n+1 < len(r.lines) {
+		// the line feed
+		last = r.lines[n+1] - 1
+	}
+	cells := r.cells[first : last+1]
+	k := sort.Search(len(cells), func(i int) bool { return cells[i].col > col }) - 1
+	return cells[max(k, 0)].src, nil
+}
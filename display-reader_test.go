@@ -0,0 +1,100 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes_test
+
+import (
+	"io"
+	"testing"
+
+	. "github.com/go-corelibs/runes"
+)
+
+const displayText = "a\tb\r\n\x01\x7f\u4e16\u0085x\n"
+
+func TestDisplayReaderText(t *testing.T) {
+	for _, tc := range []struct {
+		tab   int
+		style ControlStyle
+		want  string
+	}{
+		{4, ControlCaret, "a   b^M\n^A^?\u4e16^[Ex\n"},
+		{0, ControlCaret, "a       b^M\n^A^?\u4e16^[Ex\n"},
+		{2, ControlPictures, "a b\u240d\n\u2401\u2421\u4e16\u241bEx\n"},
+		{4, ControlRaw, "a   b\r\n\x01\x7f\u4e16\u0085x\n"},
+	} {
+		for _, src := range newCaseReaders(displayText) {
+			r, err := NewDisplayReader(src, tc.tab, tc.style)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, _ := io.ReadAll(r); string(got) != tc.want {
+				t.Errorf("tab %d style %d: got %+q; want %+q", tc.tab, tc.style, got, tc.want)
+			}
+		}
+	}
+}
+
+func TestDisplayReaderIndex(t *testing.T) {
+	r, err := NewDisplayReader(NewStringReader(displayText), 4, ControlCaret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for index, want := range map[int64]int64{0: 0, 2: 1, 4: 2, 6: 3, 7: 4, 13: 7, 16: 10, 18: 12, 20: 14} {
+		if got, err := r.SourceIndex(index); got != want || err != nil {
+			t.Errorf("SourceIndex(%d): got %d,%v; want %d", index, got, err, want)
+		}
+	}
+	for src, want := range map[int64]int64{1: 1, 3: 5, 8: 12, 12: 18, 14: 20} {
+		if got, err := r.DisplayIndex(src); got != want || err != nil {
+			t.Errorf("DisplayIndex(%d): got %d,%v; want %d", src, got, err, want)
+		}
+	}
+	for src, want := range map[int64]int{0: 0, 2: 4, 3: 5, 4: 7, 7: 4, 10: 6, 12: 9, 13: 10, 14: 0} {
+		if got, err := r.Column(src); got != want || err != nil {
+			t.Errorf("Column(%d): got %d,%v; want %d", src, got, err, want)
+		}
+	}
+	for _, tc := range [][3]int64{
+		{0, 0, 0}, {0, 2, 1}, {0, 4, 2}, {0, 6, 3}, {0, 7, 4}, {0, 100, 4},
+		{1, 5, 7}, {1, 8, 10}, {1, 9, 12}, {1, 50, 13}, {2, 0, 14},
+	} {
+		if got, err := r.SourceAtColumn(int(tc[0]), int(tc[1])); got != tc[2] || err != nil {
+			t.Errorf("SourceAtColumn(%d, %d): got %d,%v; want %d", tc[0], tc[1], got, err, tc[2])
+		}
+	}
+
+	if _, err = r.SourceIndex(21); err == nil {
+		t.Errorf("SourceIndex(21): expected error")
+	}
+	if _, err = r.DisplayIndex(-1); err == nil {
+		t.Errorf("DisplayIndex(-1): expected error")
+	}
+	if _, err = r.Column(15); err == nil {
+		t.Errorf("Column(15): expected error")
+	}
+	if _, err = r.SourceAtColumn(3, 0); err == nil {
+		t.Errorf("SourceAtColumn(3, 0): expected error")
+	}
+	if _, err = r.SourceAtColumn(0, -1); err == nil {
+		t.Errorf("SourceAtColumn(0, -1): expected error")
+	}
+
+	r, err = NewDisplayReader(NewRunesReader([]rune(displayText)), 4, ControlCaret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := r.DisplayIndex(8); got != 13 {
+		t.Errorf("runes DisplayIndex(8): got %d; want 13", got)
+	}
+	if got, _ := r.SourceIndex(14); got != 8 {
+		t.Errorf("runes SourceIndex(14): got %d; want 8", got)
+	}
+	if got, _ := r.Column(9); got != 9 {
+		t.Errorf("runes Column(9): got %d; want 9", got)
+	}
+	if got, _ := r.SourceAtColumn(1, 5); got != 7 {
+		t.Errorf("runes SourceAtColumn(1, 5): got %d; want 7", got)
+	}
+}
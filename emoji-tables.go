@@ -0,0 +1,2871 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+// Code generated by gen-emoji-tables.go; DO NOT EDIT.
+
+package runes
+
+import (
+	"unicode"
+)
+
+// The following tables contain the RGI emoji of the Unicode Emoji 17.0
+// emoji-sequences.txt and emoji-zwj-sequences.txt from UTS #51, Unicode Emoji
+
+// emojiMaxSequence is the number of runes in the longest emoji sequence
+const emojiMaxSequence = 10
+
+// emojiBasic is the Basic_Emoji which are single runes, shown with emoji
+// presentation by default
+var emojiBasic = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x231A, Hi: 0x231B, Stride: 1},
+		{Lo: 0x23E9, Hi: 0x23EC, Stride: 1},
+		{Lo: 0x23F0, Hi: 0x23F0, Stride: 1},
+		{Lo: 0x23F3, Hi: 0x23F3, Stride: 1},
+		{Lo: 0x25FD, Hi: 0x25FE, Stride: 1},
+		{Lo: 0x2614, Hi: 0x2615, Stride: 1},
+		{Lo: 0x2648, Hi: 0x2653, Stride: 1},
+		{Lo: 0x267F, Hi: 0x267F, Stride: 1},
+		{Lo: 0x2693, Hi: 0x2693, Stride: 1},
+		{Lo: 0x26A1, Hi: 0x26A1, Stride: 1},
+		{Lo: 0x26AA, Hi: 0x26AB, Stride: 1},
+		{Lo: 0x26BD, Hi: 0x26BE, Stride: 1},
+		{Lo: 0x26C4, Hi: 0x26C5, Stride: 1},
+		{Lo: 0x26CE, Hi: 0x26CE, Stride: 1},
+		{Lo: 0x26D4, Hi: 0x26D4, Stride: 1},
+		{Lo: 0x26EA, Hi: 0x26EA, Stride: 1},
+		{Lo: 0x26F2, Hi: 0x26F3, Stride: 1},
+		{Lo: 0x26F5, Hi: 0x26F5, Stride: 1},
+		{Lo: 0x26FA, Hi: 0x26FA, Stride: 1},
+		{Lo: 0x26FD, Hi: 0x26FD, Stride: 1},
+		{Lo: 0x2705, Hi: 0x2705, Stride: 1},
+		{Lo: 0x270A, Hi: 0x270B, Stride: 1},
+		{Lo: 0x2728, Hi: 0x2728, Stride: 1},
+		{Lo: 0x274C, Hi: 0x274C, Stride: 1},
+		{Lo: 0x274E, Hi: 0x274E, Stride: 1},
+		{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+		{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+		{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+		{Lo: 0x27B0, Hi: 0x27B0, Stride: 1},
+		{Lo: 0x27BF, Hi: 0x27BF, Stride: 1},
+		{Lo: 0x2B1B, Hi: 0x2B1C, Stride: 1},
+		{Lo: 0x2B50, Hi: 0x2B50, Stride: 1},
+		{Lo: 0x2B55, Hi: 0x2B55, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1F004, Hi: 0x1F004, Stride: 1},
+		{Lo: 0x1F0CF, Hi: 0x1F0CF, Stride: 1},
+		{Lo: 0x1F18E, Hi: 0x1F18E, Stride: 1},
+		{Lo: 0x1F191, Hi: 0x1F19A, Stride: 1},
+		{Lo: 0x1F201, Hi: 0x1F201, Stride: 1},
+		{Lo: 0x1F21A, Hi: 0x1F21A, Stride: 1},
+		{Lo: 0x1F22F, Hi: 0x1F22F, Stride: 1},
+		{Lo: 0x1F232, Hi: 0x1F236, Stride: 1},
+		{Lo: 0x1F238, Hi: 0x1F23A, Stride: 1},
+		{Lo: 0x1F250, Hi: 0x1F251, Stride: 1},
+		{Lo: 0x1F300, Hi: 0x1F320, Stride: 1},
+		{Lo: 0x1F32D, Hi: 0x1F335, Stride: 1},
+		{Lo: 0x1F337, Hi: 0x1F37C, Stride: 1},
+		{Lo: 0x1F37E, Hi: 0x1F393, Stride: 1},
+		{Lo: 0x1F3A0, Hi: 0x1F3CA, Stride: 1},
+		{Lo: 0x1F3CF, Hi: 0x1F3D3, Stride: 1},
+		{Lo: 0x1F3E0, Hi: 0x1F3F0, Stride: 1},
+		{Lo: 0x1F3F4, Hi: 0x1F3F4, Stride: 1},
+		{Lo: 0x1F3F8, Hi: 0x1F43E, Stride: 1},
+		{Lo: 0x1F440, Hi: 0x1F440, Stride: 1},
+		{Lo: 0x1F442, Hi: 0x1F4FC, Stride: 1},
+		{Lo: 0x1F4FF, Hi: 0x1F53D, Stride: 1},
+		{Lo: 0x1F54B, Hi: 0x1F54E, Stride: 1},
+		{Lo: 0x1F550, Hi: 0x1F567, Stride: 1},
+		{Lo: 0x1F57A, Hi: 0x1F57A, Stride: 1},
+		{Lo: 0x1F595, Hi: 0x1F596, Stride: 1},
+		{Lo: 0x1F5A4, Hi: 0x1F5A4, Stride: 1},
+		{Lo: 0x1F5FB, Hi: 0x1F64F, Stride: 1},
+		{Lo: 0x1F680, Hi: 0x1F6C5, Stride: 1},
+		{Lo: 0x1F6CC, Hi: 0x1F6CC, Stride: 1},
+		{Lo: 0x1F6D0, Hi: 0x1F6D2, Stride: 1},
+		{Lo: 0x1F6D5, Hi: 0x1F6D8, Stride: 1},
+		{Lo: 0x1F6DC, Hi: 0x1F6DF, Stride: 1},
+		{Lo: 0x1F6EB, Hi: 0x1F6EC, Stride: 1},
+		{Lo: 0x1F6F4, Hi: 0x1F6FC, Stride: 1},
+		{Lo: 0x1F7E0, Hi: 0x1F7EB, Stride: 1},
+		{Lo: 0x1F7F0, Hi: 0x1F7F0, Stride: 1},
+		{Lo: 0x1F90C, Hi: 0x1F93A, Stride: 1},
+		{Lo: 0x1F93C, Hi: 0x1F945, Stride: 1},
+		{Lo: 0x1F947, Hi: 0x1F9FF, Stride: 1},
+		{Lo: 0x1FA70, Hi: 0x1FA7C, Stride: 1},
+		{Lo: 0x1FA80, Hi: 0x1FA8A, Stride: 1},
+		{Lo: 0x1FA8E, Hi: 0x1FAC6, Stride: 1},
+		{Lo: 0x1FAC8, Hi: 0x1FAC8, Stride: 1},
+		{Lo: 0x1FACD, Hi: 0x1FADC, Stride: 1},
+		{Lo: 0x1FADF, Hi: 0x1FAEA, Stride: 1},
+		{Lo: 0x1FAEF, Hi: 0x1FAF8, Stride: 1},
+	},
+	LatinOffset: 0,
+}
+
+// emojiSequences maps the emoji sequences of more than one rune to their kind
+var emojiSequences = map[string]EmojiKind{
+	"#\uFE0F\u20E3":                                  EmojiKeycap,
+	"*\uFE0F\u20E3":                                  EmojiKeycap,
+	"0\uFE0F\u20E3":                                  EmojiKeycap,
+	"1\uFE0F\u20E3":                                  EmojiKeycap,
+	"2\uFE0F\u20E3":                                  EmojiKeycap,
+	"3\uFE0F\u20E3":                                  EmojiKeycap,
+	"4\uFE0F\u20E3":                                  EmojiKeycap,
+	"5\uFE0F\u20E3":                                  EmojiKeycap,
+	"6\uFE0F\u20E3":                                  EmojiKeycap,
+	"7\uFE0F\u20E3":                                  EmojiKeycap,
+	"8\uFE0F\u20E3":                                  EmojiKeycap,
+	"9\uFE0F\u20E3":                                  EmojiKeycap,
+	"\u00A9\uFE0F":                                   EmojiBasic,
+	"\u00AE\uFE0F":                                   EmojiBasic,
+	"\u203C\uFE0F":                                   EmojiBasic,
+	"\u2049\uFE0F":                                   EmojiBasic,
+	"\u2122\uFE0F":                                   EmojiBasic,
+	"\u2139\uFE0F":                                   EmojiBasic,
+	"\u2194\uFE0F":                                   EmojiBasic,
+	"\u2195\uFE0F":                                   EmojiBasic,
+	"\u2196\uFE0F":                                   EmojiBasic,
+	"\u2197\uFE0F":                                   EmojiBasic,
+	"\u2198\uFE0F":                                   EmojiBasic,
+	"\u2199\uFE0F":                                   EmojiBasic,
+	"\u21A9\uFE0F":                                   EmojiBasic,
+	"\u21AA\uFE0F":                                   EmojiBasic,
+	"\u2328\uFE0F":                                   EmojiBasic,
+	"\u23CF\uFE0F":                                   EmojiBasic,
+	"\u23ED\uFE0F":                                   EmojiBasic,
+	"\u23EE\uFE0F":                                   EmojiBasic,
+	"\u23EF\uFE0F":                                   EmojiBasic,
+	"\u23F1\uFE0F":                                   EmojiBasic,
+	"\u23F2\uFE0F":                                   EmojiBasic,
+	"\u23F8\uFE0F":                                   EmojiBasic,
+	"\u23F9\uFE0F":                                   EmojiBasic,
+	"\u23FA\uFE0F":                                   EmojiBasic,
+	"\u24C2\uFE0F":                                   EmojiBasic,
+	"\u25AA\uFE0F":                                   EmojiBasic,
+	"\u25AB\uFE0F":                                   EmojiBasic,
+	"\u25B6\uFE0F":                                   EmojiBasic,
+	"\u25C0\uFE0F":                                   EmojiBasic,
+	"\u25FB\uFE0F":                                   EmojiBasic,
+	"\u25FC\uFE0F":                                   EmojiBasic,
+	"\u2600\uFE0F":                                   EmojiBasic,
+	"\u2601\uFE0F":                                   EmojiBasic,
+	"\u2602\uFE0F":                                   EmojiBasic,
+	"\u2603\uFE0F":                                   EmojiBasic,
+	"\u2604\uFE0F":                                   EmojiBasic,
+	"\u260E\uFE0F":                                   EmojiBasic,
+	"\u2611\uFE0F":                                   EmojiBasic,
+	"\u2618\uFE0F":                                   EmojiBasic,
+	"\u261D\uFE0F":                                   EmojiBasic,
+	"\u261D\U0001F3FB":                               EmojiModifier,
+	"\u261D\U0001F3FC":                               EmojiModifier,
+	"\u261D\U0001F3FD":                               EmojiModifier,
+	"\u261D\U0001F3FE":                               EmojiModifier,
+	"\u261D\U0001F3FF":                               EmojiModifier,
+	"\u2620\uFE0F":                                   EmojiBasic,
+	"\u2622\uFE0F":                                   EmojiBasic,
+	"\u2623\uFE0F":                                   EmojiBasic,
+	"\u2626\uFE0F":                                   EmojiBasic,
+	"\u262A\uFE0F":                                   EmojiBasic,
+	"\u262E\uFE0F":                                   EmojiBasic,
+	"\u262F\uFE0F":                                   EmojiBasic,
+	"\u2638\uFE0F":                                   EmojiBasic,
+	"\u2639\uFE0F":                                   EmojiBasic,
+	"\u263A\uFE0F":                                   EmojiBasic,
+	"\u2640\uFE0F":                                   EmojiBasic,
+	"\u2642\uFE0F":                                   EmojiBasic,
+	"\u265F\uFE0F":                                   EmojiBasic,
+	"\u2660\uFE0F":                                   EmojiBasic,
+	"\u2663\uFE0F":                                   EmojiBasic,
+	"\u2665\uFE0F":                                   EmojiBasic,
+	"\u2666\uFE0F":                                   EmojiBasic,
+	"\u2668\uFE0F":                                   EmojiBasic,
+	"\u267B\uFE0F":                                   EmojiBasic,
+	"\u267E\uFE0F":                                   EmojiBasic,
+	"\u2692\uFE0F":                                   EmojiBasic,
+	"\u2694\uFE0F":                                   EmojiBasic,
+	"\u2695\uFE0F":                                   EmojiBasic,
+	"\u2696\uFE0F":                                   EmojiBasic,
+	"\u2697\uFE0F":                                   EmojiBasic,
+	"\u2699\uFE0F":                                   EmojiBasic,
+	"\u269B\uFE0F":                                   EmojiBasic,
+	"\u269C\uFE0F":                                   EmojiBasic,
+	"\u26A0\uFE0F":                                   EmojiBasic,
+	"\u26A7\uFE0F":                                   EmojiBasic,
+	"\u26B0\uFE0F":                                   EmojiBasic,
+	"\u26B1\uFE0F":                                   EmojiBasic,
+	"\u26C8\uFE0F":                                   EmojiBasic,
+	"\u26CF\uFE0F":                                   EmojiBasic,
+	"\u26D1\uFE0F":                                   EmojiBasic,
+	"\u26D3\uFE0F":                                   EmojiBasic,
+	"\u26D3\uFE0F\u200D\U0001F4A5":                   EmojiZWJ,
+	"\u26E9\uFE0F":                                   EmojiBasic,
+	"\u26F0\uFE0F":                                   EmojiBasic,
+	"\u26F1\uFE0F":                                   EmojiBasic,
+	"\u26F4\uFE0F":                                   EmojiBasic,
+	"\u26F7\uFE0F":                                   EmojiBasic,
+	"\u26F8\uFE0F":                                   EmojiBasic,
+	"\u26F9\uFE0F":                                   EmojiBasic,
+	"\u26F9\uFE0F\u200D\u2640\uFE0F":                 EmojiZWJ,
+	"\u26F9\uFE0F\u200D\u2642\uFE0F":                 EmojiZWJ,
+	"\u26F9\U0001F3FB":                               EmojiModifier,
+	"\u26F9\U0001F3FB\u200D\u2640\uFE0F":             EmojiZWJ,
+	"\u26F9\U0001F3FB\u200D\u2642\uFE0F":             EmojiZWJ,
+	"\u26F9\U0001F3FC":                               EmojiModifier,
+	"\u26F9\U0001F3FC\u200D\u2640\uFE0F":             EmojiZWJ,
+	"\u26F9\U0001F3FC\u200D\u2642\uFE0F":             EmojiZWJ,
+	"\u26F9\U0001F3FD":                               EmojiModifier,
+	"\u26F9\U0001F3FD\u200D\u2640\uFE0F":             EmojiZWJ,
+	"\u26F9\U0001F3FD\u200D\u2642\uFE0F":             EmojiZWJ,
+	"\u26F9\U0001F3FE":                               EmojiModifier,
+	"\u26F9\U0001F3FE\u200D\u2640\uFE0F":             EmojiZWJ,
+	"\u26F9\U0001F3FE\u200D\u2642\uFE0F":             EmojiZWJ,
+	"\u26F9\U0001F3FF":                               EmojiModifier,
+	"\u26F9\U0001F3FF\u200D\u2640\uFE0F":             EmojiZWJ,
+	"\u26F9\U0001F3FF\u200D\u2642\uFE0F":             EmojiZWJ,
+	"\u2702\uFE0F":                                   EmojiBasic,
+	"\u2708\uFE0F":                                   EmojiBasic,
+	"\u2709\uFE0F":                                   EmojiBasic,
+	"\u270A\U0001F3FB":                               EmojiModifier,
+	"\u270A\U0001F3FC":                               EmojiModifier,
+	"\u270A\U0001F3FD":                               EmojiModifier,
+	"\u270A\U0001F3FE":                               EmojiModifier,
+	"\u270A\U0001F3FF":                               EmojiModifier,
+	"\u270B\U0001F3FB":                               EmojiModifier,
+	"\u270B\U0001F3FC":                               EmojiModifier,
+	"\u270B\U0001F3FD":                               EmojiModifier,
+	"\u270B\U0001F3FE":                               EmojiModifier,
+	"\u270B\U0001F3FF":                               EmojiModifier,
+	"\u270C\uFE0F":                                   EmojiBasic,
+	"\u270C\U0001F3FB":                               EmojiModifier,
+	"\u270C\U0001F3FC":                               EmojiModifier,
+	"\u270C\U0001F3FD":                               EmojiModifier,
+	"\u270C\U0001F3FE":                               EmojiModifier,
+	"\u270C\U0001F3FF":                               EmojiModifier,
+	"\u270D\uFE0F":                                   EmojiBasic,
+	"\u270D\U0001F3FB":                               EmojiModifier,
+	"\u270D\U0001F3FC":                               EmojiModifier,
+	"\u270D\U0001F3FD":                               EmojiModifier,
+	"\u270D\U0001F3FE":                               EmojiModifier,
+	"\u270D\U0001F3FF":                               EmojiModifier,
+	"\u270F\uFE0F":                                   EmojiBasic,
+	"\u2712\uFE0F":                                   EmojiBasic,
+	"\u2714\uFE0F":                                   EmojiBasic,
+	"\u2716\uFE0F":                                   EmojiBasic,
+	"\u271D\uFE0F":                                   EmojiBasic,
+	"\u2721\uFE0F":                                   EmojiBasic,
+	"\u2733\uFE0F":                                   EmojiBasic,
+	"\u2734\uFE0F":                                   EmojiBasic,
+	"\u2744\uFE0F":                                   EmojiBasic,
+	"\u2747\uFE0F":                                   EmojiBasic,
+	"\u2763\uFE0F":                                   EmojiBasic,
+	"\u2764\uFE0F":                                   EmojiBasic,
+	"\u2764\uFE0F\u200D\U0001F525":                   EmojiZWJ,
+	"\u2764\uFE0F\u200D\U0001FA79":                   EmojiZWJ,
+	"\u27A1\uFE0F":                                   EmojiBasic,
+	"\u2934\uFE0F":                                   EmojiBasic,
+	"\u2935\uFE0F":                                   EmojiBasic,
+	"\u2B05\uFE0F":                                   EmojiBasic,
+	"\u2B06\uFE0F":                                   EmojiBasic,
+	"\u2B07\uFE0F":                                   EmojiBasic,
+	"\u3030\uFE0F":                                   EmojiBasic,
+	"\u303D\uFE0F":                                   EmojiBasic,
+	"\u3297\uFE0F":                                   EmojiBasic,
+	"\u3299\uFE0F":                                   EmojiBasic,
+	"\U0001F170\uFE0F":                               EmojiBasic,
+	"\U0001F171\uFE0F":                               EmojiBasic,
+	"\U0001F17E\uFE0F":                               EmojiBasic,
+	"\U0001F17F\uFE0F":                               EmojiBasic,
+	"\U0001F1E6\U0001F1E8":                           EmojiFlag,
+	"\U0001F1E6\U0001F1E9":                           EmojiFlag,
+	"\U0001F1E6\U0001F1EA":                           EmojiFlag,
+	"\U0001F1E6\U0001F1EB":                           EmojiFlag,
+	"\U0001F1E6\U0001F1EC":                           EmojiFlag,
+	"\U0001F1E6\U0001F1EE":                           EmojiFlag,
+	"\U0001F1E6\U0001F1F1":                           EmojiFlag,
+	"\U0001F1E6\U0001F1F2":                           EmojiFlag,
+	"\U0001F1E6\U0001F1F4":                           EmojiFlag,
+	"\U0001F1E6\U0001F1F6":                           EmojiFlag,
+	"\U0001F1E6\U0001F1F7":                           EmojiFlag,
+	"\U0001F1E6\U0001F1F8":                           EmojiFlag,
+	"\U0001F1E6\U0001F1F9":                           EmojiFlag,
+	"\U0001F1E6\U0001F1FA":                           EmojiFlag,
+	"\U0001F1E6\U0001F1FC":                           EmojiFlag,
+	"\U0001F1E6\U0001F1FD":                           EmojiFlag,
+	"\U0001F1E6\U0001F1FF":                           EmojiFlag,
+	"\U0001F1E7\U0001F1E6":                           EmojiFlag,
+	"\U0001F1E7\U0001F1E7":                           EmojiFlag,
+	"\U0001F1E7\U0001F1E9":                           EmojiFlag,
+	"\U0001F1E7\U0001F1EA":                           EmojiFlag,
+	"\U0001F1E7\U0001F1EB":                           EmojiFlag,
+	"\U0001F1E7\U0001F1EC":                           EmojiFlag,
+	"\U0001F1E7\U0001F1ED":                           EmojiFlag,
+	"\U0001F1E7\U0001F1EE":                           EmojiFlag,
+	"\U0001F1E7\U0001F1EF":                           EmojiFlag,
+	"\U0001F1E7\U0001F1F1":                           EmojiFlag,
+	"\U0001F1E7\U0001F1F2":                           EmojiFlag,
+	"\U0001F1E7\U0001F1F3":                           EmojiFlag,
+	"\U0001F1E7\U0001F1F4":                           EmojiFlag,
+	"\U0001F1E7\U0001F1F6":                           EmojiFlag,
+	"\U0001F1E7\U0001F1F7":                           EmojiFlag,
+	"\U0001F1E7\U0001F1F8":                           EmojiFlag,
+	"\U0001F1E7\U0001F1F9":                           EmojiFlag,
+	"\U0001F1E7\U0001F1FB":                           EmojiFlag,
+	"\U0001F1E7\U0001F1FC":                           EmojiFlag,
+	"\U0001F1E7\U0001F1FE":                           EmojiFlag,
+	"\U0001F1E7\U0001F1FF":                           EmojiFlag,
+	"\U0001F1E8\U0001F1E6":                           EmojiFlag,
+	"\U0001F1E8\U0001F1E8":                           EmojiFlag,
+	"\U0001F1E8\U0001F1E9":                           EmojiFlag,
+	"\U0001F1E8\U0001F1EB":                           EmojiFlag,
+	"\U0001F1E8\U0001F1EC":                           EmojiFlag,
+	"\U0001F1E8\U0001F1ED":                           EmojiFlag,
+	"\U0001F1E8\U0001F1EE":                           EmojiFlag,
+	"\U0001F1E8\U0001F1F0":                           EmojiFlag,
+	"\U0001F1E8\U0001F1F1":                           EmojiFlag,
+	"\U0001F1E8\U0001F1F2":                           EmojiFlag,
+	"\U0001F1E8\U0001F1F3":                           EmojiFlag,
+	"\U0001F1E8\U0001F1F4":                           EmojiFlag,
+	"\U0001F1E8\U0001F1F5":                           EmojiFlag,
+	"\U0001F1E8\U0001F1F6":                           EmojiFlag,
+	"\U0001F1E8\U0001F1F7":                           EmojiFlag,
+	"\U0001F1E8\U0001F1FA":                           EmojiFlag,
+	"\U0001F1E8\U0001F1FB":                           EmojiFlag,
+	"\U0001F1E8\U0001F1FC":                           EmojiFlag,
+	"\U0001F1E8\U0001F1FD":                           EmojiFlag,
+	"\U0001F1E8\U0001F1FE":                           EmojiFlag,
+	"\U0001F1E8\U0001F1FF":                           EmojiFlag,
+	"\U0001F1E9\U0001F1EA":                           EmojiFlag,
+	"\U0001F1E9\U0001F1EC":                           EmojiFlag,
+	"\U0001F1E9\U0001F1EF":                           EmojiFlag,
+	"\U0001F1E9\U0001F1F0":                           EmojiFlag,
+	"\U0001F1E9\U0001F1F2":                           EmojiFlag,
+	"\U0001F1E9\U0001F1F4":                           EmojiFlag,
+	"\U0001F1E9\U0001F1FF":                           EmojiFlag,
+	"\U0001F1EA\U0001F1E6":                           EmojiFlag,
+	"\U0001F1EA\U0001F1E8":                           EmojiFlag,
+	"\U0001F1EA\U0001F1EA":                           EmojiFlag,
+	"\U0001F1EA\U0001F1EC":                           EmojiFlag,
+	"\U0001F1EA\U0001F1ED":                           EmojiFlag,
+	"\U0001F1EA\U0001F1F7":                           EmojiFlag,
+	"\U0001F1EA\U0001F1F8":                           EmojiFlag,
+	"\U0001F1EA\U0001F1F9":                           EmojiFlag,
+	"\U0001F1EA\U0001F1FA":                           EmojiFlag,
+	"\U0001F1EB\U0001F1EE":                           EmojiFlag,
+	"\U0001F1EB\U0001F1EF":                           EmojiFlag,
+	"\U0001F1EB\U0001F1F0":                           EmojiFlag,
+	"\U0001F1EB\U0001F1F2":                           EmojiFlag,
+	"\U0001F1EB\U0001F1F4":                           EmojiFlag,
+	"\U0001F1EB\U0001F1F7":                           EmojiFlag,
+	"\U0001F1EC\U0001F1E6":                           EmojiFlag,
+	"\U0001F1EC\U0001F1E7":                           EmojiFlag,
+	"\U0001F1EC\U0001F1E9":                           EmojiFlag,
+	"\U0001F1EC\U0001F1EA":                           EmojiFlag,
+	"\U0001F1EC\U0001F1EB":                           EmojiFlag,
+	"\U0001F1EC\U0001F1EC":                           EmojiFlag,
+	"\U0001F1EC\U0001F1ED":                           EmojiFlag,
+	"\U0001F1EC\U0001F1EE":                           EmojiFlag,
+	"\U0001F1EC\U0001F1F1":                           EmojiFlag,
+	"\U0001F1EC\U0001F1F2":                           EmojiFlag,
+	"\U0001F1EC\U0001F1F3":                           EmojiFlag,
+	"\U0001F1EC\U0001F1F5":                           EmojiFlag,
+	"\U0001F1EC\U0001F1F6":                           EmojiFlag,
+	"\U0001F1EC\U0001F1F7":                           EmojiFlag,
+	"\U0001F1EC\U0001F1F8":                           EmojiFlag,
+	"\U0001F1EC\U0001F1F9":                           EmojiFlag,
+	"\U0001F1EC\U0001F1FA":                           EmojiFlag,
+	"\U0001F1EC\U0001F1FC":                           EmojiFlag,
+	"\U0001F1EC\U0001F1FE":                           EmojiFlag,
+	"\U0001F1ED\U0001F1F0":                           EmojiFlag,
+	"\U0001F1ED\U0001F1F2":                           EmojiFlag,
+	"\U0001F1ED\U0001F1F3":                           EmojiFlag,
+	"\U0001F1ED\U0001F1F7":                           EmojiFlag,
+	"\U0001F1ED\U0001F1F9":                           EmojiFlag,
+	"\U0001F1ED\U0001F1FA":                           EmojiFlag,
+	"\U0001F1EE\U0001F1E8":                           EmojiFlag,
+	"\U0001F1EE\U0001F1E9":                           EmojiFlag,
+	"\U0001F1EE\U0001F1EA":                           EmojiFlag,
+	"\U0001F1EE\U0001F1F1":                           EmojiFlag,
+	"\U0001F1EE\U0001F1F2":                           EmojiFlag,
+	"\U0001F1EE\U0001F1F3":                           EmojiFlag,
+	"\U0001F1EE\U0001F1F4":                           EmojiFlag,
+	"\U0001F1EE\U0001F1F6":                           EmojiFlag,
+	"\U0001F1EE\U0001F1F7":                           EmojiFlag,
+	"\U0001F1EE\U0001F1F8":                           EmojiFlag,
+	"\U0001F1EE\U0001F1F9":                           EmojiFlag,
+	"\U0001F1EF\U0001F1EA":                           EmojiFlag,
+	"\U0001F1EF\U0001F1F2":                           EmojiFlag,
+	"\U0001F1EF\U0001F1F4":                           EmojiFlag,
+	"\U0001F1EF\U0001F1F5":                           EmojiFlag,
+	"\U0001F1F0\U0001F1EA":                           EmojiFlag,
+	"\U0001F1F0\U0001F1EC":                           EmojiFlag,
+	"\U0001F1F0\U0001F1ED":                           EmojiFlag,
+	"\U0001F1F0\U0001F1EE":                           EmojiFlag,
+	"\U0001F1F0\U0001F1F2":                           EmojiFlag,
+	"\U0001F1F0\U0001F1F3":                           EmojiFlag,
+	"\U0001F1F0\U0001F1F5":                           EmojiFlag,
+	"\U0001F1F0\U0001F1F7":                           EmojiFlag,
+	"\U0001F1F0\U0001F1FC":                           EmojiFlag,
+	"\U0001F1F0\U0001F1FE":                           EmojiFlag,
+	"\U0001F1F0\U0001F1FF":                           EmojiFlag,
+	"\U0001F1F1\U0001F1E6":                           EmojiFlag,
+	"\U0001F1F1\U0001F1E7":                           EmojiFlag,
+	"\U0001F1F1\U0001F1E8":                           EmojiFlag,
+	"\U0001F1F1\U0001F1EE":                           EmojiFlag,
+	"\U0001F1F1\U0001F1F0":                           EmojiFlag,
+	"\U0001F1F1\U0001F1F7":                           EmojiFlag,
+	"\U0001F1F1\U0001F1F8":                           EmojiFlag,
+	"\U0001F1F1\U0001F1F9":                           EmojiFlag,
+	"\U0001F1F1\U0001F1FA":                           EmojiFlag,
+	"\U0001F1F1\U0001F1FB":                           EmojiFlag,
+	"\U0001F1F1\U0001F1FE":                           EmojiFlag,
+	"\U0001F1F2\U0001F1E6":                           EmojiFlag,
+	"\U0001F1F2\U0001F1E8":                           EmojiFlag,
+	"\U0001F1F2\U0001F1E9":                           EmojiFlag,
+	"\U0001F1F2\U0001F1EA":                           EmojiFlag,
+	"\U0001F1F2\U0001F1EB":                           EmojiFlag,
+	"\U0001F1F2\U0001F1EC":                           EmojiFlag,
+	"\U0001F1F2\U0001F1ED":                           EmojiFlag,
+	"\U0001F1F2\U0001F1F0":                           EmojiFlag,
+	"\U0001F1F2\U0001F1F1":                           EmojiFlag,
+	"\U0001F1F2\U0001F1F2":                           EmojiFlag,
+	"\U0001F1F2\U0001F1F3":                           EmojiFlag,
+	"\U0001F1F2\U0001F1F4":                           EmojiFlag,
+	"\U0001F1F2\U0001F1F5":                           EmojiFlag,
+	"\U0001F1F2\U0001F1F6":                           EmojiFlag,
+	"\U0001F1F2\U0001F1F7":                           EmojiFlag,
+	"\U0001F1F2\U0001F1F8":                           EmojiFlag,
+	"\U0001F1F2\U0001F1F9":                           EmojiFlag,
+	"\U0001F1F2\U0001F1FA":                           EmojiFlag,
+	"\U0001F1F2\U0001F1FB":                           EmojiFlag,
+	"\U0001F1F2\U0001F1FC":                           EmojiFlag,
+	"\U0001F1F2\U0001F1FD":                           EmojiFlag,
+	"\U0001F1F2\U0001F1FE":                           EmojiFlag,
+	"\U0001F1F2\U0001F1FF":                           EmojiFlag,
+	"\U0001F1F3\U0001F1E6":                           EmojiFlag,
+	"\U0001F1F3\U0001F1E8":                           EmojiFlag,
+	"\U0001F1F3\U0001F1EA":                           EmojiFlag,
+	"\U0001F1F3\U0001F1EB":                           EmojiFlag,
+	"\U0001F1F3\U0001F1EC":                           EmojiFlag,
+	"\U0001F1F3\U0001F1EE":                           EmojiFlag,
+	"\U0001F1F3\U0001F1F1":                           EmojiFlag,
+	"\U0001F1F3\U0001F1F4":                           EmojiFlag,
+	"\U0001F1F3\U0001F1F5":                           EmojiFlag,
+	"\U0001F1F3\U0001F1F7":                           EmojiFlag,
+	"\U0001F1F3\U0001F1FA":                           EmojiFlag,
+	"\U0001F1F3\U0001F1FF":                           EmojiFlag,
+	"\U0001F1F4\U0001F1F2":                           EmojiFlag,
+	"\U0001F1F5\U0001F1E6":                           EmojiFlag,
+	"\U0001F1F5\U0001F1EA":                           EmojiFlag,
+	"\U0001F1F5\U0001F1EB":                           EmojiFlag,
+	"\U0001F1F5\U0001F1EC":                           EmojiFlag,
+	"\U0001F1F5\U0001F1ED":                           EmojiFlag,
+	"\U0001F1F5\U0001F1F0":                           EmojiFlag,
+	"\U0001F1F5\U0001F1F1":                           EmojiFlag,
+	"\U0001F1F5\U0001F1F2":                           EmojiFlag,
+	"\U0001F1F5\U0001F1F3":                           EmojiFlag,
+	"\U0001F1F5\U0001F1F7":                           EmojiFlag,
+	"\U0001F1F5\U0001F1F8":                           EmojiFlag,
+	"\U0001F1F5\U0001F1F9":                           EmojiFlag,
+	"\U0001F1F5\U0001F1FC":                           EmojiFlag,
+	"\U0001F1F5\U0001F1FE":                           EmojiFlag,
+	"\U0001F1F6\U0001F1E6":                           EmojiFlag,
+	"\U0001F1F7\U0001F1EA":                           EmojiFlag,
+	"\U0001F1F7\U0001F1F4":                           EmojiFlag,
+	"\U0001F1F7\U0001F1F8":                           EmojiFlag,
+	"\U0001F1F7\U0001F1FA":                           EmojiFlag,
+	"\U0001F1F7\U0001F1FC":                           EmojiFlag,
+	"\U0001F1F8\U0001F1E6":                           EmojiFlag,
+	"\U0001F1F8\U0001F1E7":                           EmojiFlag,
+	"\U0001F1F8\U0001F1E8":                           EmojiFlag,
+	"\U0001F1F8\U0001F1E9":                           EmojiFlag,
+	"\U0001F1F8\U0001F1EA":                           EmojiFlag,
+	"\U0001F1F8\U0001F1EC":                           EmojiFlag,
+	"\U0001F1F8\U0001F1ED":                           EmojiFlag,
+	"\U0001F1F8\U0001F1EE":                           EmojiFlag,
+	"\U0001F1F8\U0001F1EF":                           EmojiFlag,
+	"\U0001F1F8\U0001F1F0":                           EmojiFlag,
+	"\U0001F1F8\U0001F1F1":                           EmojiFlag,
+	"\U0001F1F8\U0001F1F2":                           EmojiFlag,
+	"\U0001F1F8\U0001F1F3":                           EmojiFlag,
+	"\U0001F1F8\U0001F1F4":                           EmojiFlag,
+	"\U0001F1F8\U0001F1F7":                           EmojiFlag,
+	"\U0001F1F8\U0001F1F8":                           EmojiFlag,
+	"\U0001F1F8\U0001F1F9":                           EmojiFlag,
+	"\U0001F1F8\U0001F1FB":                           EmojiFlag,
+	"\U0001F1F8\U0001F1FD":                           EmojiFlag,
+	"\U0001F1F8\U0001F1FE":                           EmojiFlag,
+	"\U0001F1F8\U0001F1FF":                           EmojiFlag,
+	"\U0001F1F9\U0001F1E6":                           EmojiFlag,
+	"\U0001F1F9\U0001F1E8":                           EmojiFlag,
+	"\U0001F1F9\U0001F1E9":                           EmojiFlag,
+	"\U0001F1F9\U0001F1EB":                           EmojiFlag,
+	"\U0001F1F9\U0001F1EC":                           EmojiFlag,
+	"\U0001F1F9\U0001F1ED":                           EmojiFlag,
+	"\U0001F1F9\U0001F1EF":                           EmojiFlag,
+	"\U0001F1F9\U0001F1F0":                           EmojiFlag,
+	"\U0001F1F9\U0001F1F1":                           EmojiFlag,
+	"\U0001F1F9\U0001F1F2":                           EmojiFlag,
+	"\U0001F1F9\U0001F1F3":                           EmojiFlag,
+	"\U0001F1F9\U0001F1F4":                           EmojiFlag,
+	"\U0001F1F9\U0001F1F7":                           EmojiFlag,
+	"\U0001F1F9\U0001F1F9":                           EmojiFlag,
+	"\U0001F1F9\U0001F1FB":                           EmojiFlag,
+	"\U0001F1F9\U0001F1FC":                           EmojiFlag,
+	"\U0001F1F9\U0001F1FF":                           EmojiFlag,
+	"\U0001F1FA\U0001F1E6":                           EmojiFlag,
+	"\U0001F1FA\U0001F1EC":                           EmojiFlag,
+	"\U0001F1FA\U0001F1F2":                           EmojiFlag,
+	"\U0001F1FA\U0001F1F3":                           EmojiFlag,
+	"\U0001F1FA\U0001F1F8":                           EmojiFlag,
+	"\U0001F1FA\U0001F1FE":                           EmojiFlag,
+	"\U0001F1FA\U0001F1FF":                           EmojiFlag,
+	"\U0001F1FB\U0001F1E6":                           EmojiFlag,
+	"\U0001F1FB\U0001F1E8":                           EmojiFlag,
+	"\U0001F1FB\U0001F1EA":                           EmojiFlag,
+	"\U0001F1FB\U0001F1EC":                           EmojiFlag,
+	"\U0001F1FB\U0001F1EE":                           EmojiFlag,
+	"\U0001F1FB\U0001F1F3":                           EmojiFlag,
+	"\U0001F1FB\U0001F1FA":                           EmojiFlag,
+	"\U0001F1FC\U0001F1EB":                           EmojiFlag,
+	"\U0001F1FC\U0001F1F8":                           EmojiFlag,
+	"\U0001F1FD\U0001F1F0":                           EmojiFlag,
+	"\U0001F1FE\U0001F1EA":                           EmojiFlag,
+	"\U0001F1FE\U0001F1F9":                           EmojiFlag,
+	"\U0001F1FF\U0001F1E6":                           EmojiFlag,
+	"\U0001F1FF\U0001F1F2":                           EmojiFlag,
+	"\U0001F1FF\U0001F1FC":                           EmojiFlag,
+	"\U0001F202\uFE0F":                               EmojiBasic,
+	"\U0001F237\uFE0F":                               EmojiBasic,
+	"\U0001F321\uFE0F":                               EmojiBasic,
+	"\U0001F324\uFE0F":                               EmojiBasic,
+	"\U0001F325\uFE0F":                               EmojiBasic,
+	"\U0001F326\uFE0F":                               EmojiBasic,
+	"\U0001F327\uFE0F":                               EmojiBasic,
+	"\U0001F328\uFE0F":                               EmojiBasic,
+	"\U0001F329\uFE0F":                               EmojiBasic,
+	"\U0001F32A\uFE0F":                               EmojiBasic,
+	"\U0001F32B\uFE0F":                               EmojiBasic,
+	"\U0001F32C\uFE0F":                               EmojiBasic,
+	"\U0001F336\uFE0F":                               EmojiBasic,
+	"\U0001F344\u200D\U0001F7EB":                     EmojiZWJ,
+	"\U0001F34B\u200D\U0001F7E9":                     EmojiZWJ,
+	"\U0001F37D\uFE0F":                               EmojiBasic,
+	"\U0001F385\U0001F3FB":                           EmojiModifier,
+	"\U0001F385\U0001F3FC":                           EmojiModifier,
+	"\U0001F385\U0001F3FD":                           EmojiModifier,
+	"\U0001F385\U0001F3FE":                           EmojiModifier,
+	"\U0001F385\U0001F3FF":                           EmojiModifier,
+	"\U0001F396\uFE0F":                               EmojiBasic,
+	"\U0001F397\uFE0F":                               EmojiBasic,
+	"\U0001F399\uFE0F":                               EmojiBasic,
+	"\U0001F39A\uFE0F":                               EmojiBasic,
+	"\U0001F39B\uFE0F":                               EmojiBasic,
+	"\U0001F39E\uFE0F":                               EmojiBasic,
+	"\U0001F39F\uFE0F":                               EmojiBasic,
+	"\U0001F3C2\U0001F3FB":                           EmojiModifier,
+	"\U0001F3C2\U0001F3FC":                           EmojiModifier,
+	"\U0001F3C2\U0001F3FD":                           EmojiModifier,
+	"\U0001F3C2\U0001F3FE":                           EmojiModifier,
+	"\U0001F3C2\U0001F3FF":                           EmojiModifier,
+	"\U0001F3C3\u200D\u2640\uFE0F":                   EmojiZWJ,
+	"\U0001F3C3\u200D\u2640\uFE0F\u200D\u27A1\uFE0F": EmojiZWJ,
+	"\U0001F3C3\u200D\u2642\uFE0F":                   EmojiZWJ,
+	"\U0001F3C3\u200D\u2642\uFE0F\u200D\u27A1\uFE0F": EmojiZWJ,
+	"\U0001F3C3\u200D\u27A1\uFE0F":                   EmojiZWJ,
+	"\U0001F3C3\U0001F3FB":                           EmojiModifier,
+	"\U0001F3C3\U0001F3FB\u200D\u2640\uFE0F":         EmojiZWJ,
+	"\U0001F3C3\U0001F3FB\u200D\u2640\uFE0F\u200D\u27A1\uFE0F":               EmojiZWJ,
+	"\U0001F3C3\U0001F3FB\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3C3\U0001F3FB\u200D\u2642\uFE0F\u200D\u27A1\uFE0F":               EmojiZWJ,
+	"\U0001F3C3\U0001F3FB\u200D\u27A1\uFE0F":                                 EmojiZWJ,
+	"\U0001F3C3\U0001F3FC":                                                   EmojiModifier,
+	"\U0001F3C3\U0001F3FC\u200D\u2640\uFE0F":                                 EmojiZWJ,
+	"\U0001F3C3\U0001F3FC\u200D\u2640\uFE0F\u200D\u27A1\uFE0F":               EmojiZWJ,
+	"\U0001F3C3\U0001F3FC\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3C3\U0001F3FC\u200D\u2642\uFE0F\u200D\u27A1\uFE0F":               EmojiZWJ,
+	"\U0001F3C3\U0001F3FC\u200D\u27A1\uFE0F":                                 EmojiZWJ,
+	"\U0001F3C3\U0001F3FD":                                                   EmojiModifier,
+	"\U0001F3C3\U0001F3FD\u200D\u2640\uFE0F":                                 EmojiZWJ,
+	"\U0001F3C3\U0001F3FD\u200D\u2640\uFE0F\u200D\u27A1\uFE0F":               EmojiZWJ,
+	"\U0001F3C3\U0001F3FD\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3C3\U0001F3FD\u200D\u2642\uFE0F\u200D\u27A1\uFE0F":               EmojiZWJ,
+	"\U0001F3C3\U0001F3FD\u200D\u27A1\uFE0F":                                 EmojiZWJ,
+	"\U0001F3C3\U0001F3FE":                                                   EmojiModifier,
+	"\U0001F3C3\U0001F3FE\u200D\u2640\uFE0F":                                 EmojiZWJ,
+	"\U0001F3C3\U0001F3FE\u200D\u2640\uFE0F\u200D\u27A1\uFE0F":               EmojiZWJ,
+	"\U0001F3C3\U0001F3FE\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3C3\U0001F3FE\u200D\u2642\uFE0F\u200D\u27A1\uFE0F":               EmojiZWJ,
+	"\U0001F3C3\U0001F3FE\u200D\u27A1\uFE0F":                                 EmojiZWJ,
+	"\U0001F3C3\U0001F3FF":                                                   EmojiModifier,
+	"\U0001F3C3\U0001F3FF\u200D\u2640\uFE0F":                                 EmojiZWJ,
+	"\U0001F3C3\U0001F3FF\u200D\u2640\uFE0F\u200D\u27A1\uFE0F":               EmojiZWJ,
+	"\U0001F3C3\U0001F3FF\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3C3\U0001F3FF\u200D\u2642\uFE0F\u200D\u27A1\uFE0F":               EmojiZWJ,
+	"\U0001F3C3\U0001F3FF\u200D\u27A1\uFE0F":                                 EmojiZWJ,
+	"\U0001F3C4\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F3C4\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F3C4\U0001F3FB":                                                   EmojiModifier,
+	"\U0001F3C4\U0001F3FB\u200D\u2640\uFE0F":                                 EmojiZWJ,
+	"\U0001F3C4\U0001F3FB\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3C4\U0001F3FC":                                                   EmojiModifier,
+	"\U0001F3C4\U0001F3FC\u200D\u2640\uFE0F":                                 EmojiZWJ,
+	"\U0001F3C4\U0001F3FC\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3C4\U0001F3FD":                                                   EmojiModifier,
+	"\U0001F3C4\U0001F3FD\u200D\u2640\uFE0F":                                 EmojiZWJ,
+	"\U0001F3C4\U0001F3FD\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3C4\U0001F3FE":                                                   EmojiModifier,
+	"\U0001F3C4\U0001F3FE\u200D\u2640\uFE0F":                                 EmojiZWJ,
+	"\U0001F3C4\U0001F3FE\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3C4\U0001F3FF":                                                   EmojiModifier,
+	"\U0001F3C4\U0001F3FF\u200D\u2640\uFE0F":                                 EmojiZWJ,
+	"\U0001F3C4\U0001F3FF\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3C7\U0001F3FB":                                                   EmojiModifier,
+	"\U0001F3C7\U0001F3FC":                                                   EmojiModifier,
+	"\U0001F3C7\U0001F3FD":                                                   EmojiModifier,
+	"\U0001F3C7\U0001F3FE":                                                   EmojiModifier,
+	"\U0001F3C7\U0001F3FF":                                                   EmojiModifier,
+	"\U0001F3CA\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F3CA\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F3CA\U0001F3FB":                                                   EmojiModifier,
+	"\U0001F3CA\U0001F3FB\u200D\u2640\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CA\U0001F3FB\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CA\U0001F3FC":                                                   EmojiModifier,
+	"\U0001F3CA\U0001F3FC\u200D\u2640\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CA\U0001F3FC\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CA\U0001F3FD":                                                   EmojiModifier,
+	"\U0001F3CA\U0001F3FD\u200D\u2640\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CA\U0001F3FD\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CA\U0001F3FE":                                                   EmojiModifier,
+	"\U0001F3CA\U0001F3FE\u200D\u2640\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CA\U0001F3FE\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CA\U0001F3FF":                                                   EmojiModifier,
+	"\U0001F3CA\U0001F3FF\u200D\u2640\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CA\U0001F3FF\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CB\uFE0F":                                                       EmojiBasic,
+	"\U0001F3CB\uFE0F\u200D\u2640\uFE0F":                                     EmojiZWJ,
+	"\U0001F3CB\uFE0F\u200D\u2642\uFE0F":                                     EmojiZWJ,
+	"\U0001F3CB\U0001F3FB":                                                   EmojiModifier,
+	"\U0001F3CB\U0001F3FB\u200D\u2640\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CB\U0001F3FB\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CB\U0001F3FC":                                                   EmojiModifier,
+	"\U0001F3CB\U0001F3FC\u200D\u2640\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CB\U0001F3FC\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CB\U0001F3FD":                                                   EmojiModifier,
+	"\U0001F3CB\U0001F3FD\u200D\u2640\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CB\U0001F3FD\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CB\U0001F3FE":                                                   EmojiModifier,
+	"\U0001F3CB\U0001F3FE\u200D\u2640\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CB\U0001F3FE\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CB\U0001F3FF":                                                   EmojiModifier,
+	"\U0001F3CB\U0001F3FF\u200D\u2640\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CB\U0001F3FF\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CC\uFE0F":                                                       EmojiBasic,
+	"\U0001F3CC\uFE0F\u200D\u2640\uFE0F":                                     EmojiZWJ,
+	"\U0001F3CC\uFE0F\u200D\u2642\uFE0F":                                     EmojiZWJ,
+	"\U0001F3CC\U0001F3FB":                                                   EmojiModifier,
+	"\U0001F3CC\U0001F3FB\u200D\u2640\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CC\U0001F3FB\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CC\U0001F3FC":                                                   EmojiModifier,
+	"\U0001F3CC\U0001F3FC\u200D\u2640\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CC\U0001F3FC\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CC\U0001F3FD":                                                   EmojiModifier,
+	"\U0001F3CC\U0001F3FD\u200D\u2640\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CC\U0001F3FD\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CC\U0001F3FE":                                                   EmojiModifier,
+	"\U0001F3CC\U0001F3FE\u200D\u2640\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CC\U0001F3FE\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CC\U0001F3FF":                                                   EmojiModifier,
+	"\U0001F3CC\U0001F3FF\u200D\u2640\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CC\U0001F3FF\u200D\u2642\uFE0F":                                 EmojiZWJ,
+	"\U0001F3CD\uFE0F":                                                       EmojiBasic,
+	"\U0001F3CE\uFE0F":                                                       EmojiBasic,
+	"\U0001F3D4\uFE0F":                                                       EmojiBasic,
+	"\U0001F3D5\uFE0F":                                                       EmojiBasic,
+	"\U0001F3D6\uFE0F":                                                       EmojiBasic,
+	"\U0001F3D7\uFE0F":                                                       EmojiBasic,
+	"\U0001F3D8\uFE0F":                                                       EmojiBasic,
+	"\U0001F3D9\uFE0F":                                                       EmojiBasic,
+	"\U0001F3DA\uFE0F":                                                       EmojiBasic,
+	"\U0001F3DB\uFE0F":                                                       EmojiBasic,
+	"\U0001F3DC\uFE0F":                                                       EmojiBasic,
+	"\U0001F3DD\uFE0F":                                                       EmojiBasic,
+	"\U0001F3DE\uFE0F":                                                       EmojiBasic,
+	"\U0001F3DF\uFE0F":                                                       EmojiBasic,
+	"\U0001F3F3\uFE0F":                                                       EmojiBasic,
+	"\U0001F3F3\uFE0F\u200D\u26A7\uFE0F":                                     EmojiZWJ,
+	"\U0001F3F3\uFE0F\u200D\U0001F308":                                       EmojiZWJ,
+	"\U0001F3F4\u200D\u2620\uFE0F":                                           EmojiZWJ,
+	"\U0001F3F4\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F": EmojiTag,
+	"\U0001F3F4\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F": EmojiTag,
+	"\U0001F3F4\U000E0067\U000E0062\U000E0077\U000E006C\U000E0073\U000E007F": EmojiTag,
+	"\U0001F3F5\uFE0F":                                                       EmojiBasic,
+	"\U0001F3F7\uFE0F":                                                       EmojiBasic,
+	"\U0001F408\u200D\u2B1B":                                                 EmojiZWJ,
+	"\U0001F415\u200D\U0001F9BA":                                             EmojiZWJ,
+	"\U0001F426\u200D\u2B1B":                                                 EmojiZWJ,
+	"\U0001F426\u200D\U0001F525":                                             EmojiZWJ,
+	"\U0001F43B\u200D\u2744\uFE0F":                                           EmojiZWJ,
+	"\U0001F43F\uFE0F":                                                       EmojiBasic,
+	"\U0001F441\uFE0F":                                                       EmojiBasic,
+	"\U0001F441\uFE0F\u200D\U0001F5E8\uFE0F":                                 EmojiZWJ,
+	"\U0001F442\U0001F3FB":                                                   EmojiModifier,
+	"\U0001F442\U0001F3FC":                                                   EmojiModifier,
+	"\U0001F442\U0001F3FD":                                                   EmojiModifier,
+	"\U0001F442\U0001F3FE":                                                   EmojiModifier,
+	"\U0001F442\U0001F3FF":                                                   EmojiModifier,
+	"\U0001F443\U0001F3FB":                                                   EmojiModifier,
+	"\U0001F443\U0001F3FC":                                                   EmojiModifier,
+	"\U0001F443\U0001F3FD":                                                   EmojiModifier,
+	"\U0001F443\U0001F3FE":                                                   EmojiModifier,
+	"\U0001F443\U0001F3FF":                                                   EmojiModifier,
+	"\U0001F446\U0001F3FB":                                                   EmojiModifier,
+	"\U0001F446\U0001F3FC":                                                   EmojiModifier,
+	"\U0001F446\U0001F3FD":                                                   EmojiModifier,
+	"\U0001F446\U0001F3FE":                                                   EmojiModifier,
+	"\U0001F446\U0001F3FF":                                                   EmojiModifier,
+	"\U0001F447\U0001F3FB":                                                   EmojiModifier,
+	"\U0001F447\U0001F3FC":                                                   EmojiModifier,
+	"\U0001F447\U0001F3FD":                                                   EmojiModifier,
+	"\U0001F447\U0001F3FE":                                                   EmojiModifier,
+	"\U0001F447\U0001F3FF":                                                   EmojiModifier,
+	"\U0001F448\U0001F3FB":                                                   EmojiModifier,
+	"\U0001F448\U0001F3FC":                                                   EmojiModifier,
+	"\U0001F448\U0001F3FD":                                                   EmojiModifier,
+	"\U0001F448\U0001F3FE":                                                   EmojiModifier,
+	"\U0001F448\U0001F3FF":                                                   EmojiModifier,
+	"\U0001F449\U0001F3FB":                                                   EmojiModifier,
+	"\U0001F449\U0001F3FC":                                                   EmojiModifier,
+	"\U0001F449\U0001F3FD":                                                   EmojiModifier,
+	"\U0001F449\U0001F3FE":                                                   EmojiModifier,
+	"\U0001F449\U0001F3FF":                                                   EmojiModifier,
+	"\U0001F44A\U0001F3FB":                                                   EmojiModifier,
+	"\U0001F44A\U0001F3FC":                                                   EmojiModifier,
+	"\U0001F44A\U0001F3FD":                                                   EmojiModifier,
+	"\U0001F44A\U0001F3FE":                                                   EmojiModifier,
+	"\U0001F44A\U0001F3FF":                                                   EmojiModifier,
+	"\U0001F44B\U0001F3FB":                                                   EmojiModifier,
+	"\U0001F44B\U0001F3FC":                                                   EmojiModifier,
+	"\U0001F44B\U0001F3FD":                                                   EmojiModifier,
+	"\U0001F44B\U0001F3FE":                                                   EmojiModifier,
+	"\U0001F44B\U0001F3FF":                                                   EmojiModifier,
+	"\U0001F44C\U0001F3FB":                                                   EmojiModifier,
+	"\U0001F44C\U0001F3FC":                                                   EmojiModifier,
+	"\U0001F44C\U0001F3FD":                                                   EmojiModifier,
+	"\U0001F44C\U0001F3FE":                                                   EmojiModifier,
+	"\U0001F44C\U0001F3FF":                                                   EmojiModifier,
+	"\U0001F44D\U0001F3FB":                                                   EmojiModifier,
+	"\U0001F44D\U0001F3FC":                                                   EmojiModifier,
+	"\U0001F44D\U0001F3FD":                                                   EmojiModifier,
+	"\U0001F44D\U0001F3FE":                                                   EmojiModifier,
+	"\U0001F44D\U0001F3FF":                                                   EmojiModifier,
+	"\U0001F44E\U0001F3FB":                                                   EmojiModifier,
+	"\U0001F44E\U0001F3FC":                                                   EmojiModifier,
+	"\U0001F44E\U0001F3FD":                                                   EmojiModifier,
+	"\U0001F44E\U0001F3FE":                                                   EmojiModifier,
+	"\U0001F44E\U0001F3FF":                                                   EmojiModifier,
+	"\U0001F44F\U0001F3FB":                                                   EmojiModifier,
+	"\U0001F44F\U0001F3FC":                                                   EmojiModifier,
+	"\U0001F44F\U0001F3FD":                                                   EmojiModifier,
+	"\U0001F44F\U0001F3FE":                                                   EmojiModifier,
+	"\U0001F44F\U0001F3FF":                                                   EmojiModifier,
+	"\U0001F450\U0001F3FB":                                                   EmojiModifier,
+	"\U0001F450\U0001F3FC":                                                   EmojiModifier,
+	"\U0001F450\U0001F3FD":                                                   EmojiModifier,
+	"\U0001F450\U0001F3FE":                                                   EmojiModifier,
+	"\U0001F450\U0001F3FF":                                                   EmojiModifier,
+	"\U0001F466\U0001F3FB":                                                   EmojiModifier,
+	"\U0001F466\U0001F3FC":                                                   EmojiModifier,
+	"\U0001F466\U0001F3FD":                                                   EmojiModifier,
+	"\U0001F466\U0001F3FE":                                                   EmojiModifier,
+	"\U0001F466\U0001F3FF":                                                   EmojiModifier,
+	"\U0001F467\U0001F3FB":                                                   EmojiModifier,
+	"\U0001F467\U0001F3FC":                                                   EmojiModifier,
+	"\U0001F467\U0001F3FD":                                                   EmojiModifier,
+	"\U0001F467\U0001F3FE":                                                   EmojiModifier,
+	"\U0001F467\U0001F3FF":                                                   EmojiModifier,
+	"\U0001F468\u200D\u2695\uFE0F":                                           EmojiZWJ,
+	"\U0001F468\u200D\u2696\uFE0F":                                           EmojiZWJ,
+	"\U0001F468\u200D\u2708\uFE0F":                                           EmojiZWJ,
+	"\U0001F468\u200D\u2764\uFE0F\u200D\U0001F468":                           EmojiZWJ,
+	"\U0001F468\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468":           EmojiZWJ,
+	"\U0001F468\u200D\U0001F33E":                                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F373":                                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F37C":                                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F393":                                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F3A4":                                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F3A8":                                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F3EB":                                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F3ED":                                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F466":                                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F466\u200D\U0001F466":                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F467":                                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F467\u200D\U0001F466":                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F467\u200D\U0001F467":                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F468\u200D\U0001F466":                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F468\u200D\U0001F466\u200D\U0001F466":             EmojiZWJ,
+	"\U0001F468\u200D\U0001F468\u200D\U0001F467":                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F468\u200D\U0001F467\u200D\U0001F466":             EmojiZWJ,
+	"\U0001F468\u200D\U0001F468\u200D\U0001F467\u200D\U0001F467":             EmojiZWJ,
+	"\U0001F468\u200D\U0001F469\u200D\U0001F466":                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F469\u200D\U0001F466\u200D\U0001F466":             EmojiZWJ,
+	"\U0001F468\u200D\U0001F469\u200D\U0001F467":                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F469\u200D\U0001F467\u200D\U0001F466":             EmojiZWJ,
+	"\U0001F468\u200D\U0001F469\u200D\U0001F467\u200D\U0001F467":             EmojiZWJ,
+	"\U0001F468\u200D\U0001F4BB":                                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F4BC":                                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F527":                                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F52C":                                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F680":                                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F692":                                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F9AF":                                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F9AF\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F468\u200D\U0001F9B0":                                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F9B1":                                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F9B2":                                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F9B3":                                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F9BC":                                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F9BC\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F468\u200D\U0001F9BD":                                             EmojiZWJ,
+	"\U0001F468\u200D\U0001F9BD\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F468\U0001F3FB":                                                   EmojiModifier,
+	"\U0001F468\U0001F3FB\u200D\u2695\uFE0F":                                 EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\u2696\uFE0F":                                 EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\u2708\uFE0F":                                 EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FB":       EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FC":       EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FD":       EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FE":       EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FF":       EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FB": EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FC": EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FD": EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FE": EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FF": EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F33E":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F373":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F37C":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F393":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F3A4":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F3A8":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F3EB":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F3ED":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F430\u200D\U0001F468\U0001F3FC":                   EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F430\u200D\U0001F468\U0001F3FD":                   EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F430\u200D\U0001F468\U0001F3FE":                   EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F430\u200D\U0001F468\U0001F3FF":                   EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F4BB":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F4BC":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F527":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F52C":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F680":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F692":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F91D\u200D\U0001F468\U0001F3FC":                   EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F91D\u200D\U0001F468\U0001F3FD":                   EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F91D\u200D\U0001F468\U0001F3FE":                   EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F91D\u200D\U0001F468\U0001F3FF":                   EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F9AF":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F9AF\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F9B0":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F9B1":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F9B2":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F9B3":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F9BC":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F9BC\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F9BD":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001F9BD\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001FAEF\u200D\U0001F468\U0001F3FC":                   EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001FAEF\u200D\U0001F468\U0001F3FD":                   EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001FAEF\u200D\U0001F468\U0001F3FE":                   EmojiZWJ,
+	"\U0001F468\U0001F3FB\u200D\U0001FAEF\u200D\U0001F468\U0001F3FF":                   EmojiZWJ,
+	"\U0001F468\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F468\U0001F3FC\u200D\u2695\uFE0F":                                           EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\u2696\uFE0F":                                           EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\u2708\uFE0F":                                           EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FB":                 EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FC":                 EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FD":                 EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FE":                 EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FF":                 EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FB": EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FC": EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FD": EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FE": EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FF": EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F33E":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F373":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F37C":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F393":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F3A4":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F3A8":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F3EB":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F3ED":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F430\u200D\U0001F468\U0001F3FB":                   EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F430\u200D\U0001F468\U0001F3FD":                   EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F430\u200D\U0001F468\U0001F3FE":                   EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F430\u200D\U0001F468\U0001F3FF":                   EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F4BB":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F4BC":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F527":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F52C":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F680":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F692":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F91D\u200D\U0001F468\U0001F3FB":                   EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F91D\u200D\U0001F468\U0001F3FD":                   EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F91D\u200D\U0001F468\U0001F3FE":                   EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F91D\u200D\U0001F468\U0001F3FF":                   EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F9AF":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F9AF\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F9B0":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F9B1":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F9B2":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F9B3":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F9BC":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F9BC\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F9BD":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001F9BD\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001FAEF\u200D\U0001F468\U0001F3FB":                   EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001FAEF\u200D\U0001F468\U0001F3FD":                   EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001FAEF\u200D\U0001F468\U0001F3FE":                   EmojiZWJ,
+	"\U0001F468\U0001F3FC\u200D\U0001FAEF\u200D\U0001F468\U0001F3FF":                   EmojiZWJ,
+	"\U0001F468\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F468\U0001F3FD\u200D\u2695\uFE0F":                                           EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\u2696\uFE0F":                                           EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\u2708\uFE0F":                                           EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FB":                 EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FC":                 EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FD":                 EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FE":                 EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FF":                 EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FB": EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FC": EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FD": EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FE": EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FF": EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F33E":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F373":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F37C":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F393":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F3A4":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F3A8":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F3EB":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F3ED":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F430\u200D\U0001F468\U0001F3FB":                   EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F430\u200D\U0001F468\U0001F3FC":                   EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F430\u200D\U0001F468\U0001F3FE":                   EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F430\u200D\U0001F468\U0001F3FF":                   EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F4BB":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F4BC":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F527":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F52C":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F680":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F692":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F91D\u200D\U0001F468\U0001F3FB":                   EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F91D\u200D\U0001F468\U0001F3FC":                   EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F91D\u200D\U0001F468\U0001F3FE":                   EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F91D\u200D\U0001F468\U0001F3FF":                   EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F9AF":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F9AF\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F9B0":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F9B1":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F9B2":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F9B3":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F9BC":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F9BC\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F9BD":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001F9BD\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001FAEF\u200D\U0001F468\U0001F3FB":                   EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001FAEF\u200D\U0001F468\U0001F3FC":                   EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001FAEF\u200D\U0001F468\U0001F3FE":                   EmojiZWJ,
+	"\U0001F468\U0001F3FD\u200D\U0001FAEF\u200D\U0001F468\U0001F3FF":                   EmojiZWJ,
+	"\U0001F468\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F468\U0001F3FE\u200D\u2695\uFE0F":                                           EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\u2696\uFE0F":                                           EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\u2708\uFE0F":                                           EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FB":                 EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FC":                 EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FD":                 EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FE":                 EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FF":                 EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FB": EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FC": EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FD": EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FE": EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FF": EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F33E":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F373":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F37C":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F393":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F3A4":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F3A8":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F3EB":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F3ED":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F430\u200D\U0001F468\U0001F3FB":                   EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F430\u200D\U0001F468\U0001F3FC":                   EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F430\u200D\U0001F468\U0001F3FD":                   EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F430\u200D\U0001F468\U0001F3FF":                   EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F4BB":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F4BC":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F527":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F52C":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F680":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F692":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F91D\u200D\U0001F468\U0001F3FB":                   EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F91D\u200D\U0001F468\U0001F3FC":                   EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F91D\u200D\U0001F468\U0001F3FD":                   EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F91D\u200D\U0001F468\U0001F3FF":                   EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F9AF":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F9AF\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F9B0":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F9B1":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F9B2":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F9B3":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F9BC":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F9BC\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F9BD":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001F9BD\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001FAEF\u200D\U0001F468\U0001F3FB":                   EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001FAEF\u200D\U0001F468\U0001F3FC":                   EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001FAEF\u200D\U0001F468\U0001F3FD":                   EmojiZWJ,
+	"\U0001F468\U0001F3FE\u200D\U0001FAEF\u200D\U0001F468\U0001F3FF":                   EmojiZWJ,
+	"\U0001F468\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F468\U0001F3FF\u200D\u2695\uFE0F":                                           EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\u2696\uFE0F":                                           EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\u2708\uFE0F":                                           EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FB":                 EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FC":                 EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FD":                 EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FE":                 EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FF":                 EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FB": EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FC": EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FD": EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FE": EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FF": EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F33E":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F373":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F37C":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F393":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F3A4":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F3A8":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F3EB":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F3ED":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F430\u200D\U0001F468\U0001F3FB":                   EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F430\u200D\U0001F468\U0001F3FC":                   EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F430\u200D\U0001F468\U0001F3FD":                   EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F430\u200D\U0001F468\U0001F3FE":                   EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F4BB":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F4BC":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F527":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F52C":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F680":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F692":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F91D\u200D\U0001F468\U0001F3FB":                   EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F91D\u200D\U0001F468\U0001F3FC":                   EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F91D\u200D\U0001F468\U0001F3FD":                   EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F91D\u200D\U0001F468\U0001F3FE":                   EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F9AF":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F9AF\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F9B0":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F9B1":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F9B2":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F9B3":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F9BC":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F9BC\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F9BD":                                             EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001F9BD\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001FAEF\u200D\U0001F468\U0001F3FB":                   EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001FAEF\u200D\U0001F468\U0001F3FC":                   EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001FAEF\u200D\U0001F468\U0001F3FD":                   EmojiZWJ,
+	"\U0001F468\U0001F3FF\u200D\U0001FAEF\u200D\U0001F468\U0001F3FE":                   EmojiZWJ,
+	"\U0001F469\u200D\u2695\uFE0F":                                                     EmojiZWJ,
+	"\U0001F469\u200D\u2696\uFE0F":                                                     EmojiZWJ,
+	"\U0001F469\u200D\u2708\uFE0F":                                                     EmojiZWJ,
+	"\U0001F469\u200D\u2764\uFE0F\u200D\U0001F468":                                     EmojiZWJ,
+	"\U0001F469\u200D\u2764\uFE0F\u200D\U0001F469":                                     EmojiZWJ,
+	"\U0001F469\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468":                     EmojiZWJ,
+	"\U0001F469\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469":                     EmojiZWJ,
+	"\U0001F469\u200D\U0001F33E":                                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F373":                                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F37C":                                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F393":                                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F3A4":                                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F3A8":                                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F3EB":                                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F3ED":                                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F466":                                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F466\u200D\U0001F466":                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F467":                                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F467\u200D\U0001F466":                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F467\u200D\U0001F467":                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F469\u200D\U0001F466":                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F469\u200D\U0001F466\u200D\U0001F466":                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F469\u200D\U0001F467":                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F469\u200D\U0001F467\u200D\U0001F466":                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F469\u200D\U0001F467\u200D\U0001F467":                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F4BB":                                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F4BC":                                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F527":                                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F52C":                                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F680":                                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F692":                                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F9AF":                                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F9AF\u200D\u27A1\uFE0F":                                     EmojiZWJ,
+	"\U0001F469\u200D\U0001F9B0":                                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F9B1":                                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F9B2":                                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F9B3":                                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F9BC":                                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F9BC\u200D\u27A1\uFE0F":                                     EmojiZWJ,
+	"\U0001F469\u200D\U0001F9BD":                                                       EmojiZWJ,
+	"\U0001F469\u200D\U0001F9BD\u200D\u27A1\uFE0F":                                     EmojiZWJ,
+	"\U0001F469\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F469\U0001F3FB\u200D\u2695\uFE0F":                                           EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\u2696\uFE0F":                                           EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\u2708\uFE0F":                                           EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FB":                 EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FC":                 EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FD":                 EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FE":                 EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FF":                 EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FB":                 EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FC":                 EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FD":                 EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FE":                 EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FF":                 EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FB": EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FC": EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FD": EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FE": EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FF": EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FB": EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FC": EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FD": EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FE": EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FF": EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F33E":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F373":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F37C":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F393":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F3A4":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F3A8":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F3EB":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F3ED":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F430\u200D\U0001F469\U0001F3FC":                   EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F430\u200D\U0001F469\U0001F3FD":                   EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F430\u200D\U0001F469\U0001F3FE":                   EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F430\u200D\U0001F469\U0001F3FF":                   EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F4BB":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F4BC":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F527":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F52C":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F680":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F692":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F91D\u200D\U0001F468\U0001F3FC":                   EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F91D\u200D\U0001F468\U0001F3FD":                   EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F91D\u200D\U0001F468\U0001F3FE":                   EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F91D\u200D\U0001F468\U0001F3FF":                   EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F91D\u200D\U0001F469\U0001F3FC":                   EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F91D\u200D\U0001F469\U0001F3FD":                   EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F91D\u200D\U0001F469\U0001F3FE":                   EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F91D\u200D\U0001F469\U0001F3FF":                   EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F9AF":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F9AF\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F9B0":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F9B1":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F9B2":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F9B3":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F9BC":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F9BC\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F9BD":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001F9BD\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001FAEF\u200D\U0001F469\U0001F3FC":                   EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001FAEF\u200D\U0001F469\U0001F3FD":                   EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001FAEF\u200D\U0001F469\U0001F3FE":                   EmojiZWJ,
+	"\U0001F469\U0001F3FB\u200D\U0001FAEF\u200D\U0001F469\U0001F3FF":                   EmojiZWJ,
+	"\U0001F469\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F469\U0001F3FC\u200D\u2695\uFE0F":                                           EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\u2696\uFE0F":                                           EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\u2708\uFE0F":                                           EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FB":                 EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FC":                 EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FD":                 EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FE":                 EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FF":                 EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FB":                 EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FC":                 EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FD":                 EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FE":                 EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FF":                 EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FB": EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FC": EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FD": EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FE": EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FF": EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FB": EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FC": EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FD": EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FE": EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FF": EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F33E":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F373":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F37C":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F393":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F3A4":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F3A8":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F3EB":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F3ED":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F430\u200D\U0001F469\U0001F3FB":                   EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F430\u200D\U0001F469\U0001F3FD":                   EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F430\u200D\U0001F469\U0001F3FE":                   EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F430\u200D\U0001F469\U0001F3FF":                   EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F4BB":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F4BC":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F527":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F52C":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F680":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F692":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F91D\u200D\U0001F468\U0001F3FB":                   EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F91D\u200D\U0001F468\U0001F3FD":                   EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F91D\u200D\U0001F468\U0001F3FE":                   EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F91D\u200D\U0001F468\U0001F3FF":                   EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F91D\u200D\U0001F469\U0001F3FB":                   EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F91D\u200D\U0001F469\U0001F3FD":                   EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F91D\u200D\U0001F469\U0001F3FE":                   EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F91D\u200D\U0001F469\U0001F3FF":                   EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F9AF":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F9AF\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F9B0":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F9B1":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F9B2":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F9B3":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F9BC":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F9BC\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F9BD":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001F9BD\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001FAEF\u200D\U0001F469\U0001F3FB":                   EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001FAEF\u200D\U0001F469\U0001F3FD":                   EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001FAEF\u200D\U0001F469\U0001F3FE":                   EmojiZWJ,
+	"\U0001F469\U0001F3FC\u200D\U0001FAEF\u200D\U0001F469\U0001F3FF":                   EmojiZWJ,
+	"\U0001F469\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F469\U0001F3FD\u200D\u2695\uFE0F":                                           EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\u2696\uFE0F":                                           EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\u2708\uFE0F":                                           EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FB":                 EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FC":                 EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FD":                 EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FE":                 EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FF":                 EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FB":                 EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FC":                 EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FD":                 EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FE":                 EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FF":                 EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FB": EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FC": EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FD": EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FE": EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FF": EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FB": EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FC": EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FD": EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FE": EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FF": EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F33E":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F373":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F37C":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F393":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F3A4":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F3A8":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F3EB":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F3ED":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F430\u200D\U0001F469\U0001F3FB":                   EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F430\u200D\U0001F469\U0001F3FC":                   EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F430\u200D\U0001F469\U0001F3FE":                   EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F430\u200D\U0001F469\U0001F3FF":                   EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F4BB":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F4BC":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F527":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F52C":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F680":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F692":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F91D\u200D\U0001F468\U0001F3FB":                   EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F91D\u200D\U0001F468\U0001F3FC":                   EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F91D\u200D\U0001F468\U0001F3FE":                   EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F91D\u200D\U0001F468\U0001F3FF":                   EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F91D\u200D\U0001F469\U0001F3FB":                   EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F91D\u200D\U0001F469\U0001F3FC":                   EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F91D\u200D\U0001F469\U0001F3FE":                   EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F91D\u200D\U0001F469\U0001F3FF":                   EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F9AF":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F9AF\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F9B0":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F9B1":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F9B2":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F9B3":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F9BC":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F9BC\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F9BD":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001F9BD\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001FAEF\u200D\U0001F469\U0001F3FB":                   EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001FAEF\u200D\U0001F469\U0001F3FC":                   EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001FAEF\u200D\U0001F469\U0001F3FE":                   EmojiZWJ,
+	"\U0001F469\U0001F3FD\u200D\U0001FAEF\u200D\U0001F469\U0001F3FF":                   EmojiZWJ,
+	"\U0001F469\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F469\U0001F3FE\u200D\u2695\uFE0F":                                           EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\u2696\uFE0F":                                           EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\u2708\uFE0F":                                           EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FB":                 EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FC":                 EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FD":                 EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FE":                 EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FF":                 EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FB":                 EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FC":                 EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FD":                 EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FE":                 EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FF":                 EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FB": EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FC": EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FD": EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FE": EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FF": EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FB": EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FC": EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FD": EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FE": EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FF": EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F33E":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F373":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F37C":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F393":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F3A4":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F3A8":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F3EB":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F3ED":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F430\u200D\U0001F469\U0001F3FB":                   EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F430\u200D\U0001F469\U0001F3FC":                   EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F430\u200D\U0001F469\U0001F3FD":                   EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F430\u200D\U0001F469\U0001F3FF":                   EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F4BB":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F4BC":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F527":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F52C":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F680":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F692":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F91D\u200D\U0001F468\U0001F3FB":                   EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F91D\u200D\U0001F468\U0001F3FC":                   EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F91D\u200D\U0001F468\U0001F3FD":                   EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F91D\u200D\U0001F468\U0001F3FF":                   EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F91D\u200D\U0001F469\U0001F3FB":                   EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F91D\u200D\U0001F469\U0001F3FC":                   EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F91D\u200D\U0001F469\U0001F3FD":                   EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F91D\u200D\U0001F469\U0001F3FF":                   EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F9AF":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F9AF\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F9B0":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F9B1":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F9B2":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F9B3":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F9BC":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F9BC\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F9BD":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001F9BD\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001FAEF\u200D\U0001F469\U0001F3FB":                   EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001FAEF\u200D\U0001F469\U0001F3FC":                   EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001FAEF\u200D\U0001F469\U0001F3FD":                   EmojiZWJ,
+	"\U0001F469\U0001F3FE\u200D\U0001FAEF\u200D\U0001F469\U0001F3FF":                   EmojiZWJ,
+	"\U0001F469\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F469\U0001F3FF\u200D\u2695\uFE0F":                                           EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\u2696\uFE0F":                                           EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\u2708\uFE0F":                                           EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FB":                 EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FC":                 EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FD":                 EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FE":                 EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FF":                 EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FB":                 EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FC":                 EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FD":                 EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FE":                 EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FF":                 EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FB": EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FC": EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FD": EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FE": EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FF": EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FB": EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FC": EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FD": EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FE": EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FF": EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F33E":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F373":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F37C":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F393":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F3A4":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F3A8":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F3EB":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F3ED":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F430\u200D\U0001F469\U0001F3FB":                   EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F430\u200D\U0001F469\U0001F3FC":                   EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F430\u200D\U0001F469\U0001F3FD":                   EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F430\u200D\U0001F469\U0001F3FE":                   EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F4BB":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F4BC":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F527":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F52C":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F680":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F692":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F91D\u200D\U0001F468\U0001F3FB":                   EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F91D\u200D\U0001F468\U0001F3FC":                   EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F91D\u200D\U0001F468\U0001F3FD":                   EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F91D\u200D\U0001F468\U0001F3FE":                   EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F91D\u200D\U0001F469\U0001F3FB":                   EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F91D\u200D\U0001F469\U0001F3FC":                   EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F91D\u200D\U0001F469\U0001F3FD":                   EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F91D\u200D\U0001F469\U0001F3FE":                   EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F9AF":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F9AF\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F9B0":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F9B1":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F9B2":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F9B3":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F9BC":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F9BC\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F9BD":                                             EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001F9BD\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001FAEF\u200D\U0001F469\U0001F3FB":                   EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001FAEF\u200D\U0001F469\U0001F3FC":                   EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001FAEF\u200D\U0001F469\U0001F3FD":                   EmojiZWJ,
+	"\U0001F469\U0001F3FF\u200D\U0001FAEF\u200D\U0001F469\U0001F3FE":                   EmojiZWJ,
+	"\U0001F46B\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F46B\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F46B\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F46B\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F46B\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F46C\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F46C\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F46C\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F46C\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F46C\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F46D\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F46D\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F46D\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F46D\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F46D\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F46E\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F46E\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F46E\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F46E\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F46E\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F46E\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F46E\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F46E\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F46E\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F46E\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F46E\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F46E\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F46E\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F46E\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F46E\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F46E\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F46E\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F46F\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F46F\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F46F\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F46F\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F46F\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F46F\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F46F\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F46F\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F46F\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F46F\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F46F\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F46F\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F46F\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F46F\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F46F\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F46F\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F46F\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F470\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F470\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F470\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F470\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F470\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F470\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F470\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F470\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F470\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F470\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F470\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F470\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F470\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F470\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F470\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F470\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F470\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F471\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F471\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F471\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F471\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F471\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F471\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F471\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F471\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F471\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F471\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F471\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F471\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F471\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F471\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F471\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F471\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F471\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F472\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F472\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F472\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F472\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F472\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F473\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F473\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F473\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F473\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F473\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F473\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F473\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F473\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F473\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F473\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F473\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F473\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F473\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F473\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F473\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F473\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F473\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F474\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F474\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F474\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F474\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F474\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F475\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F475\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F475\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F475\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F475\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F476\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F476\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F476\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F476\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F476\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F477\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F477\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F477\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F477\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F477\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F477\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F477\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F477\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F477\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F477\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F477\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F477\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F477\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F477\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F477\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F477\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F477\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F478\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F478\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F478\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F478\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F478\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F47C\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F47C\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F47C\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F47C\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F47C\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F481\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F481\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F481\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F481\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F481\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F481\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F481\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F481\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F481\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F481\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F481\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F481\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F481\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F481\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F481\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F481\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F481\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F482\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F482\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F482\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F482\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F482\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F482\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F482\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F482\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F482\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F482\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F482\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F482\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F482\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F482\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F482\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F482\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F482\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F483\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F483\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F483\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F483\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F483\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F485\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F485\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F485\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F485\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F485\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F486\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F486\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F486\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F486\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F486\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F486\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F486\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F486\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F486\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F486\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F486\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F486\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F486\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F486\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F486\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F486\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F486\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F487\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F487\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F487\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F487\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F487\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F487\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F487\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F487\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F487\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F487\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F487\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F487\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F487\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F487\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F487\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F487\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F487\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F48F\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F48F\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F48F\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F48F\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F48F\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F491\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F491\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F491\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F491\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F491\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F4AA\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F4AA\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F4AA\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F4AA\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F4AA\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F4FD\uFE0F":                                                                 EmojiBasic,
+	"\U0001F549\uFE0F":                                                                 EmojiBasic,
+	"\U0001F54A\uFE0F":                                                                 EmojiBasic,
+	"\U0001F56F\uFE0F":                                                                 EmojiBasic,
+	"\U0001F570\uFE0F":                                                                 EmojiBasic,
+	"\U0001F573\uFE0F":                                                                 EmojiBasic,
+	"\U0001F574\uFE0F":                                                                 EmojiBasic,
+	"\U0001F574\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F574\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F574\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F574\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F574\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F575\uFE0F":                                                                 EmojiBasic,
+	"\U0001F575\uFE0F\u200D\u2640\uFE0F":                                               EmojiZWJ,
+	"\U0001F575\uFE0F\u200D\u2642\uFE0F":                                               EmojiZWJ,
+	"\U0001F575\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F575\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F575\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F575\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F575\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F575\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F575\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F575\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F575\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F575\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F575\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F575\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F575\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F575\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F575\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F576\uFE0F":                                                                 EmojiBasic,
+	"\U0001F577\uFE0F":                                                                 EmojiBasic,
+	"\U0001F578\uFE0F":                                                                 EmojiBasic,
+	"\U0001F579\uFE0F":                                                                 EmojiBasic,
+	"\U0001F57A\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F57A\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F57A\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F57A\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F57A\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F587\uFE0F":                                                                 EmojiBasic,
+	"\U0001F58A\uFE0F":                                                                 EmojiBasic,
+	"\U0001F58B\uFE0F":                                                                 EmojiBasic,
+	"\U0001F58C\uFE0F":                                                                 EmojiBasic,
+	"\U0001F58D\uFE0F":                                                                 EmojiBasic,
+	"\U0001F590\uFE0F":                                                                 EmojiBasic,
+	"\U0001F590\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F590\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F590\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F590\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F590\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F595\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F595\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F595\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F595\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F595\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F596\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F596\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F596\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F596\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F596\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F5A5\uFE0F":                                                                 EmojiBasic,
+	"\U0001F5A8\uFE0F":                                                                 EmojiBasic,
+	"\U0001F5B1\uFE0F":                                                                 EmojiBasic,
+	"\U0001F5B2\uFE0F":                                                                 EmojiBasic,
+	"\U0001F5BC\uFE0F":                                                                 EmojiBasic,
+	"\U0001F5C2\uFE0F":                                                                 EmojiBasic,
+	"\U0001F5C3\uFE0F":                                                                 EmojiBasic,
+	"\U0001F5C4\uFE0F":                                                                 EmojiBasic,
+	"\U0001F5D1\uFE0F":                                                                 EmojiBasic,
+	"\U0001F5D2\uFE0F":                                                                 EmojiBasic,
+	"\U0001F5D3\uFE0F":                                                                 EmojiBasic,
+	"\U0001F5DC\uFE0F":                                                                 EmojiBasic,
+	"\U0001F5DD\uFE0F":                                                                 EmojiBasic,
+	"\U0001F5DE\uFE0F":                                                                 EmojiBasic,
+	"\U0001F5E1\uFE0F":                                                                 EmojiBasic,
+	"\U0001F5E3\uFE0F":                                                                 EmojiBasic,
+	"\U0001F5E8\uFE0F":                                                                 EmojiBasic,
+	"\U0001F5EF\uFE0F":                                                                 EmojiBasic,
+	"\U0001F5F3\uFE0F":                                                                 EmojiBasic,
+	"\U0001F5FA\uFE0F":                                                                 EmojiBasic,
+	"\U0001F62E\u200D\U0001F4A8":                                                       EmojiZWJ,
+	"\U0001F635\u200D\U0001F4AB":                                                       EmojiZWJ,
+	"\U0001F636\u200D\U0001F32B\uFE0F":                                                 EmojiZWJ,
+	"\U0001F642\u200D\u2194\uFE0F":                                                     EmojiZWJ,
+	"\U0001F642\u200D\u2195\uFE0F":                                                     EmojiZWJ,
+	"\U0001F645\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F645\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F645\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F645\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F645\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F645\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F645\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F645\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F645\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F645\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F645\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F645\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F645\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F645\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F645\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F645\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F645\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F646\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F646\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F646\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F646\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F646\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F646\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F646\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F646\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F646\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F646\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F646\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F646\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F646\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F646\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F646\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F646\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F646\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F647\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F647\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F647\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F647\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F647\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F647\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F647\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F647\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F647\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F647\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F647\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F647\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F647\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F647\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F647\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F647\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F647\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F64B\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F64B\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F64B\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F64B\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F64B\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F64B\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F64B\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F64B\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F64B\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F64B\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F64B\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F64B\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F64B\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F64B\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F64B\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F64B\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F64B\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F64C\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F64C\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F64C\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F64C\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F64C\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F64D\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F64D\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F64D\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F64D\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F64D\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F64D\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F64D\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F64D\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F64D\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F64D\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F64D\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F64D\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F64D\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F64D\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F64D\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F64D\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F64D\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F64E\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F64E\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F64E\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F64E\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F64E\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F64E\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F64E\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F64E\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F64E\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F64E\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F64E\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F64E\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F64E\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F64E\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F64E\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F64E\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F64E\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F64F\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F64F\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F64F\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F64F\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F64F\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F6A3\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F6A3\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F6A3\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F6A3\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F6A3\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F6A3\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F6A3\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F6A3\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F6A3\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F6A3\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F6A3\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F6A3\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F6A3\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F6A3\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F6A3\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F6A3\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F6A3\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B4\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F6B4\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F6B4\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F6B4\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B4\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B4\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F6B4\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B4\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B4\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F6B4\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B4\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B4\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F6B4\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B4\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B4\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F6B4\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B4\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B5\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F6B5\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F6B5\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F6B5\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B5\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B5\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F6B5\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B5\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B5\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F6B5\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B5\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B5\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F6B5\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B5\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B5\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F6B5\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B5\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B6\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F6B6\u200D\u2640\uFE0F\u200D\u27A1\uFE0F":                                   EmojiZWJ,
+	"\U0001F6B6\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F6B6\u200D\u2642\uFE0F\u200D\u27A1\uFE0F":                                   EmojiZWJ,
+	"\U0001F6B6\u200D\u27A1\uFE0F":                                                     EmojiZWJ,
+	"\U0001F6B6\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F6B6\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B6\U0001F3FB\u200D\u2640\uFE0F\u200D\u27A1\uFE0F":                         EmojiZWJ,
+	"\U0001F6B6\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B6\U0001F3FB\u200D\u2642\uFE0F\u200D\u27A1\uFE0F":                         EmojiZWJ,
+	"\U0001F6B6\U0001F3FB\u200D\u27A1\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B6\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F6B6\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B6\U0001F3FC\u200D\u2640\uFE0F\u200D\u27A1\uFE0F":                         EmojiZWJ,
+	"\U0001F6B6\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B6\U0001F3FC\u200D\u2642\uFE0F\u200D\u27A1\uFE0F":                         EmojiZWJ,
+	"\U0001F6B6\U0001F3FC\u200D\u27A1\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B6\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F6B6\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B6\U0001F3FD\u200D\u2640\uFE0F\u200D\u27A1\uFE0F":                         EmojiZWJ,
+	"\U0001F6B6\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B6\U0001F3FD\u200D\u2642\uFE0F\u200D\u27A1\uFE0F":                         EmojiZWJ,
+	"\U0001F6B6\U0001F3FD\u200D\u27A1\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B6\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F6B6\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B6\U0001F3FE\u200D\u2640\uFE0F\u200D\u27A1\uFE0F":                         EmojiZWJ,
+	"\U0001F6B6\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B6\U0001F3FE\u200D\u2642\uFE0F\u200D\u27A1\uFE0F":                         EmojiZWJ,
+	"\U0001F6B6\U0001F3FE\u200D\u27A1\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B6\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F6B6\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B6\U0001F3FF\u200D\u2640\uFE0F\u200D\u27A1\uFE0F":                         EmojiZWJ,
+	"\U0001F6B6\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F6B6\U0001F3FF\u200D\u2642\uFE0F\u200D\u27A1\uFE0F":                         EmojiZWJ,
+	"\U0001F6B6\U0001F3FF\u200D\u27A1\uFE0F":                                           EmojiZWJ,
+	"\U0001F6C0\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F6C0\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F6C0\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F6C0\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F6C0\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F6CB\uFE0F":                                                                 EmojiBasic,
+	"\U0001F6CC\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F6CC\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F6CC\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F6CC\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F6CC\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F6CD\uFE0F":                                                                 EmojiBasic,
+	"\U0001F6CE\uFE0F":                                                                 EmojiBasic,
+	"\U0001F6CF\uFE0F":                                                                 EmojiBasic,
+	"\U0001F6E0\uFE0F":                                                                 EmojiBasic,
+	"\U0001F6E1\uFE0F":                                                                 EmojiBasic,
+	"\U0001F6E2\uFE0F":                                                                 EmojiBasic,
+	"\U0001F6E3\uFE0F":                                                                 EmojiBasic,
+	"\U0001F6E4\uFE0F":                                                                 EmojiBasic,
+	"\U0001F6E5\uFE0F":                                                                 EmojiBasic,
+	"\U0001F6E9\uFE0F":                                                                 EmojiBasic,
+	"\U0001F6F0\uFE0F":                                                                 EmojiBasic,
+	"\U0001F6F3\uFE0F":                                                                 EmojiBasic,
+	"\U0001F90C\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F90C\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F90C\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F90C\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F90C\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F90F\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F90F\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F90F\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F90F\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F90F\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F918\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F918\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F918\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F918\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F918\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F919\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F919\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F919\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F919\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F919\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F91A\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F91A\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F91A\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F91A\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F91A\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F91B\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F91B\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F91B\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F91B\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F91B\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F91C\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F91C\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F91C\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F91C\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F91C\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F91D\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F91D\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F91D\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F91D\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F91D\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F91E\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F91E\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F91E\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F91E\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F91E\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F91F\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F91F\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F91F\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F91F\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F91F\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F926\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F926\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F926\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F926\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F926\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F926\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F926\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F926\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F926\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F926\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F926\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F926\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F926\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F926\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F926\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F926\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F926\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F930\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F930\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F930\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F930\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F930\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F931\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F931\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F931\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F931\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F931\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F932\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F932\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F932\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F932\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F932\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F933\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F933\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F933\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F933\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F933\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F934\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F934\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F934\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F934\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F934\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F935\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F935\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F935\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F935\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F935\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F935\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F935\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F935\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F935\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F935\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F935\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F935\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F935\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F935\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F935\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F935\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F935\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F936\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F936\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F936\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F936\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F936\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F937\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F937\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F937\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F937\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F937\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F937\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F937\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F937\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F937\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F937\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F937\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F937\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F937\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F937\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F937\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F937\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F937\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F938\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F938\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F938\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F938\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F938\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F938\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F938\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F938\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F938\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F938\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F938\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F938\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F938\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F938\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F938\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F938\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F938\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F939\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F939\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F939\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F939\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F939\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F939\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F939\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F939\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F939\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F939\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F939\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F939\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F939\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F939\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F939\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F939\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F939\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F93C\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F93C\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F93C\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F93C\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F93C\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F93C\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F93C\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F93C\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F93C\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F93C\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F93C\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F93C\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F93C\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F93C\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F93C\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F93C\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F93C\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F93D\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F93D\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F93D\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F93D\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F93D\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F93D\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F93D\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F93D\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F93D\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F93D\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F93D\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F93D\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F93D\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F93D\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F93D\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F93D\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F93D\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F93E\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F93E\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F93E\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F93E\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F93E\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F93E\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F93E\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F93E\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F93E\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F93E\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F93E\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F93E\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F93E\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F93E\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F93E\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F93E\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F93E\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F977\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F977\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F977\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F977\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F977\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F9B5\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F9B5\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F9B5\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F9B5\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F9B5\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F9B6\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F9B6\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F9B6\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F9B6\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F9B6\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F9B8\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9B8\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9B8\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F9B8\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9B8\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9B8\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F9B8\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9B8\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9B8\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F9B8\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9B8\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9B8\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F9B8\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9B8\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9B8\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F9B8\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9B8\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9B9\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9B9\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9B9\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F9B9\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9B9\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9B9\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F9B9\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9B9\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9B9\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F9B9\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9B9\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9B9\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F9B9\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9B9\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9B9\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F9B9\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9B9\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9BB\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F9BB\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F9BB\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F9BB\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F9BB\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F9CD\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9CD\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9CD\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F9CD\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CD\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CD\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F9CD\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CD\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CD\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F9CD\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CD\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CD\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F9CD\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CD\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CD\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F9CD\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CD\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CE\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9CE\u200D\u2640\uFE0F\u200D\u27A1\uFE0F":                                   EmojiZWJ,
+	"\U0001F9CE\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9CE\u200D\u2642\uFE0F\u200D\u27A1\uFE0F":                                   EmojiZWJ,
+	"\U0001F9CE\u200D\u27A1\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9CE\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F9CE\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CE\U0001F3FB\u200D\u2640\uFE0F\u200D\u27A1\uFE0F":                         EmojiZWJ,
+	"\U0001F9CE\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CE\U0001F3FB\u200D\u2642\uFE0F\u200D\u27A1\uFE0F":                         EmojiZWJ,
+	"\U0001F9CE\U0001F3FB\u200D\u27A1\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CE\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F9CE\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CE\U0001F3FC\u200D\u2640\uFE0F\u200D\u27A1\uFE0F":                         EmojiZWJ,
+	"\U0001F9CE\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CE\U0001F3FC\u200D\u2642\uFE0F\u200D\u27A1\uFE0F":                         EmojiZWJ,
+	"\U0001F9CE\U0001F3FC\u200D\u27A1\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CE\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F9CE\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CE\U0001F3FD\u200D\u2640\uFE0F\u200D\u27A1\uFE0F":                         EmojiZWJ,
+	"\U0001F9CE\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CE\U0001F3FD\u200D\u2642\uFE0F\u200D\u27A1\uFE0F":                         EmojiZWJ,
+	"\U0001F9CE\U0001F3FD\u200D\u27A1\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CE\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F9CE\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CE\U0001F3FE\u200D\u2640\uFE0F\u200D\u27A1\uFE0F":                         EmojiZWJ,
+	"\U0001F9CE\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CE\U0001F3FE\u200D\u2642\uFE0F\u200D\u27A1\uFE0F":                         EmojiZWJ,
+	"\U0001F9CE\U0001F3FE\u200D\u27A1\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CE\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F9CE\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CE\U0001F3FF\u200D\u2640\uFE0F\u200D\u27A1\uFE0F":                         EmojiZWJ,
+	"\U0001F9CE\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CE\U0001F3FF\u200D\u2642\uFE0F\u200D\u27A1\uFE0F":                         EmojiZWJ,
+	"\U0001F9CE\U0001F3FF\u200D\u27A1\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CF\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9CF\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9CF\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F9CF\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CF\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CF\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F9CF\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CF\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CF\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F9CF\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CF\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CF\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F9CF\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CF\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CF\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F9CF\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9CF\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D1\u200D\u2695\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9D1\u200D\u2696\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9D1\u200D\u2708\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F33E":                                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F373":                                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F37C":                                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F384":                                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F393":                                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F3A4":                                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F3A8":                                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F3EB":                                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F3ED":                                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F4BB":                                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F4BC":                                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F527":                                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F52C":                                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F680":                                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F692":                                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F91D\u200D\U0001F9D1":                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F9AF":                                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F9AF\u200D\u27A1\uFE0F":                                     EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F9B0":                                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F9B1":                                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F9B2":                                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F9B3":                                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F9BC":                                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F9BC\u200D\u27A1\uFE0F":                                     EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F9BD":                                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F9BD\u200D\u27A1\uFE0F":                                     EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F9D1\u200D\U0001F9D2":                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F9D1\u200D\U0001F9D2\u200D\U0001F9D2":                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F9D2":                                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001F9D2\u200D\U0001F9D2":                                       EmojiZWJ,
+	"\U0001F9D1\u200D\U0001FA70":                                                       EmojiZWJ,
+	"\U0001F9D1\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F9D1\U0001F3FB\u200D\u2695\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\u2696\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\u2708\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FC": EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FD": EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FE": EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FF": EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FC":                 EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FD":                 EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FE":                 EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FF":                 EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F33E":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F373":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F37C":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F384":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F393":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F3A4":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F3A8":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F3EB":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F3ED":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F430\u200D\U0001F9D1\U0001F3FC":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F430\u200D\U0001F9D1\U0001F3FD":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F430\u200D\U0001F9D1\U0001F3FE":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F430\u200D\U0001F9D1\U0001F3FF":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F4BB":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F4BC":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F527":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F52C":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F680":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F692":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FB":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FC":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FD":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FE":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FF":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F9AF":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F9AF\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F9B0":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F9B1":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F9B2":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F9B3":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F9BC":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F9BC\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F9BD":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001F9BD\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001FA70":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001FAEF\u200D\U0001F9D1\U0001F3FC":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001FAEF\u200D\U0001F9D1\U0001F3FD":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001FAEF\u200D\U0001F9D1\U0001F3FE":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FB\u200D\U0001FAEF\u200D\U0001F9D1\U0001F3FF":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F9D1\U0001F3FC\u200D\u2695\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\u2696\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\u2708\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FB": EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FD": EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FE": EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FF": EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FB":                 EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FD":                 EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FE":                 EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FF":                 EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F33E":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F373":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F37C":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F384":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F393":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F3A4":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F3A8":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F3EB":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F3ED":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F430\u200D\U0001F9D1\U0001F3FB":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F430\u200D\U0001F9D1\U0001F3FD":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F430\u200D\U0001F9D1\U0001F3FE":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F430\u200D\U0001F9D1\U0001F3FF":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F4BB":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F4BC":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F527":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F52C":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F680":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F692":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FB":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FC":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FD":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FE":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FF":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F9AF":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F9AF\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F9B0":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F9B1":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F9B2":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F9B3":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F9BC":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F9BC\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F9BD":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001F9BD\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001FA70":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001FAEF\u200D\U0001F9D1\U0001F3FB":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001FAEF\u200D\U0001F9D1\U0001F3FD":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001FAEF\u200D\U0001F9D1\U0001F3FE":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FC\u200D\U0001FAEF\u200D\U0001F9D1\U0001F3FF":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F9D1\U0001F3FD\u200D\u2695\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\u2696\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\u2708\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FB": EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FC": EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FE": EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FF": EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FB":                 EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FC":                 EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FE":                 EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FF":                 EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F33E":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F373":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F37C":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F384":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F393":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F3A4":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F3A8":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F3EB":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F3ED":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F430\u200D\U0001F9D1\U0001F3FB":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F430\u200D\U0001F9D1\U0001F3FC":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F430\u200D\U0001F9D1\U0001F3FE":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F430\u200D\U0001F9D1\U0001F3FF":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F4BB":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F4BC":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F527":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F52C":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F680":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F692":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FB":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FC":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FD":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FE":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FF":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F9AF":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F9AF\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F9B0":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F9B1":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F9B2":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F9B3":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F9BC":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F9BC\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F9BD":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001F9BD\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001FA70":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001FAEF\u200D\U0001F9D1\U0001F3FB":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001FAEF\u200D\U0001F9D1\U0001F3FC":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001FAEF\u200D\U0001F9D1\U0001F3FE":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FD\u200D\U0001FAEF\u200D\U0001F9D1\U0001F3FF":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F9D1\U0001F3FE\u200D\u2695\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\u2696\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\u2708\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FB": EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FC": EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FD": EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FF": EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FB":                 EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FC":                 EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FD":                 EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FF":                 EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F33E":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F373":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F37C":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F384":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F393":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F3A4":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F3A8":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F3EB":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F3ED":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F430\u200D\U0001F9D1\U0001F3FB":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F430\u200D\U0001F9D1\U0001F3FC":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F430\u200D\U0001F9D1\U0001F3FD":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F430\u200D\U0001F9D1\U0001F3FF":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F4BB":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F4BC":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F527":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F52C":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F680":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F692":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FB":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FC":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FD":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FE":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FF":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F9AF":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F9AF\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F9B0":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F9B1":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F9B2":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F9B3":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F9BC":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F9BC\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F9BD":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001F9BD\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001FA70":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001FAEF\u200D\U0001F9D1\U0001F3FB":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001FAEF\u200D\U0001F9D1\U0001F3FC":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001FAEF\u200D\U0001F9D1\U0001F3FD":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FE\u200D\U0001FAEF\u200D\U0001F9D1\U0001F3FF":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F9D1\U0001F3FF\u200D\u2695\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\u2696\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\u2708\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FB": EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FC": EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FD": EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FE": EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FB":                 EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FC":                 EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FD":                 EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FE":                 EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F33E":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F373":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F37C":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F384":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F393":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F3A4":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F3A8":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F3EB":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F3ED":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F430\u200D\U0001F9D1\U0001F3FB":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F430\u200D\U0001F9D1\U0001F3FC":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F430\u200D\U0001F9D1\U0001F3FD":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F430\u200D\U0001F9D1\U0001F3FE":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F4BB":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F4BC":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F527":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F52C":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F680":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F692":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FB":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FC":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FD":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FE":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FF":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F9AF":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F9AF\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F9B0":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F9B1":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F9B2":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F9B3":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F9BC":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F9BC\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F9BD":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001F9BD\u200D\u27A1\uFE0F":                           EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001FA70":                                             EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001FAEF\u200D\U0001F9D1\U0001F3FB":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001FAEF\u200D\U0001F9D1\U0001F3FC":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001FAEF\u200D\U0001F9D1\U0001F3FD":                   EmojiZWJ,
+	"\U0001F9D1\U0001F3FF\u200D\U0001FAEF\u200D\U0001F9D1\U0001F3FE":                   EmojiZWJ,
+	"\U0001F9D2\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F9D2\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F9D2\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F9D2\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F9D2\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F9D3\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F9D3\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F9D3\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F9D3\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F9D3\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F9D4\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9D4\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9D4\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F9D4\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D4\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D4\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F9D4\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D4\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D4\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F9D4\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D4\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D4\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F9D4\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D4\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D4\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F9D4\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D4\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D5\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F9D5\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F9D5\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F9D5\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F9D5\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F9D6\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9D6\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9D6\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F9D6\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D6\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D6\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F9D6\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D6\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D6\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F9D6\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D6\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D6\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F9D6\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D6\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D6\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F9D6\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D6\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D7\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9D7\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9D7\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F9D7\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D7\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D7\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F9D7\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D7\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D7\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F9D7\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D7\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D7\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F9D7\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D7\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D7\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F9D7\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D7\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D8\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9D8\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9D8\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F9D8\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D8\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D8\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F9D8\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D8\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D8\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F9D8\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D8\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D8\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F9D8\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D8\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D8\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F9D8\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D8\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D9\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9D9\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9D9\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F9D9\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D9\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D9\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F9D9\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D9\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D9\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F9D9\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D9\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D9\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F9D9\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D9\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D9\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F9D9\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9D9\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DA\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9DA\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9DA\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F9DA\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DA\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DA\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F9DA\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DA\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DA\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F9DA\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DA\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DA\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F9DA\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DA\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DA\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F9DA\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DA\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DB\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9DB\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9DB\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F9DB\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DB\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DB\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F9DB\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DB\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DB\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F9DB\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DB\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DB\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F9DB\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DB\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DB\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F9DB\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DB\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DC\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9DC\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9DC\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F9DC\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DC\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DC\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F9DC\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DC\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DC\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F9DC\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DC\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DC\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F9DC\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DC\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DC\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F9DC\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DC\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DD\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9DD\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9DD\U0001F3FB":                                                             EmojiModifier,
+	"\U0001F9DD\U0001F3FB\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DD\U0001F3FB\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DD\U0001F3FC":                                                             EmojiModifier,
+	"\U0001F9DD\U0001F3FC\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DD\U0001F3FC\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DD\U0001F3FD":                                                             EmojiModifier,
+	"\U0001F9DD\U0001F3FD\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DD\U0001F3FD\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DD\U0001F3FE":                                                             EmojiModifier,
+	"\U0001F9DD\U0001F3FE\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DD\U0001F3FE\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DD\U0001F3FF":                                                             EmojiModifier,
+	"\U0001F9DD\U0001F3FF\u200D\u2640\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DD\U0001F3FF\u200D\u2642\uFE0F":                                           EmojiZWJ,
+	"\U0001F9DE\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9DE\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9DF\u200D\u2640\uFE0F":                                                     EmojiZWJ,
+	"\U0001F9DF\u200D\u2642\uFE0F":                                                     EmojiZWJ,
+	"\U0001FAC3\U0001F3FB":                                                             EmojiModifier,
+	"\U0001FAC3\U0001F3FC":                                                             EmojiModifier,
+	"\U0001FAC3\U0001F3FD":                                                             EmojiModifier,
+	"\U0001FAC3\U0001F3FE":                                                             EmojiModifier,
+	"\U0001FAC3\U0001F3FF":                                                             EmojiModifier,
+	"\U0001FAC4\U0001F3FB":                                                             EmojiModifier,
+	"\U0001FAC4\U0001F3FC":                                                             EmojiModifier,
+	"\U0001FAC4\U0001F3FD":                                                             EmojiModifier,
+	"\U0001FAC4\U0001F3FE":                                                             EmojiModifier,
+	"\U0001FAC4\U0001F3FF":                                                             EmojiModifier,
+	"\U0001FAC5\U0001F3FB":                                                             EmojiModifier,
+	"\U0001FAC5\U0001F3FC":                                                             EmojiModifier,
+	"\U0001FAC5\U0001F3FD":                                                             EmojiModifier,
+	"\U0001FAC5\U0001F3FE":                                                             EmojiModifier,
+	"\U0001FAC5\U0001F3FF":                                                             EmojiModifier,
+	"\U0001FAF0\U0001F3FB":                                                             EmojiModifier,
+	"\U0001FAF0\U0001F3FC":                                                             EmojiModifier,
+	"\U0001FAF0\U0001F3FD":                                                             EmojiModifier,
+	"\U0001FAF0\U0001F3FE":                                                             EmojiModifier,
+	"\U0001FAF0\U0001F3FF":                                                             EmojiModifier,
+	"\U0001FAF1\U0001F3FB":                                                             EmojiModifier,
+	"\U0001FAF1\U0001F3FB\u200D\U0001FAF2\U0001F3FC":                                   EmojiZWJ,
+	"\U0001FAF1\U0001F3FB\u200D\U0001FAF2\U0001F3FD":                                   EmojiZWJ,
+	"\U0001FAF1\U0001F3FB\u200D\U0001FAF2\U0001F3FE":                                   EmojiZWJ,
+	"\U0001FAF1\U0001F3FB\u200D\U0001FAF2\U0001F3FF":                                   EmojiZWJ,
+	"\U0001FAF1\U0001F3FC":                                                             EmojiModifier,
+	"\U0001FAF1\U0001F3FC\u200D\U0001FAF2\U0001F3FB":                                   EmojiZWJ,
+	"\U0001FAF1\U0001F3FC\u200D\U0001FAF2\U0001F3FD":                                   EmojiZWJ,
+	"\U0001FAF1\U0001F3FC\u200D\U0001FAF2\U0001F3FE":                                   EmojiZWJ,
+	"\U0001FAF1\U0001F3FC\u200D\U0001FAF2\U0001F3FF":                                   EmojiZWJ,
+	"\U0001FAF1\U0001F3FD":                                                             EmojiModifier,
+	"\U0001FAF1\U0001F3FD\u200D\U0001FAF2\U0001F3FB":                                   EmojiZWJ,
+	"\U0001FAF1\U0001F3FD\u200D\U0001FAF2\U0001F3FC":                                   EmojiZWJ,
+	"\U0001FAF1\U0001F3FD\u200D\U0001FAF2\U0001F3FE":                                   EmojiZWJ,
+	"\U0001FAF1\U0001F3FD\u200D\U0001FAF2\U0001F3FF":                                   EmojiZWJ,
+	"\U0001FAF1\U0001F3FE":                                                             EmojiModifier,
+	"\U0001FAF1\U0001F3FE\u200D\U0001FAF2\U0001F3FB":                                   EmojiZWJ,
+	"\U0001FAF1\U0001F3FE\u200D\U0001FAF2\U0001F3FC":                                   EmojiZWJ,
+	"\U0001FAF1\U0001F3FE\u200D\U0001FAF2\U0001F3FD":                                   EmojiZWJ,
+	"\U0001FAF1\U0001F3FE\u200D\U0001FAF2\U0001F3FF":                                   EmojiZWJ,
+	"\U0001FAF1\U0001F3FF":                                                             EmojiModifier,
+	"\U0001FAF1\U0001F3FF\u200D\U0001FAF2\U0001F3FB":                                   EmojiZWJ,
+	"\U0001FAF1\U0001F3FF\u200D\U0001FAF2\U0001F3FC":                                   EmojiZWJ,
+	"\U0001FAF1\U0001F3FF\u200D\U0001FAF2\U0001F3FD":                                   EmojiZWJ,
+	"\U0001FAF1\U0001F3FF\u200D\U0001FAF2\U0001F3FE":                                   EmojiZWJ,
+	"\U0001FAF2\U0001F3FB":                                                             EmojiModifier,
+	"\U0001FAF2\U0001F3FC":                                                             EmojiModifier,
+	"\U0001FAF2\U0001F3FD":                                                             EmojiModifier,
+	"\U0001FAF2\U0001F3FE":                                                             EmojiModifier,
+	"\U0001FAF2\U0001F3FF":                                                             EmojiModifier,
+	"\U0001FAF3\U0001F3FB":                                                             EmojiModifier,
+	"\U0001FAF3\U0001F3FC":                                                             EmojiModifier,
+	"\U0001FAF3\U0001F3FD":                                                             EmojiModifier,
+	"\U0001FAF3\U0001F3FE":                                                             EmojiModifier,
+	"\U0001FAF3\U0001F3FF":                                                             EmojiModifier,
+	"\U0001FAF4\U0001F3FB":                                                             EmojiModifier,
+	"\U0001FAF4\U0001F3FC":                                                             EmojiModifier,
+	"\U0001FAF4\U0001F3FD":                                                             EmojiModifier,
+	"\U0001FAF4\U0001F3FE":                                                             EmojiModifier,
+	"\U0001FAF4\U0001F3FF":                                                             EmojiModifier,
+	"\U0001FAF5\U0001F3FB":                                                             EmojiModifier,
+	"\U0001FAF5\U0001F3FC":                                                             EmojiModifier,
+	"\U0001FAF5\U0001F3FD":                                                             EmojiModifier,
+	"\U0001FAF5\U0001F3FE":                                                             EmojiModifier,
+	"\U0001FAF5\U0001F3FF":                                                             EmojiModifier,
+	"\U0001FAF6\U0001F3FB":                                                             EmojiModifier,
+	"\U0001FAF6\U0001F3FC":                                                             EmojiModifier,
+	"\U0001FAF6\U0001F3FD":                                                             EmojiModifier,
+	"\U0001FAF6\U0001F3FE":                                                             EmojiModifier,
+	"\U0001FAF6\U0001F3FF":                                                             EmojiModifier,
+	"\U0001FAF7\U0001F3FB":                                                             EmojiModifier,
+	"\U0001FAF7\U0001F3FC":                                                             EmojiModifier,
+	"\U0001FAF7\U0001F3FD":                                                             EmojiModifier,
+	"\U0001FAF7\U0001F3FE":                                                             EmojiModifier,
+	"\U0001FAF7\U0001F3FF":                                                             EmojiModifier,
+	"\U0001FAF8\U0001F3FB":                                                             EmojiModifier,
+	"\U0001FAF8\U0001F3FC":                                                             EmojiModifier,
+	"\U0001FAF8\U0001F3FD":                                                             EmojiModifier,
+	"\U0001FAF8\U0001F3FE":                                                             EmojiModifier,
+	"\U0001FAF8\U0001F3FF":                                                             EmojiModifier,
+}
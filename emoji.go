@@ -0,0 +1,197 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"errors"
+	"sync"
+	"unicode"
+)
+
+//go:generate go run gen-emoji-tables.go
+
+// EmojiKind is the kind of an emoji sequence, following the type fields of
+// the UTS #51 emoji sequence data
+type EmojiKind uint8
+
+const (
+	// EmojiNone is not an emoji
+	EmojiNone EmojiKind = iota
+	// EmojiBasic is a single emoji rune, optionally followed by a variation
+	// selector
+	EmojiBasic
+	// EmojiKeycap is a digit, number sign or asterisk followed by the
+	// emoji variation selector and the combining enclosing keycap
+	EmojiKeycap
+	// EmojiFlag is a pair of regional indicators of a recommended flag,
+	// other pairs are not emoji
+	EmojiFlag
+	// EmojiTag is an emoji followed by tag characters and the cancel tag,
+	// such as the recommended subdivision flags
+	EmojiTag
+	// EmojiModifier is an emoji followed by a skin tone modifier
+	EmojiModifier
+	// EmojiZWJ is a sequence of emoji joined by the zero width joiner, such
+	// as the family emoji
+	EmojiZWJ
+)
+
+// String returns the name of the EmojiKind
+func (k EmojiKind) String() string {
+	switch k {
+	case EmojiBasic:
+		return "Basic"
+	case EmojiKeycap:
+		return "Keycap"
+	case EmojiFlag:
+		return "Flag"
+	case EmojiTag:
+		return "Tag"
+	case EmojiModifier:
+		return "Modifier"
+	case EmojiZWJ:
+		return "ZWJ"
+	}
+	return "None"
+}
+
+// Emoji is an emoji sequence found by ReadEmojiAt or an EmojiSpans iterator
+type Emoji struct {
+	Kind EmojiKind
+	// Index is the index of the sequence
+	Index int64
+	// Size is the width of the sequence in native units, zero when there is
+	// no emoji at Index
+	Size int
+	// TextPresentation is true when the emoji is followed by the text
+	// variation selector, VS15, requesting it be shown as text
+	TextPresentation bool
+}
+
+const (
+	emojiVS15 = '\uFE0E'
+	emojiVS16 = '\uFE0F'
+)
+
+var (
+	emojiStartsOnce sync.Once
+	emojiStarts     map[rune]struct{}
+)
+
+// mayStartEmoji returns true if an emoji sequence may begin with ch
+func mayStartEmoji(ch rune) bool {
+	if unicode.Is(emojiBasic, ch) {
+		return true
+	}
+	emojiStartsOnce.Do(func() {
+		emojiStarts = make(map[rune]struct{})
+		for seq := range emojiSequences {
+			for _, first := range seq {
+				emojiStarts[first] = struct{}{}
+				break
+			}
+		}
+	})
+	_, ok := emojiStarts[ch]
+	return ok
+}
+
+// matchEmoji returns the kind and the number of runes of the emoji sequence
+// at the start of seq, and whether it is followed by VS15
+func matchEmoji(seq []rune) (kind EmojiKind, n int, text bool) {
+	if len(seq) > 1 && seq[1] == emojiVS15 {
+		if unicode.Is(emojiBasic, seq[0]) || emojiSequences[string([]rune{seq[0], emojiVS16})] == EmojiBasic {
+			return EmojiBasic, 2, true
+		}
+	}
+	for n = len(seq); n > 1; n-- {
+		if kind = emojiSequences[string(seq[:n])]; kind != EmojiNone {
+			return kind, n, false
+		}
+	}
+	if unicode.Is(emojiBasic, seq[0]) {
+		if len(seq) > 1 && seq[1] == emojiVS16 {
+			return EmojiBasic, 2, false
+		}
+		return EmojiBasic, 1, false
+	}
+	return EmojiNone, 0, false
+}
+
+// readEmojiAt returns the emoji sequence at the index given, along with the
+// width of the rune at that index
+func readEmojiAt(r RuneReader, name string, index int64) (emoji Emoji, width int, err error) {
+	emoji.Index = index
+	if index < 0 {
+		return emoji, 0, errors.New(name + ": negative position")
+	}
+	var ch rune
+	if ch, width, err = r.ReadRuneAt(index); err != nil || !mayStartEmoji(ch) {
+		return
+	}
+	var seq []rune
+	if seq, _, err = r.ReadRuneSlice(index, emojiMaxSequence); err != nil {
+		return
+	}
+	var n int
+	if emoji.Kind, n, emoji.TextPresentation = matchEmoji(seq); n > 0 {
+		if ri, ok := r.(runeIndexer); ok && ri.runeIndexed() {
+			emoji.Size = n
+		} else {
+			for _, c := range seq[:n] {
+				emoji.Size += runeLen(c)
+			}
+		}
+	}
+	return
+}
+
+// EmojiSpans is an iterator over the emoji sequences of a RuneReader,
+// created by the Emojis methods of the readers
+//
+// The reader is used to read each emoji as it is reached and its position is
+// unspecified while iterating
+type EmojiSpans struct {
+	r     RuneReader
+	name  string
+	size  int64
+	index int64
+	emoji Emoji
+	err   error
+}
+
+func newEmojiSpans(r RuneReader, name string) *EmojiSpans {
+	return &EmojiSpans{r: r, name: name, size: r.Size()}
+}
+
+// Next advances to the next emoji sequence, returning false when there are no
+// more or an error occurred
+//
+// Next was added by go-corelibs
+func (s *EmojiSpans) Next() bool {
+	for s.err == nil && s.index < s.size {
+		emoji, width, err := readEmojiAt(s.r, s.name, s.index)
+		if err != nil {
+			s.err = err
+			return false
+		} else if emoji.Size > 0 {
+			s.emoji = emoji
+			s.index += int64(emoji.Size)
+			return true
+		}
+		s.index += int64(width)
+	}
+	return false
+}
+
+// Emoji returns the current emoji sequence
+//
+// Emoji was added by go-corelibs
+func (s *EmojiSpans) Emoji() Emoji { return s.emoji }
+
+// Err returns the first error encountered while iterating
+//
+// Err was added by go-corelibs
+func (s *EmojiSpans) Err() error { return s.err }
@@ -0,0 +1,149 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes_test
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	. "github.com/go-corelibs/runes"
+)
+
+type emojiReader interface {
+	RuneReader
+	ReadEmojiAt(index int64) (emoji Emoji, err error)
+	Emojis() *EmojiSpans
+}
+
+// newEmojiReaders returns the bytes, string and runes readers of text
+func newEmojiReaders(text string) []emojiReader {
+	return []emojiReader{
+		NewBytesReader([]byte(text)),
+		NewStringReader(text),
+		NewRunesReader([]rune(text)),
+	}
+}
+
+// nativeSize returns the size of text in the native units of r
+func nativeSize(r RuneReader, text string) int {
+	if _, ok := r.(*Reader); ok {
+		return len([]rune(text))
+	}
+	return len(text)
+}
+
+func TestEmojiConformance(t *testing.T) {
+	fh, err := os.Open("testdata/emoji-test.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fh.Close()
+
+	var count int
+	s := bufio.NewScanner(fh)
+	for line := 1; s.Scan(); line++ {
+		text, _, _ := strings.Cut(s.Text(), "#")
+		fields := strings.Split(text, ";")
+		if len(fields) < 2 {
+			continue
+		}
+		if status := strings.TrimSpace(fields[1]); status != "fully-qualified" && status != "component" {
+			continue
+		}
+		count++
+		emoji := parseCodePoints(t, fields[0])
+		for _, r := range newEmojiReaders("a" + emoji + "b") {
+			if e, err := r.ReadEmojiAt(1); e.Size != nativeSize(r, emoji) || err != nil {
+				t.Errorf("line %d: ReadEmojiAt(%+q) got %+v,%v", line, emoji, e, err)
+			}
+		}
+	}
+	if err = s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if count < 3500 {
+		t.Errorf("only %d emoji tested", count)
+	}
+}
+
+func TestReadEmojiAt(t *testing.T) {
+	for _, tc := range []struct {
+		text string
+		kind EmojiKind
+		want string // the text of the emoji
+		vs15 bool
+	}{
+		{"\U0001F600!", EmojiBasic, "\U0001F600", false},
+		{"\u2764\ufe0f!", EmojiBasic, "\u2764\ufe0f", false},
+		{"\u2764\ufe0e!", EmojiBasic, "\u2764\ufe0e", true},
+		{"\u231a\ufe0e", EmojiBasic, "\u231a\ufe0e", true},
+		{"\u231a\ufe0f", EmojiBasic, "\u231a\ufe0f", false},
+		{"#\ufe0f\u20e3#", EmojiKeycap, "#\ufe0f\u20e3", false},
+		{"\U0001F1E8\U0001F1E6\U0001F1E8", EmojiFlag, "\U0001F1E8\U0001F1E6", false},
+		{"\U0001F1FF\U0001F1FF", EmojiNone, "", false},
+		{"\U0001F3F4\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F", EmojiTag, "\U0001F3F4\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F", false},
+		{"\U0001F3F4\U000E0078\U000E0078\U000E007F", EmojiBasic, "\U0001F3F4", false},
+		{"\U0001F44D\U0001F3FD\U0001F3FD", EmojiModifier, "\U0001F44D\U0001F3FD", false},
+		{"\U0001F468\u200d\U0001F469\u200d\U0001F467\u200d\U0001F466", EmojiZWJ, "\U0001F468\u200d\U0001F469\u200d\U0001F467\u200d\U0001F466", false},
+		{"\U0001F468\u200d\U0001F469\u200d\U0001F600", EmojiBasic, "\U0001F468", false},
+		{"\U0001F3F4\U000E0067", EmojiBasic, "\U0001F3F4", false},
+		{"a\U0001F600", EmojiNone, "", false},
+		{"\u2764 ", EmojiNone, "", false},
+		{"# ", EmojiNone, "", false},
+	} {
+		for _, r := range newEmojiReaders(tc.text) {
+			e, err := r.ReadEmojiAt(0)
+			if err != nil || e.Kind != tc.kind || e.Size != nativeSize(r, tc.want) || e.TextPresentation != tc.vs15 {
+				t.Errorf("%+q: got %+v,%v; want %v %d %v", tc.text, e, err, tc.kind, nativeSize(r, tc.want), tc.vs15)
+			}
+		}
+	}
+
+	r := NewStringReader("x")
+	if _, err := r.ReadEmojiAt(-1); err == nil || err.Error() != "StringReader.ReadEmojiAt: negative position" {
+		t.Errorf("ReadEmojiAt(-1): got %v", err)
+	}
+	if e, err := r.ReadEmojiAt(1); e.Size != 0 || err != io.EOF {
+		t.Errorf("ReadEmojiAt(1): got %+v,%v; want io.EOF", e, err)
+	}
+	if got := EmojiZWJ.String(); got != "ZWJ" {
+		t.Errorf("String: got %q", got)
+	}
+}
+
+func TestEmojis(t *testing.T) {
+	text := "hi \U0001F44B\U0001F3FB, \U0001F1EF\U0001F1F5\U0001F1FA! 1\ufe0f\u20e3\u2764\U0001F469\u200d\U0001F4BB"
+	for _, r := range newEmojiReaders(text) {
+		var got []Emoji
+		it := r.Emojis()
+		for it.Next() {
+			got = append(got, it.Emoji())
+		}
+		if it.Err() != nil {
+			t.Fatal(it.Err())
+		}
+		want := []struct {
+			kind  EmojiKind
+			index string // the text preceding the emoji
+			text  string
+		}{
+			{EmojiModifier, "hi ", "\U0001F44B\U0001F3FB"},
+			{EmojiFlag, "hi \U0001F44B\U0001F3FB, ", "\U0001F1EF\U0001F1F5"},
+			{EmojiKeycap, "hi \U0001F44B\U0001F3FB, \U0001F1EF\U0001F1F5\U0001F1FA! ", "1\ufe0f\u20e3"},
+			{EmojiZWJ, "hi \U0001F44B\U0001F3FB, \U0001F1EF\U0001F1F5\U0001F1FA! 1\ufe0f\u20e3\u2764", "\U0001F469\u200d\U0001F4BB"},
+		}
+		if len(got) != len(want) {
+			t.Fatalf("got %d emoji; want %d: %+v", len(got), len(want), got)
+		}
+		for i, e := range got {
+			if e.Kind != want[i].kind || e.Index != int64(nativeSize(r, want[i].index)) || e.Size != nativeSize(r, want[i].text) {
+				t.Errorf("%d: got %+v; want %v at %d", i, e, want[i].kind, nativeSize(r, want[i].index))
+			}
+		}
+	}
+}
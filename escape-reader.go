@@ -0,0 +1,285 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// TokenKind identifies the kind of an EscapeToken
+type TokenKind uint8
+
+const (
+	// TextToken is a run of visible text
+	TextToken TokenKind = iota
+	// CSIToken is a Control Sequence Introducer sequence, such as the SGR
+	// colour codes: ESC [ params intermediates final
+	CSIToken
+	// OSCToken is an Operating System Command sequence, such as a hyperlink:
+	// ESC ] data, terminated by BEL or ST
+	OSCToken
+	// StringToken is a DCS, SOS, PM or APC control string, terminated by ST
+	StringToken
+	// EscToken is any other escape sequence: ESC intermediates final
+	EscToken
+)
+
+// String returns the name of the TokenKind
+func (k TokenKind) String() string {
+	switch k {
+	case TextToken:
+		return "Text"
+	case CSIToken:
+		return "CSI"
+	case OSCToken:
+		return "OSC"
+	case StringToken:
+		return "String"
+	case EscToken:
+		return "Esc"
+	}
+	return "TokenKind(invalid)"
+}
+
+// EscapeToken is a run of visible text or one escape sequence of the source
+// of an EscapeReader. Sequences which are cut short by the end of the text
+// or an unexpected control character end where they were interrupted
+type EscapeToken struct {
+	Kind TokenKind
+	// Index is the index of the token in the source
+	Index int64
+	// Size is the length of the token in the source
+	Size int64
+	// Text is the raw text of the token
+	Text string
+}
+
+// escapeEdit records source text removed from the visible text, such as an
+// escape sequence or markup tag, or replaced with other visible text
+type escapeEdit struct {
+	src      int64 // index of the removed text in the source
+	width    int64 // width of the removed text in the source
+	visible  int64 // index of the visible text following the removed text
+	replaced int64 // width of the visible text replacing the removed text
+}
+
+// sourceIndexOf returns the source index of the visible index given, which
+// must be less than the size of the visible text. Indices within replacement
+// text map to the start of the text it replaced
+func sourceIndexOf(edits []escapeEdit, index int64) int64 {
+	// the last removal before the visible rune
+	k := sort.Search(len(edits), func(i int) bool { return edits[i].visible > index }) - 1
+	if k < 0 {
+		return index
+	} else if edit := edits[k]; index < edit.visible+edit.replaced {
+		return edit.src
+	} else {
+		return edit.src + edit.width + index - edit.visible - edit.replaced
+	}
+}
+
+// visibleIndexOf returns the visible index of the source index given.
+// Indices within removed text map to the visible text following it, or to
+// the start of its replacement
+func visibleIndexOf(edits []escapeEdit, src int64) int64 {
+	k := sort.Search(len(edits), func(i int) bool { return edits[i].src > src }) - 1
+	if k < 0 {
+		return src
+	} else if edit := edits[k]; src < edit.src+edit.width {
+		return edit.visible
+	} else {
+		return edit.visible + edit.replaced + src - edit.src - edit.width
+	}
+}
+
+// EscapeReader is a RuneReader presenting only the visible text of another
+// RuneReader, with all ANSI/VT escape sequences removed. All indices are in
+// the native units of the source reader. SourceIndex and VisibleIndex
+// convert between the visible text and the source
+//
+// The visible text is produced when the EscapeReader is created and the
+// source reader is not used after that
+type EscapeReader struct {
+	textRuneReader
+	runes  bool
+	size   int64
+	tokens []EscapeToken
+	edits  []escapeEdit
+}
+
+// NewEscapeReader returns a new EscapeReader for the text of src
+//
+// NewEscapeReader was added by go-corelibs
+func NewEscapeReader(src RuneReader) (r *EscapeReader, err error) {
+	var text string
+	r = &EscapeReader{size: src.Size()}
+	if text, r.runes, err = readText(src); err != nil {
+		return nil, err
+	}
+
+	var visible strings.Builder
+	var srcIndex, visibleIndex int64
+	for offset := 0; offset < len(text); {
+		kind, width := scanEscape(text[offset:])
+		raw := text[offset : offset+width]
+		units := int64(width)
+		if r.runes {
+			units = int64(utf8.RuneCountInString(raw))
+		}
+		if kind == TextToken {
+			visible.WriteString(raw)
+			visibleIndex += units
+		} else {
+			r.edits = append(r.edits, escapeEdit{src: srcIndex, width: units, visible: visibleIndex})
+		}
+		if n := len(r.tokens) - 1; kind == TextToken && n >= 0 && r.tokens[n].Kind == TextToken {
+			r.tokens[n].Size += units
+			r.tokens[n].Text = text[offset-len(r.tokens[n].Text) : offset+width]
+		} else {
+			r.tokens = append(r.tokens, EscapeToken{Kind: kind, Index: srcIndex, Size: units, Text: raw})
+		}
+		srcIndex += units
+		offset += width
+	}
+	r.textRuneReader = textReaderFor(visible.String(), r.runes)
+	return r, nil
+}
+
+// escape sequence introducers, as 7-bit escapes and 8-bit C1 controls
+const (
+	escCSI = '['
+	escOSC = ']'
+	c1CSI  = '\u009b'
+	c1OSC  = '\u009d'
+	c1ST   = '\u009c'
+)
+
+// isStringIntroducer returns true for the final byte of the escapes starting
+// a DCS, SOS, PM or APC control string
+func isStringIntroducer(b byte) bool {
+	return b == 'P' || b == 'X' || b == '^' || b == '_'
+}
+
+// isC1StringIntroducer returns true for the C1 controls starting a DCS, SOS,
+// PM or APC control string
+func isC1StringIntroducer(ch rune) bool {
+	return ch == '\u0090' || ch == '\u0098' || ch == '\u009e' || ch == '\u009f'
+}
+
+// scanEscape returns the kind and byte width of the token at the start of s,
+// text tokens are returned one rune at a time
+func scanEscape(s string) (kind TokenKind, width int) {
+	if s[0] == 0x1b {
+		if len(s) == 1 {
+			return EscToken, 1
+		}
+		switch b := s[1]; {
+		case b == escCSI:
+			return CSIToken, 2 + scanCSI(s[2:])
+		case b == escOSC:
+			return OSCToken, 2 + scanControlString(s[2:], true)
+		case isStringIntroducer(b):
+			return StringToken, 2 + scanControlString(s[2:], false)
+		}
+		// ESC intermediates final
+		width = 1
+		for width < len(s) && 0x20 <= s[width] && s[width] <= 0x2f {
+			width++
+		}
+		if width < len(s) && 0x30 <= s[width] && s[width] <= 0x7e {
+			width++
+		}
+		return EscToken, width
+	}
+	ch, width := utf8.DecodeRuneInString(s)
+	switch {
+	case ch == c1CSI:
+		return CSIToken, width + scanCSI(s[width:])
+	case ch == c1OSC:
+		return OSCToken, width + scanControlString(s[width:], true)
+	case isC1StringIntroducer(ch):
+		return StringToken, width + scanControlString(s[width:], false)
+	}
+	return TextToken, width
+}
+
+// scanCSI returns the width of the parameter, intermediate and final bytes
+// of a control sequence at the start of s
+func scanCSI(s string) (width int) {
+	for width < len(s) && 0x30 <= s[width] && s[width] <= 0x3f {
+		width++
+	}
+	for width < len(s) && 0x20 <= s[width] && s[width] <= 0x2f {
+		width++
+	}
+	if width < len(s) && 0x40 <= s[width] && s[width] <= 0x7e {
+		width++
+	}
+	return
+}
+
+// scanControlString returns the width of a control string at the start of s,
+// including its terminator. OSC strings may also be terminated by BEL
+func scanControlString(s string, bel bool) (width int) {
+	for width < len(s) {
+		switch b := s[width]; {
+		case b == 0x07 && bel:
+			return width + 1
+		case b == 0x1b:
+			if width+1 < len(s) && s[width+1] == '\\' {
+				return width + 2
+			}
+			// an escape interrupts the string
+			return width
+		case b == 0xc2 && strings.HasPrefix(s[width:], string(c1ST)):
+			return width + len(string(c1ST))
+		case b == 0x18 || b == 0x1a:
+			// CAN and SUB cancel the string
+			return width
+		}
+		width++
+	}
+	return
+}
+
+// Tokens returns the visible text and escape sequences of the source, in
+// order. Adjacent text is always returned as a single token
+//
+// Tokens was added by go-corelibs
+func (r *EscapeReader) Tokens() []EscapeToken {
+	return r.tokens
+}
+
+// runeIndexed returns true if the native units of the source are runes
+func (r *EscapeReader) runeIndexed() bool { return r.runes }
+
+// SourceIndex returns the index in the source of the visible index given.
+// Escape sequences preceding a visible rune are included before the source
+// index of that rune, and the end of the visible text maps to the end of the
+// source
+//
+// SourceIndex was added by go-corelibs
+func (r *EscapeReader) SourceIndex(index int64) (src int64, err error) {
+	if index < 0 || index > r.Size() {
+		return -1, errors.New("EscapeReader.SourceIndex: index out of range")
+	} else if index == r.Size() {
+		return r.size, nil
+	}
+	return sourceIndexOf(r.edits, index), nil
+}
+
+// VisibleIndex returns the visible index of the source index given. Indices
+// within an escape sequence map to the visible text following it
+//
+// VisibleIndex was added by go-corelibs
+func (r *EscapeReader) VisibleIndex(src int64) (index int64, err error) {
+	if src < 0 || src > r.size {
+		return -1, errors.New("EscapeReader.VisibleIndex: index out of range")
+	}
+	return visibleIndexOf(r.edits, src), nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes_test
+
+import (
+	"io"
+	"testing"
+
+	. "github.com/go-corelibs/runes"
+)
+
+func TestEscapeReaderVisible(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		want  string
+		kinds []TokenKind
+	}{
+		{"plain", "plain", []TokenKind{TextToken}},
+		{"\x1b[31mred\x1b[0m plain", "red plain", []TokenKind{CSIToken, TextToken, CSIToken, TextToken}},
+		{"\x1b[38;5;196;1mbold", "bold", []TokenKind{CSIToken, TextToken}},
+		{"\x1b]8;;http://example.com\x07link\x1b]8;;\x1b\\", "link", []TokenKind{OSCToken, TextToken, OSCToken}},
+		{"\x1b]0;title\x1b\\x", "x", []TokenKind{OSCToken, TextToken}},
+		{"\x1bPq#0;2;0;0;0\x1b\\x", "x", []TokenKind{StringToken, TextToken}},
+		{"\x1b(Bab\x1b7c\x1b8", "abc", []TokenKind{EscToken, TextToken, EscToken, TextToken, EscToken}},
+		{"\u009b1mA\u009b0m", "A", []TokenKind{CSIToken, TextToken, CSIToken}},
+		{"\u009d0;title\u009cB", "B", []TokenKind{OSCToken, TextToken}},
+		{"ab\x1b[3", "ab", []TokenKind{TextToken, CSIToken}},
+		{"ab\x1b", "ab", []TokenKind{TextToken, EscToken}},
+		{"\x1b]0;cut\x1b[1mc", "c", []TokenKind{OSCToken, CSIToken, TextToken}},
+		{"", "", nil},
+	} {
+		for _, src := range newCaseReaders(tc.input) {
+			r, err := NewEscapeReader(src)
+			if err != nil {
+				t.Fatalf("NewEscapeReader(%+q): %v", tc.input, err)
+			}
+			if got, _ := io.ReadAll(r); string(got) != tc.want {
+				t.Errorf("%+q: got %+q; want %+q", tc.input, got, tc.want)
+			}
+			tokens := r.Tokens()
+			if len(tokens) != len(tc.kinds) {
+				t.Errorf("%+q: got %d tokens; want %d", tc.input, len(tokens), len(tc.kinds))
+				continue
+			}
+			var raw string
+			var index int64
+			for idx, token := range tokens {
+				if token.Kind != tc.kinds[idx] {
+					t.Errorf("%+q: token %d got %v; want %v", tc.input, idx, token.Kind, tc.kinds[idx])
+				}
+				if token.Index != index {
+					t.Errorf("%+q: token %d index got %d; want %d", tc.input, idx, token.Index, index)
+				}
+				index += token.Size
+				raw += token.Text
+			}
+			if raw != tc.input || index != src.Size() {
+				t.Errorf("%+q: tokens got %+q,%d; want %d", tc.input, raw, index, src.Size())
+			}
+		}
+	}
+}
+
+func TestEscapeReaderIndex(t *testing.T) {
+	input := "\x1b[31mred\x1b[0m plain"
+	visible := []int64{5, 6, 7, 12, 13, 14, 15, 16, 17, 18}
+	source := map[int64]int64{0: 0, 2: 0, 5: 0, 6: 1, 7: 2, 8: 3, 10: 3, 11: 3, 12: 3, 13: 4, 18: 9}
+	for _, src := range newCaseReaders(input) {
+		r, err := NewEscapeReader(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for index, want := range visible {
+			if got, err := r.SourceIndex(int64(index)); got != want || err != nil {
+				t.Errorf("SourceIndex(%d): got %d,%v; want %d", index, got, err, want)
+			}
+		}
+		for index, want := range source {
+			if got, err := r.VisibleIndex(index); got != want || err != nil {
+				t.Errorf("VisibleIndex(%d): got %d,%v; want %d", index, got, err, want)
+			}
+		}
+		if _, err = r.SourceIndex(10); err == nil {
+			t.Errorf("SourceIndex(10): expected error")
+		}
+		if _, err = r.VisibleIndex(-1); err == nil {
+			t.Errorf("VisibleIndex(-1): expected error")
+		}
+		// truncating to two visible runes keeps the colour sequence whole
+		end, _ := r.SourceIndex(2)
+		if got, _ := src.ReadString(0, end); got != "\x1b[31mre" {
+			t.Errorf("truncated got %+q", got)
+		}
+	}
+}
+
+func TestEscapeReaderRunes(t *testing.T) {
+	input := "\u009b1m\u00e9\x1b[0m\u00e8"
+	r, err := NewEscapeReader(NewRunesReader([]rune(input)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for index, want := range []int64{3, 8, 9} {
+		if got, err := r.SourceIndex(int64(index)); got != want || err != nil {
+			t.Errorf("runes SourceIndex(%d): got %d,%v; want %d", index, got, err, want)
+		}
+	}
+	if tokens := r.Tokens(); len(tokens) != 4 || tokens[1].Index != 3 || tokens[2].Size != 4 {
+		t.Errorf("runes tokens got %+v", tokens)
+	}
+
+	r, err = NewEscapeReader(NewStringReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for index, want := range map[int64]int64{0: 4, 2: 10, 4: 12} {
+		if got, err := r.SourceIndex(index); got != want || err != nil {
+			t.Errorf("string SourceIndex(%d): got %d,%v; want %d", index, got, err, want)
+		}
+	}
+	if got, err := r.VisibleIndex(6); got != 2 || err != nil {
+		t.Errorf("string VisibleIndex(6): got %d,%v; want 2", got, err)
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+//go:build ignore
+
+// gen-confusable-tables generates confusable-tables.go from the UTS #39
+// confusable data in testdata/confusables.txt
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	version, prototypes, err := parseFile("testdata/confusables.txt")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	keys := make([]rune, 0, len(prototypes))
+	for ch := range prototypes {
+		keys = append(keys, ch)
+	}
+	slices.Sort(keys)
+
+	var buf bytes.Buffer
+	buf.WriteString(`// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+// Code generated by gen-confusable-tables.go; DO NOT EDIT.
+
+package runes
+
+`)
+	fmt.Fprintf(&buf, "// The following table contains the confusable prototypes of the Unicode %s\n", version)
+	buf.WriteString("// confusables.txt from UTS #39, Unicode Security Mechanisms\n\n")
+	buf.WriteString("// confusablePrototypes maps runes to the prototype they are confusable with\n")
+	buf.WriteString("var confusablePrototypes = map[rune]string{\n")
+	for _, ch := range keys {
+		fmt.Fprintf(&buf, "0x%04X: %s,\n", ch, quote(prototypes[ch]))
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	} else if err = os.WriteFile("confusable-tables.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parseFile returns the version of the data file given and the prototype of
+// each of its runes
+func parseFile(name string) (version string, prototypes map[rune][]rune, err error) {
+	var f *os.File
+	if f, err = os.Open(name); err != nil {
+		return "", nil, err
+	}
+	defer f.Close()
+
+	prototypes = make(map[rune][]rune)
+	s := bufio.NewScanner(f)
+	for line := 1; s.Scan(); line++ {
+		text := strings.TrimPrefix(s.Text(), "\uFEFF")
+		if v, ok := strings.CutPrefix(text, "# Version: "); ok {
+			version = strings.TrimSpace(v)
+		}
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+		fields := strings.Split(text, ";")
+		if len(fields) < 3 {
+			continue
+		}
+		var source []rune
+		if source, err = parseRunes(fields[0]); err != nil {
+			return "", nil, fmt.Errorf("%s:%d: %w", name, line, err)
+		} else if len(source) != 1 {
+			return "", nil, fmt.Errorf("%s:%d: source is not a single rune", name, line)
+		}
+		var prototype []rune
+		if prototype, err = parseRunes(fields[1]); err != nil {
+			return "", nil, fmt.Errorf("%s:%d: %w", name, line, err)
+		}
+		prototypes[source[0]] = prototype
+	}
+	if err = s.Err(); err == nil && version == "" {
+		err = fmt.Errorf("%s: no version found", name)
+	}
+	return
+}
+
+// parseRunes parses a list of hexadecimal code points
+func parseRunes(codes string) (seq []rune, err error) {
+	for _, code := range strings.Fields(codes) {
+		var v uint64
+		if v, err = strconv.ParseUint(code, 16, 32); err != nil {
+			return nil, err
+		}
+		seq = append(seq, rune(v))
+	}
+	return
+}
+
+// quote returns the Go string literal of the runes given, with the runes
+// outside of printable ASCII escaped
+func quote(seq []rune) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for _, ch := range seq {
+		switch {
+		case ch == '"' || ch == '\\':
+			fmt.Fprintf(&sb, `\u%04X`, ch)
+		case ch >= 0x20 && ch < 0x7F:
+			sb.WriteRune(ch)
+		case ch <= 0xFFFF:
+			fmt.Fprintf(&sb, `\u%04X`, ch)
+		default:
+			fmt.Fprintf(&sb, `\U%08X`, ch)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
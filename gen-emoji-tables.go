@@ -0,0 +1,215 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+//go:build ignore
+
+// gen-emoji-tables generates emoji-tables.go from the UTS #51 emoji sequence
+// data in testdata/emoji-sequences.txt and testdata/emoji-zwj-sequences.txt
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// emojiKinds maps the type fields of the data files to the EmojiKind names
+var emojiKinds = map[string]string{
+	"Basic_Emoji":                 "EmojiBasic",
+	"Emoji_Keycap_Sequence":       "EmojiKeycap",
+	"RGI_Emoji_Flag_Sequence":     "EmojiFlag",
+	"RGI_Emoji_Tag_Sequence":      "EmojiTag",
+	"RGI_Emoji_Modifier_Sequence": "EmojiModifier",
+	"RGI_Emoji_ZWJ_Sequence":      "EmojiZWJ",
+}
+
+type runeRange struct {
+	lo, hi rune
+}
+
+func main() {
+	var version string
+	var basic []runeRange
+	sequences := make(map[string]string)
+	for _, name := range []string{"testdata/emoji-sequences.txt", "testdata/emoji-zwj-sequences.txt"} {
+		v, err := parseFile(name, &basic, sequences)
+		if err != nil {
+			log.Fatal(err)
+		} else if version != "" && v != version {
+			log.Fatalf("%s: version %s, want %s", name, v, version)
+		}
+		version = v
+	}
+
+	// merge the adjacent single runes
+	slices.SortFunc(basic, func(a, b runeRange) int { return int(a.lo - b.lo) })
+	var merged []runeRange
+	for _, rr := range basic {
+		if n := len(merged); n > 0 && merged[n-1].hi+1 >= rr.lo {
+			merged[n-1].hi = max(merged[n-1].hi, rr.hi)
+			continue
+		}
+		merged = append(merged, rr)
+	}
+
+	keys := make([][]rune, 0, len(sequences))
+	longest := 0
+	for seq := range sequences {
+		keys = append(keys, []rune(seq))
+		longest = max(longest, len([]rune(seq)))
+	}
+	slices.SortFunc(keys, slices.Compare[[]rune])
+
+	var buf bytes.Buffer
+	buf.WriteString(`// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+// Code generated by gen-emoji-tables.go; DO NOT EDIT.
+
+package runes
+
+import (
+	"unicode"
+)
+
+`)
+	fmt.Fprintf(&buf, "// The following tables contain the RGI emoji of the Unicode Emoji %s\n", version)
+	buf.WriteString("// emoji-sequences.txt and emoji-zwj-sequences.txt from UTS #51, Unicode Emoji\n\n")
+	buf.WriteString("// emojiMaxSequence is the number of runes in the longest emoji sequence\n")
+	fmt.Fprintf(&buf, "const emojiMaxSequence = %d\n\n", longest)
+	buf.WriteString("// emojiBasic is the Basic_Emoji which are single runes, shown with emoji\n")
+	buf.WriteString("// presentation by default\n")
+	buf.WriteString("var emojiBasic = &unicode.RangeTable{\n")
+	buf.WriteString("R16: []unicode.Range16{\n")
+	latin := 0
+	for _, rr := range merged {
+		if rr.hi <= 0xFFFF {
+			fmt.Fprintf(&buf, "{Lo: 0x%04X, Hi: 0x%04X, Stride: 1},\n", rr.lo, rr.hi)
+			if rr.hi <= 0xFF {
+				latin++
+			}
+		}
+	}
+	buf.WriteString("},\n")
+	buf.WriteString("R32: []unicode.Range32{\n")
+	for _, rr := range merged {
+		if rr.hi > 0xFFFF {
+			fmt.Fprintf(&buf, "{Lo: 0x%04X, Hi: 0x%04X, Stride: 1},\n", rr.lo, rr.hi)
+		}
+	}
+	buf.WriteString("},\n")
+	fmt.Fprintf(&buf, "LatinOffset: %d,\n", latin)
+	buf.WriteString("}\n\n")
+	buf.WriteString("// emojiSequences maps the emoji sequences of more than one rune to their kind\n")
+	buf.WriteString("var emojiSequences = map[string]EmojiKind{\n")
+	for _, seq := range keys {
+		fmt.Fprintf(&buf, "%s: %s,\n", quote(seq), sequences[string(seq)])
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	} else if err = os.WriteFile("emoji-tables.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parseFile adds the single rune Basic_Emoji of the data file given to basic
+// and the sequences of more than one rune to sequences, returning the version
+// of the file
+func parseFile(name string, basic *[]runeRange, sequences map[string]string) (version string, err error) {
+	var f *os.File
+	if f, err = os.Open(name); err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	s := bufio.NewScanner(f)
+	for line := 1; s.Scan(); line++ {
+		text := s.Text()
+		if v, ok := strings.CutPrefix(text, "# Version: "); ok {
+			version = strings.TrimSpace(v)
+		}
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+		fields := strings.Split(text, ";")
+		if len(fields) < 2 {
+			continue
+		}
+		kind, ok := emojiKinds[strings.TrimSpace(fields[1])]
+		if !ok {
+			return "", fmt.Errorf("%s:%d: unknown type %q", name, line, strings.TrimSpace(fields[1]))
+		}
+		codes := strings.TrimSpace(fields[0])
+		if lo, hi, ok := strings.Cut(codes, ".."); ok {
+			var rr runeRange
+			if rr.lo, err = parseRune(lo); err == nil {
+				rr.hi, err = parseRune(hi)
+			}
+			if err != nil {
+				return "", fmt.Errorf("%s:%d: %w", name, line, err)
+			} else if kind != "EmojiBasic" {
+				return "", fmt.Errorf("%s:%d: range of %s", name, line, kind)
+			}
+			*basic = append(*basic, rr)
+			continue
+		}
+		var seq []rune
+		for _, code := range strings.Fields(codes) {
+			var ch rune
+			if ch, err = parseRune(code); err != nil {
+				return "", fmt.Errorf("%s:%d: %w", name, line, err)
+			}
+			seq = append(seq, ch)
+		}
+		if len(seq) == 1 && kind == "EmojiBasic" {
+			*basic = append(*basic, runeRange{seq[0], seq[0]})
+		} else if len(seq) > 1 {
+			sequences[string(seq)] = kind
+		}
+	}
+	if err = s.Err(); err == nil && version == "" {
+		err = fmt.Errorf("%s: no version found", name)
+	}
+	return
+}
+
+// parseRune parses a hexadecimal code point
+func parseRune(code string) (ch rune, err error) {
+	var v uint64
+	if v, err = strconv.ParseUint(code, 16, 32); err != nil {
+		return 0, err
+	}
+	return rune(v), nil
+}
+
+// quote returns the Go string literal of the runes given, with the runes
+// outside of printable ASCII escaped
+func quote(seq []rune) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for _, ch := range seq {
+		switch {
+		case ch == '"' || ch == '\\':
+			fmt.Fprintf(&sb, `\u%04X`, ch)
+		case ch >= 0x20 && ch < 0x7F:
+			sb.WriteRune(ch)
+		case ch <= 0xFFFF:
+			fmt.Fprintf(&sb, `\u%04X`, ch)
+		default:
+			fmt.Fprintf(&sb, `\U%08X`, ch)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
@@ -2,4 +2,9 @@ module github.com/go-corelibs/runes
 
 go 1.22.4
 
-require github.com/go-corelibs/x-sync v0.1.0
+require (
+	github.com/clipperhouse/displaywidth v0.11.0
+	github.com/clipperhouse/uax29/v2 v2.7.0
+	github.com/go-text/typesetting v0.3.5
+	golang.org/x/text v0.22.0
+)
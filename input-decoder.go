@@ -0,0 +1,498 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// DefaultEscapeTimeout is the time an InputDecoder waits for the rest of an
+// escape sequence before deciding the escape key was pressed on its own
+const DefaultEscapeTimeout = 50 * time.Millisecond
+
+// InputKind identifies the kind of an InputEvent
+type InputKind uint8
+
+const (
+	// RuneEvent is a printable rune, or a control character typed with the
+	// control key
+	RuneEvent InputKind = iota
+	// KeyEvent is a special key, such as the arrow and function keys
+	KeyEvent
+	// MouseEvent is a mouse report, in the X10 or SGR (1006) encoding
+	MouseEvent
+	// PasteEvent is the text of a bracketed paste
+	PasteEvent
+)
+
+// Key identifies the special key of a KeyEvent
+type Key uint8
+
+const (
+	// KeyUnknown is an escape sequence which was not recognised, the raw
+	// sequence is given as the InputEvent Text
+	KeyUnknown Key = iota
+	KeyEscape
+	KeyEnter
+	KeyTab
+	KeyBackspace
+	KeyUp
+	KeyDown
+	KeyRight
+	KeyLeft
+	KeyHome
+	KeyEnd
+	KeyInsert
+	KeyDelete
+	KeyPageUp
+	KeyPageDown
+	KeyF1
+	KeyF2
+	KeyF3
+	KeyF4
+	KeyF5
+	KeyF6
+	KeyF7
+	KeyF8
+	KeyF9
+	KeyF10
+	KeyF11
+	KeyF12
+)
+
+var keyNames = []string{
+	"Unknown", "Escape", "Enter", "Tab", "Backspace",
+	"Up", "Down", "Right", "Left", "Home", "End",
+	"Insert", "Delete", "PageUp", "PageDown",
+	"F1", "F2", "F3", "F4", "F5", "F6", "F7", "F8", "F9", "F10", "F11", "F12",
+}
+
+// String returns the name of the Key
+func (k Key) String() string {
+	if int(k) < len(keyNames) {
+		return keyNames[k]
+	}
+	return "Key(" + strconv.Itoa(int(k)) + ")"
+}
+
+// Modifier is a set of modifier keys, the values match the xterm encoding of
+// modifiers in escape sequences, less one
+type Modifier uint8
+
+const (
+	ModShift Modifier = 1 << iota
+	ModAlt
+	ModCtrl
+	ModMeta
+)
+
+// MouseButton identifies the button of a MouseEvent
+type MouseButton uint8
+
+const (
+	MouseLeft MouseButton = iota
+	MouseMiddle
+	MouseRight
+	// MouseNone is reported for motion without a button held, and for the
+	// release of any button in the X10 encoding
+	MouseNone
+	MouseWheelUp
+	MouseWheelDown
+	MouseWheelLeft
+	MouseWheelRight
+)
+
+// MouseAction identifies the action of a MouseEvent
+type MouseAction uint8
+
+const (
+	MousePress MouseAction = iota
+	MouseRelease
+	MouseMotion
+)
+
+// InputEvent is one event decoded by an InputDecoder
+type InputEvent struct {
+	Kind InputKind
+	// Rune is the rune of a RuneEvent, control characters are given as the
+	// letter or symbol typed with the control key, such as 'c' for ETX
+	Rune rune
+	// Key is the key of a KeyEvent
+	Key Key
+	// Mod is the set of modifiers held during a RuneEvent, KeyEvent or
+	// MouseEvent
+	Mod Modifier
+	// Button is the button of a MouseEvent
+	Button MouseButton
+	// Action is the action of a MouseEvent
+	Action MouseAction
+	// X and Y are the zero-based column and row of a MouseEvent
+	X, Y int
+	// Text is the text of a PasteEvent, or the raw sequence of a KeyUnknown
+	// KeyEvent
+	Text string
+}
+
+var (
+	pasteStart = []byte("\x1b[200~")
+	pasteEnd   = []byte("\x1b[201~")
+)
+
+// InputDecoder decodes the raw bytes read from a terminal into runes, keys,
+// mouse reports and bracketed pastes. Input is given to the decoder in
+// chunks with Feed and may be split anywhere, including within a UTF-8
+// encoding or an escape sequence
+//
+// A lone escape byte is ambiguous, it may be the escape key or the start of
+// a sequence whose remainder has yet to arrive. Next waits for more input in
+// this case, and Flush resolves the ambiguity once the escape timeout has
+// passed without more input. Decode does this automatically
+//
+// An InputDecoder is not safe for concurrent use
+type InputDecoder struct {
+	timeout time.Duration
+	buf     []byte
+	pos     int
+	br      BytesReader
+	err     error
+}
+
+// NewInputDecoder returns a new InputDecoder using the escape timeout given,
+// or DefaultEscapeTimeout when the timeout is not positive
+//
+// NewInputDecoder was added by go-corelibs
+func NewInputDecoder(timeout time.Duration) *InputDecoder {
+	if timeout <= 0 {
+		timeout = DefaultEscapeTimeout
+	}
+	return &InputDecoder{timeout: timeout}
+}
+
+// Feed appends a chunk of input to the decoder
+//
+// Feed was added by go-corelibs
+func (d *InputDecoder) Feed(chunk []byte) {
+	d.buf = append(d.buf[:0], d.buf[d.pos:]...)
+	d.buf = append(d.buf, chunk...)
+	d.pos = 0
+	d.br.Reset(d.buf)
+}
+
+// Pending returns true if there is buffered input which Next could not
+// decode without more input
+//
+// Pending was added by go-corelibs
+func (d *InputDecoder) Pending() bool {
+	return d.pos < len(d.buf)
+}
+
+// Next returns the next complete event of the buffered input, returning
+// false when more input is needed
+//
+// Next was added by go-corelibs
+func (d *InputDecoder) Next() (ev InputEvent, ok bool) {
+	if d.pos < len(d.buf) {
+		var width int
+		if ev, width, ok = d.decode(false, false); ok {
+			d.pos += width
+		}
+	}
+	return
+}
+
+// Flush decodes all of the buffered input, treating any incomplete escape
+// sequence as the escape key followed by ordinary input and an incomplete
+// UTF-8 encoding as utf8.RuneError. An unterminated bracketed paste remains
+// buffered, unless eof is true and no more input will follow
+//
+// Flush was added by go-corelibs
+func (d *InputDecoder) Flush(eof bool) (events []InputEvent) {
+	for d.pos < len(d.buf) {
+		ev, width, ok := d.decode(true, eof)
+		if !ok {
+			break
+		}
+		d.pos += width
+		events = append(events, ev)
+	}
+	return
+}
+
+// Decode reads input from r until it returns an error, sending the decoded
+// events on the channel returned. The escape timeout begins whenever a read
+// leaves ambiguous input buffered. The channel is closed after the last
+// event and must be drained, Err then returns the error which ended reading,
+// if it was not io.EOF
+//
+// Decode was added by go-corelibs
+func (d *InputDecoder) Decode(r io.Reader) <-chan InputEvent {
+	chunks := make(chan []byte)
+	events := make(chan InputEvent)
+	go func() {
+		defer close(chunks)
+		for {
+			buf := make([]byte, 256)
+			n, err := r.Read(buf)
+			if n > 0 {
+				chunks <- buf[:n]
+			}
+			if err != nil {
+				if err != io.EOF {
+					d.err = err
+				}
+				return
+			}
+		}
+	}()
+	go func() {
+		defer close(events)
+		var timeout <-chan time.Time
+		for {
+			select {
+			case chunk, ok := <-chunks:
+				if !ok {
+					for _, ev := range d.Flush(true) {
+						events <- ev
+					}
+					return
+				}
+				d.Feed(chunk)
+				for ev, ok := d.Next(); ok; ev, ok = d.Next() {
+					events <- ev
+				}
+				timeout = nil
+				if d.Pending() {
+					timeout = time.After(d.timeout)
+				}
+			case <-timeout:
+				timeout = nil
+				for _, ev := range d.Flush(false) {
+					events <- ev
+				}
+			}
+		}
+	}()
+	return events
+}
+
+// Err returns the error which ended Decode, once its channel is closed
+//
+// Err was added by go-corelibs
+func (d *InputDecoder) Err() error { return d.err }
+
+// decode returns the first event of the buffered input and its width. When
+// final is true, incomplete input is decoded as it is, otherwise false is
+// returned until more input arrives
+func (d *InputDecoder) decode(final, eof bool) (ev InputEvent, width int, ok bool) {
+	buf := d.buf[d.pos:]
+	if buf[0] != 0x1b {
+		return d.decodeRune(0, final)
+	} else if len(buf) == 1 {
+		return InputEvent{Kind: KeyEvent, Key: KeyEscape}, 1, final
+	}
+
+	switch buf[1] {
+	case '[':
+		if bytes.HasPrefix(buf, pasteStart) {
+			return decodePaste(buf, eof)
+		} else if ev, width, ok = decodeCSI(buf); ok || !final {
+			return
+		}
+	case 'O':
+		if len(buf) >= 3 {
+			return decodeSS3(buf), 3, true
+		} else if !final {
+			return
+		}
+	case 0x1b:
+		// a second escape cannot begin an Alt sequence
+	default:
+		if ev, width, ok = d.decodeRune(1, final); ok {
+			ev.Mod |= ModAlt
+			return ev, width + 1, true
+		}
+		return
+	}
+
+	// an incomplete sequence
+	if len(buf) == 2 && buf[1] != 0x1b {
+		ev, width, ok = d.decodeRune(1, final)
+		ev.Mod |= ModAlt
+		return ev, width + 1, ok
+	}
+	return InputEvent{Kind: KeyEvent, Key: KeyEscape}, 1, true
+}
+
+// decodeRune decodes the rune at the offset given from the start of the
+// buffered input
+func (d *InputDecoder) decodeRune(offset int, final bool) (ev InputEvent, width int, ok bool) {
+	if !final && !utf8.FullRune(d.buf[d.pos+offset:]) {
+		return
+	}
+	ch, size, _ := d.br.ReadRuneAt(int64(d.pos + offset))
+	return controlEvent(ch), size, true
+}
+
+// controlEvent returns the event for a rune, translating control characters
+// to their keys or the rune typed with the control key
+func controlEvent(ch rune) InputEvent {
+	switch {
+	case ch == '\r':
+		return InputEvent{Kind: KeyEvent, Key: KeyEnter}
+	case ch == '\t':
+		return InputEvent{Kind: KeyEvent, Key: KeyTab}
+	case ch == 0x7f || ch == 0x08:
+		return InputEvent{Kind: KeyEvent, Key: KeyBackspace}
+	case ch == 0x1b:
+		return InputEvent{Kind: KeyEvent, Key: KeyEscape}
+	case ch == 0:
+		return InputEvent{Kind: RuneEvent, Rune: ' ', Mod: ModCtrl}
+	case ch < 0x1b:
+		return InputEvent{Kind: RuneEvent, Rune: 'a' + ch - 1, Mod: ModCtrl}
+	case ch < 0x20:
+		return InputEvent{Kind: RuneEvent, Rune: ch + 0x40, Mod: ModCtrl}
+	}
+	return InputEvent{Kind: RuneEvent, Rune: ch}
+}
+
+// decodePaste decodes a bracketed paste, which is incomplete until the end
+// marker arrives or there is no more input
+func decodePaste(buf []byte, eof bool) (ev InputEvent, width int, ok bool) {
+	text := buf[len(pasteStart):]
+	if end := bytes.Index(text, pasteEnd); end >= 0 {
+		text, width = text[:end], len(pasteStart)+end+len(pasteEnd)
+	} else if eof {
+		width = len(buf)
+	} else {
+		return
+	}
+	return InputEvent{Kind: PasteEvent, Text: string(text)}, width, true
+}
+
+// decodeSS3 decodes the three byte SS3 sequences sent by the cursor and
+// function keys in application mode
+func decodeSS3(buf []byte) InputEvent {
+	if key, ok := finalKey(buf[2]); ok {
+		return InputEvent{Kind: KeyEvent, Key: key}
+	} else if buf[2] == 'M' {
+		return InputEvent{Kind: KeyEvent, Key: KeyEnter}
+	}
+	return InputEvent{Kind: KeyEvent, Key: KeyUnknown, Text: string(buf[:3])}
+}
+
+// finalKey returns the key identified by the final byte of a CSI or SS3
+// sequence
+func finalKey(final byte) (key Key, ok bool) {
+	switch final {
+	case 'A':
+		return KeyUp, true
+	case 'B':
+		return KeyDown, true
+	case 'C':
+		return KeyRight, true
+	case 'D':
+		return KeyLeft, true
+	case 'H':
+		return KeyHome, true
+	case 'F':
+		return KeyEnd, true
+	case 'P', 'Q', 'R', 'S':
+		return KeyF1 + Key(final-'P'), true
+	}
+	return KeyUnknown, false
+}
+
+// tildeKeys are the keys of the CSI number ~ sequences
+var tildeKeys = map[int]Key{
+	1: KeyHome, 2: KeyInsert, 3: KeyDelete, 4: KeyEnd, 5: KeyPageUp, 6: KeyPageDown,
+	7: KeyHome, 8: KeyEnd, 11: KeyF1, 12: KeyF2, 13: KeyF3, 14: KeyF4, 15: KeyF5,
+	17: KeyF6, 18: KeyF7, 19: KeyF8, 20: KeyF9, 21: KeyF10, 23: KeyF11, 24: KeyF12,
+}
+
+// decodeCSI decodes a control sequence, returning false if it is incomplete
+func decodeCSI(buf []byte) (ev InputEvent, width int, ok bool) {
+	if len(buf) >= 3 && buf[2] == 'M' {
+		// X10 mouse report: CSI M Cb Cx Cy
+		if len(buf) < 6 {
+			return
+		}
+		return decodeMouse(int(buf[3])-32, int(buf[4])-32, int(buf[5])-32, false), 6, true
+	}
+
+	width = scanCSI(string(buf[2:])) + 2
+	final := buf[width-1]
+	if width == 2 || final < 0x40 {
+		if width == len(buf) {
+			return ev, 0, false
+		}
+		// a malformed sequence ends at the unexpected byte
+		return InputEvent{Kind: KeyEvent, Key: KeyUnknown, Text: string(buf[:width])}, width, true
+	}
+
+	params := strings.Split(string(buf[2:width-1]), ";")
+	param := func(i, def int) int {
+		if i < len(params) {
+			if n, err := strconv.Atoi(params[i]); err == nil {
+				return n
+			}
+		}
+		return def
+	}
+	var mod Modifier
+	if m := param(1, 1); m > 1 {
+		mod = Modifier(m - 1)
+	}
+
+	if strings.HasPrefix(params[0], "<") && (final == 'M' || final == 'm') && len(params) == 3 {
+		// SGR mouse report: CSI < Cb ; Cx ; Cy M or m
+		if b, err := strconv.Atoi(params[0][1:]); err == nil {
+			return decodeMouse(b, param(1, 1), param(2, 1), final == 'm'), width, true
+		}
+	} else if key, ok := finalKey(final); ok {
+		return InputEvent{Kind: KeyEvent, Key: key, Mod: mod}, width, true
+	} else if key, ok = tildeKeys[param(0, 0)]; ok && final == '~' {
+		return InputEvent{Kind: KeyEvent, Key: key, Mod: mod}, width, true
+	} else if final == 'Z' {
+		return InputEvent{Kind: KeyEvent, Key: KeyTab, Mod: ModShift}, width, true
+	} else if final == 'u' && param(0, -1) >= 0 {
+		// fixterms and kitty keyboard protocol: CSI code ; modifiers u
+		ev = controlEvent(rune(param(0, 0)))
+		ev.Mod |= mod
+		return ev, width, true
+	}
+	return InputEvent{Kind: KeyEvent, Key: KeyUnknown, Text: string(buf[:width])}, width, true
+}
+
+// decodeMouse returns the MouseEvent for the button code and one-based
+// position of a mouse report
+func decodeMouse(b, x, y int, release bool) (ev InputEvent) {
+	ev = InputEvent{Kind: MouseEvent, X: x - 1, Y: y - 1}
+	if b&4 != 0 {
+		ev.Mod |= ModShift
+	}
+	if b&8 != 0 {
+		ev.Mod |= ModAlt
+	}
+	if b&16 != 0 {
+		ev.Mod |= ModCtrl
+	}
+	if b&64 != 0 {
+		ev.Button = MouseWheelUp + MouseButton(b&3)
+	} else {
+		ev.Button = MouseButton(b & 3)
+	}
+	switch {
+	case b&32 != 0:
+		ev.Action = MouseMotion
+	case release || ev.Button == MouseNone:
+		ev.Action = MouseRelease
+	}
+	return
+}
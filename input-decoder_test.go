@@ -0,0 +1,157 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes_test
+
+import (
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	. "github.com/go-corelibs/runes"
+)
+
+func runeEvent(ch rune, mod Modifier) InputEvent {
+	return InputEvent{Kind: RuneEvent, Rune: ch, Mod: mod}
+}
+
+func keyEvent(key Key, mod Modifier) InputEvent {
+	return InputEvent{Kind: KeyEvent, Key: key, Mod: mod}
+}
+
+func mouseEvent(button MouseButton, action MouseAction, x, y int, mod Modifier) InputEvent {
+	return InputEvent{Kind: MouseEvent, Button: button, Action: action, X: x, Y: y, Mod: mod}
+}
+
+// decodeAll feeds the chunks to a new InputDecoder, collecting the events
+// decoded by Next and then by Flush
+func decodeAll(chunks ...string) (events []InputEvent) {
+	d := NewInputDecoder(0)
+	for _, chunk := range chunks {
+		d.Feed([]byte(chunk))
+		for ev, ok := d.Next(); ok; ev, ok = d.Next() {
+			events = append(events, ev)
+		}
+	}
+	return append(events, d.Flush(true)...)
+}
+
+//gocyclo:ignore
+func TestInputDecoder(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		want  []InputEvent
+	}{
+		{"ab", []InputEvent{runeEvent('a', 0), runeEvent('b', 0)}},
+		{"\u00e9\u4e16\U0001f600", []InputEvent{runeEvent('\u00e9', 0), runeEvent('\u4e16', 0), runeEvent('\U0001f600', 0)}},
+		{"\r\t\x7f\x08", []InputEvent{keyEvent(KeyEnter, 0), keyEvent(KeyTab, 0), keyEvent(KeyBackspace, 0), keyEvent(KeyBackspace, 0)}},
+		{"\x03\x00\x1c", []InputEvent{runeEvent('c', ModCtrl), runeEvent(' ', ModCtrl), runeEvent('\\', ModCtrl)}},
+		{"\x1b", []InputEvent{keyEvent(KeyEscape, 0)}},
+		{"\x1b\x1b", []InputEvent{keyEvent(KeyEscape, 0), keyEvent(KeyEscape, 0)}},
+		{"\x1bx\x1b\u00e9\x1b\x7f", []InputEvent{runeEvent('x', ModAlt), runeEvent('\u00e9', ModAlt), keyEvent(KeyBackspace, ModAlt)}},
+		{"\x1b[A\x1b[B\x1b[C\x1b[D", []InputEvent{keyEvent(KeyUp, 0), keyEvent(KeyDown, 0), keyEvent(KeyRight, 0), keyEvent(KeyLeft, 0)}},
+		{"\x1bOA\x1bOH\x1bOP\x1bOS", []InputEvent{keyEvent(KeyUp, 0), keyEvent(KeyHome, 0), keyEvent(KeyF1, 0), keyEvent(KeyF4, 0)}},
+		{"\x1b[1;5C\x1b[1;2H\x1b[1;3P", []InputEvent{keyEvent(KeyRight, ModCtrl), keyEvent(KeyHome, ModShift), keyEvent(KeyF1, ModAlt)}},
+		{"\x1b[3~\x1b[5;5~\x1b[24~\x1b[15~", []InputEvent{keyEvent(KeyDelete, 0), keyEvent(KeyPageUp, ModCtrl), keyEvent(KeyF12, 0), keyEvent(KeyF5, 0)}},
+		{"\x1b[Z", []InputEvent{keyEvent(KeyTab, ModShift)}},
+		{"\x1b[97;5u\x1b[13u", []InputEvent{runeEvent('a', ModCtrl), keyEvent(KeyEnter, 0)}},
+		{"\x1b[?1;2c", []InputEvent{{Kind: KeyEvent, Key: KeyUnknown, Text: "\x1b[?1;2c"}}},
+		{"\x1b[<0;10;5M\x1b[<0;10;5m", []InputEvent{mouseEvent(MouseLeft, MousePress, 9, 4, 0), mouseEvent(MouseLeft, MouseRelease, 9, 4, 0)}},
+		{"\x1b[<66;1;1M\x1b[<65;3;4M", []InputEvent{mouseEvent(MouseWheelLeft, MousePress, 0, 0, 0), mouseEvent(MouseWheelDown, MousePress, 2, 3, 0)}},
+		{"\x1b[<34;7;8M\x1b[<35;7;8M", []InputEvent{mouseEvent(MouseRight, MouseMotion, 6, 7, 0), mouseEvent(MouseNone, MouseMotion, 6, 7, 0)}},
+		{"\x1b[<16;2;2M", []InputEvent{mouseEvent(MouseLeft, MousePress, 1, 1, ModCtrl)}},
+		{"\x1b[M !!\x1b[M#!!", []InputEvent{mouseEvent(MouseLeft, MousePress, 0, 0, 0), mouseEvent(MouseNone, MouseRelease, 0, 0, 0)}},
+		{"\x1b[200~a\x1b[Ab\x1b[201~c", []InputEvent{{Kind: PasteEvent, Text: "a\x1b[Ab"}, runeEvent('c', 0)}},
+		{"\x1b[200~cut", []InputEvent{{Kind: PasteEvent, Text: "cut"}}},
+		{"\x1b[", []InputEvent{runeEvent('[', ModAlt)}},
+		{"\x1b[1;5", []InputEvent{keyEvent(KeyEscape, 0), runeEvent('[', 0), runeEvent('1', 0), runeEvent(';', 0), runeEvent('5', 0)}},
+		{"\xff\xe4\xb8", []InputEvent{runeEvent(0xfffd, 0), runeEvent(0xfffd, 0), runeEvent(0xfffd, 0)}},
+	} {
+		if got := decodeAll(tc.input); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%+q: got %+v; want %+v", tc.input, got, tc.want)
+		}
+	}
+}
+
+func TestInputDecoderSplit(t *testing.T) {
+	input := "a\u00e9\x1b[1;5A\x1bOQ\x1b[<0;10;5M\x1b[200~p\u4e16\x1b[201~\x1bx\x1b[6~\U0001f600"
+	want := decodeAll(input)
+	if len(want) != 9 {
+		t.Fatalf("got %d events; want 9: %+v", len(want), want)
+	}
+	for split := 1; split < len(input); split++ {
+		if got := decodeAll(input[:split], input[split:]); !reflect.DeepEqual(got, want) {
+			t.Errorf("split at %d: got %+v; want %+v", split, got, want)
+		}
+	}
+	var chunks []string
+	for idx := 0; idx < len(input); idx++ {
+		chunks = append(chunks, input[idx:idx+1])
+	}
+	if got := decodeAll(chunks...); !reflect.DeepEqual(got, want) {
+		t.Errorf("bytewise: got %+v; want %+v", got, want)
+	}
+}
+
+func TestInputDecoderPending(t *testing.T) {
+	d := NewInputDecoder(0)
+	d.Feed([]byte("\x1b"))
+	if _, ok := d.Next(); ok || !d.Pending() {
+		t.Errorf("lone escape: expected pending input")
+	}
+	d.Feed([]byte("[200~partial"))
+	if events := d.Flush(false); len(events) != 0 || !d.Pending() {
+		t.Errorf("unterminated paste: got %+v", events)
+	}
+	d.Feed([]byte("\x1b[201~"))
+	if ev, ok := d.Next(); !ok || ev.Kind != PasteEvent || ev.Text != "partial" {
+		t.Errorf("paste: got %+v,%v", ev, ok)
+	}
+	if d.Pending() {
+		t.Errorf("expected no pending input")
+	}
+}
+
+func TestInputDecoderDecode(t *testing.T) {
+	pr, pw := io.Pipe()
+	d := NewInputDecoder(200 * time.Millisecond)
+	events := d.Decode(pr)
+
+	next := func() InputEvent {
+		select {
+		case ev := <-events:
+			return ev
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for an event")
+		}
+		return InputEvent{}
+	}
+
+	// a lone escape is resolved by the timeout
+	_, _ = pw.Write([]byte("\x1b"))
+	if ev := next(); ev != keyEvent(KeyEscape, 0) {
+		t.Errorf("escape: got %+v", ev)
+	}
+	_, _ = pw.Write([]byte("\x1b["))
+	_, _ = pw.Write([]byte("A\u00e9"[:2]))
+	_, _ = pw.Write([]byte("A\u00e9"[2:]))
+	if ev := next(); ev != keyEvent(KeyUp, 0) {
+		t.Errorf("split up: got %+v", ev)
+	}
+	if ev := next(); ev != runeEvent('\u00e9', 0) {
+		t.Errorf("split rune: got %+v", ev)
+	}
+	_, _ = pw.Write([]byte("\x1b"))
+	_ = pw.Close()
+	if ev := next(); ev != keyEvent(KeyEscape, 0) {
+		t.Errorf("escape at eof: got %+v", ev)
+	}
+	if _, ok := <-events; ok {
+		t.Errorf("expected the channel to be closed")
+	}
+	if err := d.Err(); err != nil {
+		t.Errorf("Err: %v", err)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	uax14 "github.com/go-text/typesetting/segmenter"
+)
+
+// LineBreaker iterates over the UAX #14 line-break opportunities of a
+// RuneReader. Each break is the index before which a line may end, or must
+// end when the break is mandatory. The end of the text is always a mandatory
+// break, and the start of the text is never a break
+//
+// The text is processed one paragraph at a time, the reader is used to read
+// each paragraph as it is reached and its position is unspecified while
+// iterating
+type LineBreaker struct {
+	r         RuneReader
+	runes     bool
+	size      int64
+	seg       uax14.Segmenter
+	it        *uax14.LineIterator
+	base      int64   // native index of the paragraph
+	next      int64   // native index of the next paragraph
+	offsets   []int64 // native index of each rune of the paragraph, plus the end
+	index     int64
+	mandatory bool
+	err       error
+}
+
+// NewLineBreaker returns a new LineBreaker for the reader given
+//
+// NewLineBreaker was added by go-corelibs
+func NewLineBreaker(r RuneReader) *LineBreaker {
+	lb := &LineBreaker{r: r, size: r.Size()}
+	if ri, ok := r.(runeIndexer); ok {
+		lb.runes = ri.runeIndexed()
+	}
+	return lb
+}
+
+// load prepares the next paragraph, returning false at the end of the text
+// or when an error occurs
+func (lb *LineBreaker) load() bool {
+	if lb.next >= lb.size {
+		return false
+	}
+	var end int64
+	if end, lb.err = segmentEnd(lb.r, lb.next); lb.err != nil {
+		return false
+	}
+	var text string
+	if text, lb.err = lb.r.ReadString(lb.next, end-lb.next); lb.err != nil {
+		return false
+	}
+	lb.base = lb.next
+	lb.next = end
+	lb.offsets = lb.offsets[:0]
+	paragraph := make([]rune, 0, len(text))
+	for idx, ch := range text {
+		if lb.runes {
+			lb.offsets = append(lb.offsets, lb.base+int64(len(paragraph)))
+		} else {
+			lb.offsets = append(lb.offsets, lb.base+int64(idx))
+		}
+		paragraph = append(paragraph, ch)
+	}
+	lb.offsets = append(lb.offsets, end)
+	lb.seg.Init(paragraph)
+	lb.it = lb.seg.LineIterator()
+	return true
+}
+
+// Next advances to the next line-break opportunity, returning false when
+// there are no more or an error occurred
+//
+// Next was added by go-corelibs
+func (lb *LineBreaker) Next() bool {
+	if lb.err != nil {
+		return false
+	}
+	for lb.it == nil || !lb.it.Next() {
+		if !lb.load() {
+			return false
+		}
+	}
+	line := lb.it.Line()
+	lb.index = lb.offsets[line.Offset+len(line.Text)]
+	lb.mandatory = line.IsMandatoryBreak || lb.index == lb.size
+	return true
+}
+
+// Index returns the index of the current line-break opportunity
+//
+// Index was added by go-corelibs
+func (lb *LineBreaker) Index() int64 { return lb.index }
+
+// Mandatory returns true if the current line-break opportunity is a
+// mandatory break, following a line terminator or at the end of the text
+//
+// Mandatory was added by go-corelibs
+func (lb *LineBreaker) Mandatory() bool { return lb.mandatory }
+
+// Err returns the first error encountered while iterating
+//
+// Err was added by go-corelibs
+func (lb *LineBreaker) Err() error { return lb.err }
@@ -0,0 +1,77 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes_test
+
+import (
+	"bufio"
+	"os"
+	"reflect"
+	"testing"
+	"unicode/utf8"
+
+	. "github.com/go-corelibs/runes"
+)
+
+func collectLineBreaks(r RuneReader) (breaks []int64, mandatory []bool, err error) {
+	lb := NewLineBreaker(r)
+	for lb.Next() {
+		breaks = append(breaks, lb.Index())
+		mandatory = append(mandatory, lb.Mandatory())
+	}
+	return breaks, mandatory, lb.Err()
+}
+
+func TestLineBreakConformance(t *testing.T) {
+	fh, err := os.Open("testdata/LineBreakTest.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fh.Close()
+
+	var count int
+	s := bufio.NewScanner(fh)
+	for line := 1; s.Scan(); line++ {
+		text, breaks, ok := parseBreakTest(t, s.Text())
+		if !ok {
+			continue
+		}
+		count++
+		for _, r := range newCaseReaders(text) {
+			want := make([]int64, len(breaks))
+			for i, b := range breaks {
+				want[i] = int64(b)
+				if _, ok := r.(*Reader); ok {
+					want[i] = int64(utf8.RuneCountInString(text[:b]))
+				}
+			}
+			if got, mandatory, err := collectLineBreaks(r); !reflect.DeepEqual(got, want) || err != nil {
+				t.Errorf("line %d: %T LineBreaker(%+q) got %v,%v; want %v", line, r, text, got, err, want)
+			} else if !mandatory[len(mandatory)-1] {
+				t.Errorf("line %d: %T LineBreaker(%+q) end of text is not mandatory", line, r, text)
+			}
+		}
+	}
+	if err = s.Err(); err != nil {
+		t.Fatal(err)
+	} else if count < 10000 {
+		t.Errorf("only %d tests found", count)
+	}
+}
+
+func TestLineBreaker(t *testing.T) {
+	const text = "one two-three\r\nfour\u00a0five\u2029six"
+	breaks, mandatory, err := collectLineBreaks(NewStringReader(text))
+	if err != nil {
+		t.Fatalf("LineBreaker: unexpected error: %v", err)
+	}
+	wantBreaks := []int64{4, 8, 15, 28, 31}
+	wantMandatory := []bool{false, false, true, true, true}
+	if !reflect.DeepEqual(breaks, wantBreaks) || !reflect.DeepEqual(mandatory, wantMandatory) {
+		t.Errorf("LineBreaker got %v,%v; want %v,%v", breaks, mandatory, wantBreaks, wantMandatory)
+	}
+	if breaks, _, err = collectLineBreaks(NewBytesReader(nil)); len(breaks) != 0 || err != nil {
+		t.Errorf("LineBreaker of empty text got %v,%v", breaks, err)
+	}
+}
@@ -0,0 +1,649 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// lineEdit records a terminator in the source which is replaced with a single
+// line feed in the normalized view
+type lineEdit struct {
+	norm  int64 // index of the line feed in the normalized view
+	src   int64 // index of the terminator in the source
+	width int64 // width of the terminator in the source
+}
+
+// LineEndingReader is a RuneReader which presents the text of another
+// RuneReader with line endings normalized to a single line feed. All indices
+// are in the native units of the source reader, as they are in the normalized
+// view. SourceIndex and NormalizedIndex convert between the two
+//
+// The source is scanned once, lazily, to find the terminators. The
+// normalized content itself is never stored, it is transformed as it is read.
+// The LineEndingReader takes over the position of the source reader, which
+// must not be used directly while wrapped
+type LineEndingReader struct {
+	src      RuneReader
+	terms    LineTerminator
+	runes    bool // the source is indexed in runes rather than bytes
+	built    bool
+	err      error
+	edits    []lineEdit
+	size     int64
+	i        int64
+	prevRune int
+}
+
+// NewLineEndingReader returns a new LineEndingReader over the source given,
+// normalizing the terminators given. LF is always left as-is. If terms is
+// zero, CRLF, CR and NEL are normalized
+//
+// NewLineEndingReader was added by go-corelibs
+func NewLineEndingReader(src RuneReader, terms LineTerminator) *LineEndingReader {
+	if terms == 0 {
+		terms = CRLF | CR | NEL
+	}
+	r := &LineEndingReader{src: src, terms: terms, prevRune: -1}
+	if ri, ok := src.(runeIndexer); ok {
+		r.runes = ri.runeIndexed()
+	}
+	return r
+}
+
+// newError returns a new error with the type name prefixed to msg
+func (r *LineEndingReader) newError(msg string) error {
+	return errors.New("LineEndingReader" + msg)
+}
+
+// build scans the source once, recording each terminator to be normalized
+func (r *LineEndingReader) build() error {
+	if r.built {
+		return r.err
+	}
+	r.built = true
+	size := r.src.Size()
+	var removed int64
+	for index := int64(0); index < size; {
+		ch, width, err := r.src.ReadRuneAt(index)
+		if err != nil {
+			r.err = err
+			return err
+		}
+		w := int64(width)
+		replace := false
+		switch ch {
+		case '\r':
+			if r.terms&CRLF != 0 && index+w < size {
+				after, aw, err := r.src.ReadRuneAt(index + w)
+				if err != nil {
+					r.err = err
+					return err
+				} else if after == '\n' {
+					w += int64(aw)
+					replace = true
+					break
+				}
+			}
+			replace = r.terms&CR != 0
+		case '\u0085':
+			replace = r.terms&NEL != 0
+		case '\u2028':
+			replace = r.terms&LS != 0
+		}
+		if replace {
+			r.edits = append(r.edits, lineEdit{norm: index - removed, src: index, width: w})
+			removed += w - 1
+		}
+		index += w
+	}
+	r.size = size - removed
+	return nil
+}
+
+// editAt returns the edit at the normalized index given, if there is one
+func (r *LineEndingReader) editAt(index int64) (edit lineEdit, ok bool) {
+	k := sort.Search(len(r.edits), func(i int) bool { return r.edits[i].norm >= index })
+	if k < len(r.edits) && r.edits[k].norm == index {
+		return r.edits[k], true
+	}
+	return
+}
+
+// sourceIndex is SourceIndex without the range checks
+func (r *LineEndingReader) sourceIndex(index int64) int64 {
+	k := sort.Search(len(r.edits), func(i int) bool { return r.edits[i].norm > index }) - 1
+	if k < 0 {
+		return index
+	} else if edit := r.edits[k]; edit.norm == index {
+		return edit.src
+	} else {
+		return index - edit.norm - 1 + edit.src + edit.width
+	}
+}
+
+// SourceIndex returns the index in the source reader corresponding to the
+// normalized index given. The line feed of a normalized terminator maps to
+// the start of the original terminator
+//
+// SourceIndex was added by go-corelibs
+func (r *LineEndingReader) SourceIndex(index int64) (src int64, err error) {
+	if err = r.build(); err != nil {
+		return -1, err
+	} else if index < 0 || index > r.size {
+		return -1, r.newError(".SourceIndex: index out of range")
+	}
+	return r.sourceIndex(index), nil
+}
+
+// NormalizedIndex returns the normalized index corresponding to the source
+// index given. Indices within a normalized terminator map to its line feed
+//
+// NormalizedIndex was added by go-corelibs
+func (r *LineEndingReader) NormalizedIndex(src int64) (index int64, err error) {
+	if err = r.build(); err != nil {
+		return -1, err
+	} else if src < 0 || src > r.src.Size() {
+		return -1, r.newError(".NormalizedIndex: index out of range")
+	}
+	k := sort.Search(len(r.edits), func(i int) bool { return r.edits[i].src > src }) - 1
+	if k < 0 {
+		return src, nil
+	} else if edit := r.edits[k]; src < edit.src+edit.width {
+		return edit.norm, nil
+	} else {
+		return src - edit.src - edit.width + edit.norm + 1, nil
+	}
+}
+
+// runeAt returns the normalized rune at index and its native width. The
+// caller must ensure that the index is built and 0 <= index < r.size
+func (r *LineEndingReader) runeAt(index int64) (ch rune, width int64, err error) {
+	if _, ok := r.editAt(index); ok {
+		return '\n', 1, nil
+	}
+	var w int
+	ch, w, err = r.src.ReadRuneAt(r.sourceIndex(index))
+	return ch, int64(w), err
+}
+
+// readString returns the normalized text from index to end. The caller must
+// ensure that the index is built and 0 <= index < end <= r.size
+func (r *LineEndingReader) readString(index, end int64) (text string, err error) {
+	start, stop := r.sourceIndex(index), r.sourceIndex(end)
+	if text, err = r.src.ReadString(start, stop-start); err != nil {
+		return "", err
+	}
+	if lo := sort.Search(len(r.edits), func(i int) bool { return r.edits[i].norm >= index }); lo < len(r.edits) && r.edits[lo].norm < end {
+		var buf strings.Builder
+		buf.Grow(len(text))
+		// the text is indexed in bytes, the edits in native units
+		var last, units, bytes int64
+		toBytes := func(offset int64) int64 {
+			if !r.runes {
+				return offset
+			}
+			for ; units < offset; units++ {
+				_, size := utf8.DecodeRuneInString(text[bytes:])
+				bytes += int64(size)
+			}
+			return bytes
+		}
+		for _, edit := range r.edits[lo:] {
+			if edit.norm >= end {
+				break
+			}
+			at := toBytes(edit.src - start)
+			buf.WriteString(text[last:at])
+			buf.WriteByte('\n')
+			last = toBytes(edit.src - start + edit.width)
+		}
+		buf.WriteString(text[last:])
+		text = buf.String()
+	}
+	return
+}
+
+// checkSlice validates the arguments of the slice methods
+func (r *LineEndingReader) checkSlice(method string, index, count int64) error {
+	r.prevRune = -1
+	if err := r.build(); err != nil {
+		return err
+	} else if index < 0 {
+		return r.newError(method + ": negative position")
+	} else if count < 1 {
+		return r.newError(method + ": zero or negative count")
+	} else if index >= r.size {
+		return io.EOF
+	}
+	return nil
+}
+
+// runeIndexed returns true if the native units of the source are runes
+func (r *LineEndingReader) runeIndexed() bool { return r.runes }
+
+// Len returns the number of native units of the unread portion of the
+// normalized text
+func (r *LineEndingReader) Len() int {
+	if r.build() != nil || r.i >= r.size {
+		return 0
+	}
+	return int(r.size - r.i)
+}
+
+// Size returns the length of the normalized text, in native units
+func (r *LineEndingReader) Size() int64 {
+	_ = r.build()
+	return r.size
+}
+
+// Read implements the [io.Reader] interface. Only whole runes are read and
+// io.ErrShortBuffer is returned if b is too small to hold the next rune
+func (r *LineEndingReader) Read(b []byte) (n int, err error) {
+	r.prevRune = -1
+	if err = r.build(); err != nil {
+		return 0, err
+	} else if r.i >= r.size {
+		return 0, io.EOF
+	}
+	for n < len(b) && r.i < r.size {
+		ch, width, err := r.runeAt(r.i)
+		if err != nil {
+			return n, err
+		}
+		size := runeLen(ch)
+		if n+size > len(b) {
+			break
+		}
+		n += utf8.EncodeRune(b[n:], ch)
+		r.i += width
+	}
+	if n == 0 && len(b) > 0 {
+		err = io.ErrShortBuffer
+	}
+	return
+}
+
+// ReadAt implements the [io.ReaderAt] interface
+func (r *LineEndingReader) ReadAt(b []byte, off int64) (n int, err error) {
+	if err = r.build(); err != nil {
+		return 0, err
+	} else if off < 0 {
+		return 0, r.newError(".ReadAt: negative offset")
+	} else if off >= r.size {
+		return 0, io.EOF
+	}
+	var buf [utf8.UTFMax]byte
+	for n < len(b) && off < r.size {
+		ch, width, err := r.runeAt(off)
+		if err != nil {
+			return n, err
+		}
+		size := utf8.EncodeRune(buf[:], ch)
+		n += copy(b[n:], buf[:size])
+		off += width
+	}
+	if n < len(b) {
+		err = io.EOF
+	}
+	return
+}
+
+// ReadByte implements the [io.ByteReader] interface, returning the byte the
+// ReadByte of the source returns at the same position, so for a source in
+// runes it is the low byte of the rune, as with Reader.ReadByte
+func (r *LineEndingReader) ReadByte() (b byte, err error) {
+	r.prevRune = -1
+	if err = r.build(); err != nil {
+		return 0, err
+	} else if r.i >= r.size {
+		return 0, io.EOF
+	}
+	if _, ok := r.editAt(r.i); ok {
+		b = '\n'
+	} else if _, err = r.src.Seek(r.sourceIndex(r.i), io.SeekStart); err != nil {
+		return 0, err
+	} else if b, err = r.src.ReadByte(); err != nil {
+		return 0, err
+	}
+	r.i++
+	return
+}
+
+// UnreadByte complements ReadByte in implementing the [io.ByteScanner]
+// interface
+func (r *LineEndingReader) UnreadByte() error {
+	if r.i <= 0 {
+		return r.newError(".UnreadByte: at beginning of slice")
+	}
+	r.prevRune = -1
+	r.i--
+	return nil
+}
+
+// ReadRune implements the [io.RuneReader] interface. The size returned is
+// always the UTF-8 encoded length of the rune, in bytes
+func (r *LineEndingReader) ReadRune() (ch rune, size int, err error) {
+	if err = r.build(); err != nil {
+		r.prevRune = -1
+		return 0, 0, err
+	} else if r.i >= r.size {
+		r.prevRune = -1
+		return 0, 0, io.EOF
+	}
+	var width int64
+	if ch, width, err = r.runeAt(r.i); err != nil {
+		r.prevRune = -1
+		return 0, 0, err
+	}
+	r.prevRune = int(r.i)
+	r.i += width
+	if r.runes {
+		return ch, runeLen(ch), nil
+	}
+	return ch, int(width), nil
+}
+
+// UnreadRune complements ReadRune in implementing the [io.RuneScanner]
+// interface
+func (r *LineEndingReader) UnreadRune() error {
+	if r.i <= 0 {
+		return r.newError(".UnreadRune: at beginning of slice")
+	}
+	if r.prevRune < 0 {
+		return r.newError(".UnreadRune: previous operation was not ReadRune")
+	}
+	r.i = int64(r.prevRune)
+	r.prevRune = -1
+	return nil
+}
+
+// Seek implements the [io.Seeker] interface, over the normalized view
+func (r *LineEndingReader) Seek(offset int64, whence int) (int64, error) {
+	r.prevRune = -1
+	if err := r.build(); err != nil {
+		return 0, err
+	}
+	var abs int64
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		abs = r.i + offset
+	case io.SeekEnd:
+		abs = r.size + offset
+	default:
+		return 0, r.newError(".Seek: invalid whence")
+	}
+	if abs < 0 {
+		return 0, r.newError(".Seek: negative position")
+	}
+	r.i = abs
+	return abs, nil
+}
+
+// WriteTo implements the [io.WriterTo] interface
+func (r *LineEndingReader) WriteTo(w io.Writer) (n int64, err error) {
+	r.prevRune = -1
+	if err = r.build(); err != nil || r.i >= r.size {
+		return 0, err
+	}
+	var text string
+	if text, err = r.readString(r.i, r.size); err != nil {
+		return 0, err
+	}
+	m, err := io.WriteString(w, text)
+	if m > len(text) {
+		panic("LineEndingReader.WriteTo: invalid Write count")
+	}
+	if r.runes {
+		r.i += int64(utf8.RuneCountInString(text[:m]))
+	} else {
+		r.i += int64(m)
+	}
+	n = int64(m)
+	if m != len(text) && err == nil {
+		err = io.ErrShortWrite
+	}
+	return
+}
+
+// ReadRuneAt is a convenience method combining Seek and ReadRune into one
+// operation, with the size returned in native units
+//
+// ReadRuneAt was added by go-corelibs
+func (r *LineEndingReader) ReadRuneAt(index int64) (ch rune, size int, err error) {
+	r.prevRune = -1
+	if err = r.build(); err != nil {
+		return 0, 0, err
+	} else if index < 0 {
+		return 0, 0, r.newError(".ReadRuneAt: negative position")
+	} else if index >= r.size {
+		return 0, 0, io.EOF
+	}
+	var width int64
+	if ch, width, err = r.runeAt(index); err != nil {
+		return 0, 0, err
+	}
+	r.prevRune = int(index)
+	r.i = index + width
+	return ch, int(width), nil
+}
+
+// ReadPrevRuneFrom reads the rune which ends at the index given and leaves
+// the reader positioned at the start of it
+//
+// ReadPrevRuneFrom was added by go-corelibs
+func (r *LineEndingReader) ReadPrevRuneFrom(index int64) (ch rune, size int, err error) {
+	r.prevRune = -1
+	if err = r.build(); err != nil {
+		return 0, 0, err
+	} else if index <= 0 {
+		return 0, 0, r.newError(".ReadPrevRuneFrom: zero or negative position")
+	} else if index > r.size {
+		return 0, 0, io.EOF
+	}
+	if _, ok := r.editAt(index - 1); ok {
+		ch, size = '\n', 1
+	} else if ch, size, err = r.src.ReadPrevRuneFrom(r.sourceIndex(index)); err != nil {
+		return 0, 0, err
+	}
+	r.i = index - int64(size)
+	return
+}
+
+// ReadNextRuneFrom reads the rune which follows the one at the index given
+// and leaves the reader positioned at the start of it
+//
+// ReadNextRuneFrom was added by go-corelibs
+func (r *LineEndingReader) ReadNextRuneFrom(index int64) (ch rune, size int, err error) {
+	r.prevRune = -1
+	if err = r.build(); err != nil {
+		return 0, 0, err
+	} else if index < 0 {
+		return 0, 0, r.newError(".ReadNextRuneFrom: negative position")
+	} else if index >= r.size {
+		return 0, 0, io.EOF
+	}
+	var skip, width int64
+	if _, skip, err = r.runeAt(index); err != nil {
+		return 0, 0, err
+	} else if index+skip >= r.size {
+		return 0, 0, io.EOF
+	} else if ch, width, err = r.runeAt(index + skip); err != nil {
+		return 0, 0, err
+	}
+	r.i = index + skip
+	return ch, int(width), nil
+}
+
+// ReadRuneSlice reads up to count runes starting at the index given, with
+// the size returned in native units
+//
+// ReadRuneSlice was added by go-corelibs
+func (r *LineEndingReader) ReadRuneSlice(index, count int64) (slice []rune, size int, err error) {
+	if err = r.checkSlice(".ReadRuneSlice", index, count); err != nil {
+		return nil, 0, err
+	}
+	return r.appendRunes(make([]rune, 0, min(count, r.size-index)), index, count)
+}
+
+// AppendRuneSlice is like ReadRuneSlice except that the runes are appended to
+// dst
+//
+// AppendRuneSlice was added by go-corelibs
+func (r *LineEndingReader) AppendRuneSlice(dst []rune, index, count int64) (slice []rune, size int, err error) {
+	if err = r.checkSlice(".AppendRuneSlice", index, count); err != nil {
+		return dst, 0, err
+	}
+	return r.appendRunes(dst, index, count)
+}
+
+func (r *LineEndingReader) appendRunes(dst []rune, index, count int64) ([]rune, int, error) {
+	r.i = index
+	for track := int64(0); track < count && r.i < r.size; track++ {
+		ch, width, err := r.runeAt(r.i)
+		if err != nil {
+			return dst, int(r.i - index), err
+		}
+		r.prevRune = int(r.i)
+		dst = append(dst, ch)
+		r.i += width
+	}
+	return dst, int(r.i - index), nil
+}
+
+// ReadByteSlice returns the UTF-8 encoding of count native units of the
+// normalized text, starting at the index given
+//
+// ReadByteSlice was added by go-corelibs
+func (r *LineEndingReader) ReadByteSlice(index, count int64) (slice []byte, err error) {
+	if err = r.checkSlice(".ReadByteSlice", index, count); err != nil {
+		return nil, err
+	}
+	var text string
+	if text, err = r.readSlice(index, count); err != nil {
+		return nil, err
+	}
+	return []byte(text), nil
+}
+
+// AppendByteSlice is like ReadByteSlice except that the data is appended to
+// dst
+//
+// AppendByteSlice was added by go-corelibs
+func (r *LineEndingReader) AppendByteSlice(dst []byte, index, count int64) (slice []byte, err error) {
+	if err = r.checkSlice(".AppendByteSlice", index, count); err != nil {
+		return dst, err
+	}
+	var text string
+	if text, err = r.readSlice(index, count); err != nil {
+		return dst, err
+	}
+	return append(dst, text...), nil
+}
+
+// ReadString is like ReadByteSlice except that a string is returned
+//
+// ReadString was added by go-corelibs
+func (r *LineEndingReader) ReadString(index, count int64) (slice string, err error) {
+	if err = r.checkSlice(".ReadString", index, count); err != nil {
+		return "", err
+	}
+	return r.readSlice(index, count)
+}
+
+// AppendString is like ReadString except that the data is written to dst
+//
+// AppendString was added by go-corelibs
+func (r *LineEndingReader) AppendString(dst *strings.Builder, index, count int64) (err error) {
+	if err = r.checkSlice(".AppendString", index, count); err != nil {
+		return err
+	}
+	var text string
+	if text, err = r.readSlice(index, count); err == nil {
+		dst.WriteString(text)
+	}
+	return
+}
+
+func (r *LineEndingReader) readSlice(index, count int64) (text string, err error) {
+	end := min(index+count, r.size)
+	if text, err = r.readString(index, end); err == nil {
+		r.i = end
+	}
+	return
+}
+
+// ReadWhile reads the runes of the normalized text from the index given for
+// as long as fn returns true, with the size returned in native units
+//
+// ReadWhile was added by go-corelibs
+func (r *LineEndingReader) ReadWhile(index int64, fn func(ch rune) bool) (slice []rune, size int, err error) {
+	if err = r.build(); err != nil {
+		return nil, 0, err
+	}
+	return readWhile(r, "LineEndingReader.ReadWhile", index, fn)
+}
+
+// ReadUntil reads the runes of the normalized text from the index given up
+// to the first rune in delims, which is included when inclusive is true. A
+// nil delims is an empty set, reading to the end of the text
+//
+// ReadUntil was added by go-corelibs
+func (r *LineEndingReader) ReadUntil(index int64, delims *RuneSet, inclusive bool) (slice []rune, size int, err error) {
+	if err = r.build(); err != nil {
+		return nil, 0, err
+	}
+	return readUntil(r, "LineEndingReader.ReadUntil", index, delims, inclusive)
+}
+
+// SkipWhile returns the index of the first rune of the normalized text, from
+// the index given, for which fn returns false
+//
+// SkipWhile was added by go-corelibs
+func (r *LineEndingReader) SkipWhile(index int64, fn func(ch rune) bool) (next int64, err error) {
+	if err = r.build(); err != nil {
+		return -1, err
+	}
+	return skipWhile(r, "LineEndingReader.SkipWhile", index, fn)
+}
+
+// ReadLine reads the line of the normalized text at the index given and
+// returns it without its terminator, along with the index of the next line
+//
+// ReadLine was added by go-corelibs
+func (r *LineEndingReader) ReadLine(index int64) (line []rune, next int64, err error) {
+	if err = r.build(); err != nil {
+		return nil, -1, err
+	}
+	return readLine(r, "LineEndingReader.ReadLine", index)
+}
+
+// IndexAny returns the index of the first rune of the normalized text in the
+// set, from the index given, or -1 when there is none
+//
+// IndexAny was added by go-corelibs
+func (r *LineEndingReader) IndexAny(index int64, set *RuneSet) (found int64, err error) {
+	if err = r.build(); err != nil {
+		return -1, err
+	}
+	return indexAny(r, "LineEndingReader.IndexAny", index, set)
+}
+
+// Accept reads the rune of the normalized text at the index given when it is
+// in the set, returning true and its size in native units
+//
+// Accept was added by go-corelibs
+func (r *LineEndingReader) Accept(index int64, set *RuneSet) (ok bool, size int, err error) {
+	if err = r.build(); err != nil {
+		return false, 0, err
+	}
+	return accept(r, "LineEndingReader.Accept", index, set)
+}
@@ -0,0 +1,164 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	. "github.com/go-corelibs/runes"
+)
+
+var lineEndingReplacer = strings.NewReplacer("\r\n", "\n", "\r", "\n", "\u0085", "\n")
+
+// lineEndingPairs returns each kind of LineEndingReader over text, paired
+// with the same kind of plain reader over the expected normalized text
+func lineEndingPairs(text string) (pairs [][2]RuneReader) {
+	want := lineEndingReplacer.Replace(text)
+	return [][2]RuneReader{
+		{NewLineEndingReader(NewBytesReader([]byte(text)), 0), NewBytesReader([]byte(want))},
+		{NewLineEndingReader(NewStringReader(text), 0), NewStringReader(want)},
+		{NewLineEndingReader(NewRunesReader([]rune(text)), 0), NewRunesReader([]rune(want))},
+	}
+}
+
+//gocyclo:ignore
+func TestLineEndingReader(t *testing.T) {
+	for _, text := range []string{
+		"",
+		"plain",
+		"one\r\ntwo\rthree\u0085four\nfive",
+		"\r\n\r\n\r\r\n\n\r",
+		"日本\r\n語\u0085\r",
+	} {
+		for _, pair := range lineEndingPairs(text) {
+			r, e := pair[0], pair[1]
+			if r.Size() != e.Size() {
+				t.Errorf("%q %T: Size got %d; want %d", text, e, r.Size(), e.Size())
+			}
+			for index := int64(0); index <= e.Size(); index++ {
+				ch, size, err := r.ReadRuneAt(index)
+				ech, esize, eerr := e.ReadRuneAt(index)
+				if ch != ech || size != esize || err != eerr {
+					t.Errorf("%q %T: ReadRuneAt(%d) got %q,%d,%v; want %q,%d,%v", text, e, index, ch, size, err, ech, esize, eerr)
+				}
+				ch, size, err = r.ReadPrevRuneFrom(index)
+				ech, esize, eerr = e.ReadPrevRuneFrom(index)
+				if ch != ech || size != esize || (err == nil) != (eerr == nil) {
+					t.Errorf("%q %T: ReadPrevRuneFrom(%d) got %q,%d,%v; want %q,%d,%v", text, e, index, ch, size, err, ech, esize, eerr)
+				}
+				ch, size, err = r.ReadNextRuneFrom(index)
+				ech, esize, eerr = e.ReadNextRuneFrom(index)
+				if ch != ech || size != esize || err != eerr {
+					t.Errorf("%q %T: ReadNextRuneFrom(%d) got %q,%d,%v; want %q,%d,%v", text, e, index, ch, size, err, ech, esize, eerr)
+				}
+				for count := int64(1); count <= 3; count++ {
+					slice, size, err := r.ReadRuneSlice(index, count)
+					eslice, esize, eerr := e.ReadRuneSlice(index, count)
+					if string(slice) != string(eslice) || size != esize || err != eerr {
+						t.Errorf("%q %T: ReadRuneSlice(%d,%d) got %q,%d,%v; want %q,%d,%v", text, e, index, count, string(slice), size, err, string(eslice), esize, eerr)
+					}
+					str, err := r.ReadString(index, count)
+					estr, eerr := e.ReadString(index, count)
+					if str != estr || err != eerr {
+						t.Errorf("%q %T: ReadString(%d,%d) got %q,%v; want %q,%v", text, e, index, count, str, err, estr, eerr)
+					}
+					data, err := r.(RuneAppender).AppendByteSlice([]byte("x"), index, count)
+					edata, eerr := e.(RuneAppender).AppendByteSlice([]byte("x"), index, count)
+					if !bytes.Equal(data, edata) || err != eerr {
+						t.Errorf("%q %T: AppendByteSlice(%d,%d) got %q,%v; want %q,%v", text, e, index, count, data, err, edata, eerr)
+					}
+				}
+			}
+
+			_, _ = r.Seek(0, io.SeekStart)
+			_, _ = e.Seek(0, io.SeekStart)
+			for index := int64(0); ; index++ {
+				b, err := r.ReadByte()
+				eb, eerr := e.ReadByte()
+				if b != eb || err != eerr {
+					t.Errorf("%q %T: ReadByte at %d got %q,%v; want %q,%v", text, e, index, b, err, eb, eerr)
+					break
+				} else if err != nil {
+					break
+				}
+			}
+
+			_, _ = r.Seek(0, io.SeekStart)
+			_, _ = e.Seek(0, io.SeekStart)
+			for {
+				ch, size, err := r.ReadRune()
+				ech, esize, eerr := e.ReadRune()
+				if ch != ech || size != esize || err != eerr {
+					t.Errorf("%q %T: ReadRune got %q,%d,%v; want %q,%d,%v", text, e, ch, size, err, ech, esize, eerr)
+					break
+				} else if err != nil {
+					break
+				} else if r.UnreadRune() != nil || e.UnreadRune() != nil {
+					t.Fatalf("%q %T: UnreadRune failed", text, e)
+				}
+				_, _, _ = r.ReadRune()
+				_, _, _ = e.ReadRune()
+			}
+
+			_, _ = r.Seek(0, io.SeekStart)
+			if data, err := io.ReadAll(r); err != nil || string(data) != lineEndingReplacer.Replace(text) {
+				t.Errorf("%q %T: ReadAll got %q,%v", text, e, data, err)
+			}
+			_, _ = r.Seek(0, io.SeekStart)
+			var buf bytes.Buffer
+			if _, err := r.WriteTo(&buf); err != nil || buf.String() != lineEndingReplacer.Replace(text) || r.Len() != 0 {
+				t.Errorf("%q %T: WriteTo got %q,%v", text, e, buf.String(), err)
+			}
+			// for rune readers, ReadAt offsets are in runes and only the
+			// behaviour of the plain reader can be compared
+			var ebuf bytes.Buffer
+			buf.Reset()
+			_, err := io.Copy(&buf, io.NewSectionReader(r, 0, r.Size()))
+			_, eerr := io.Copy(&ebuf, io.NewSectionReader(e, 0, e.Size()))
+			if buf.String() != ebuf.String() || err != eerr {
+				t.Errorf("%q %T: ReadAt got %q,%v; want %q,%v", text, e, buf.String(), err, ebuf.String(), eerr)
+			}
+		}
+	}
+}
+
+//gocyclo:ignore
+func TestLineEndingReader_Mapping(t *testing.T) {
+	// source:     a \r \n b \r c \u0085 d      (bytes: NEL is two bytes)
+	// normalized: a \n b \n c \n d
+	const text = "a\r\nb\rc\u0085d"
+	r := NewLineEndingReader(NewStringReader(text), 0)
+	source := []int64{0, 1, 3, 4, 5, 6, 8, 9}
+	for index, want := range source {
+		if src, err := r.SourceIndex(int64(index)); src != want || err != nil {
+			t.Errorf("SourceIndex(%d): got %d,%v; want %d", index, src, err, want)
+		}
+	}
+	normalized := []int64{0, 1, 1, 2, 3, 4, 5, 5, 6, 7}
+	for src, want := range normalized {
+		if index, err := r.NormalizedIndex(int64(src)); index != want || err != nil {
+			t.Errorf("NormalizedIndex(%d): got %d,%v; want %d", src, index, err, want)
+		}
+	}
+	if _, err := r.SourceIndex(8); err == nil {
+		t.Errorf("SourceIndex(8): expected error")
+	}
+	if _, err := r.NormalizedIndex(-1); err == nil {
+		t.Errorf("NormalizedIndex(-1): expected error")
+	}
+
+	// only the terminators requested are normalized
+	r = NewLineEndingReader(NewStringReader(text), CRLF)
+	if data, _ := io.ReadAll(r); string(data) != "a\nb\rc\u0085d" {
+		t.Errorf("CRLF only: got %q", data)
+	}
+	r = NewLineEndingReader(NewRunesReader([]rune("a b")), LS)
+	if data, _ := io.ReadAll(r); string(data) != "a\nb" {
+		t.Errorf("LS only: got %q", data)
+	}
+}
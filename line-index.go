@@ -0,0 +1,194 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"errors"
+	"sort"
+)
+
+// LineTerminator is a bitmask of the line endings recognised by a LineIndex
+type LineTerminator uint8
+
+const (
+	// LF is the line feed (U+000A) terminator
+	LF LineTerminator = 1 << iota
+	// CRLF is the carriage return (U+000D) followed by a line feed terminator
+	CRLF
+	// CR is the lone carriage return (U+000D) terminator
+	CR
+	// NEL is the next line (U+0085) terminator
+	NEL
+	// LS is the line separator (U+2028) terminator
+	LS
+
+	// DefaultLineTerminators is LF and CRLF
+	DefaultLineTerminators = LF | CRLF
+	// AllLineTerminators is every LineTerminator supported
+	AllLineTerminators = LF | CRLF | CR | NEL | LS
+)
+
+var (
+	errLineRange  = errors.New("LineIndex: line out of range")
+	errIndexRange = errors.New("LineIndex: index out of range")
+)
+
+// LineIndex provides line-based lookups over a RuneReader. All indices are in
+// the native units of the reader. Lines are numbered from zero and a text
+// always has at least one line; a terminator at the very end of the text is
+// followed by one last empty line
+//
+// The index is built lazily, in a single pass, upon the first call to any of
+// the methods which need it. If the reader is modified with Reset, the index
+// must also be Reset
+//
+// A LineIndex is not safe for concurrent use and changes the position of the
+// reader when building the index or reading lines
+type LineIndex struct {
+	r     RuneReader
+	terms LineTerminator
+	built bool
+	err   error
+	// starts[n] is the index of the first rune of line n
+	starts []int64
+	// ends[n] is the index of the terminator of line n, or the end of the text
+	ends []int64
+}
+
+// NewLineIndex returns a new LineIndex for the reader given, recognising only
+// the terminators given. If terms is zero, DefaultLineTerminators are used
+//
+// NewLineIndex was added by go-corelibs
+func NewLineIndex(r RuneReader, terms LineTerminator) *LineIndex {
+	if terms == 0 {
+		terms = DefaultLineTerminators
+	}
+	return &LineIndex{r: r, terms: terms}
+}
+
+// Reset discards the index so that it is rebuilt on next use
+func (li *LineIndex) Reset() {
+	li.built = false
+	li.err = nil
+	li.starts = li.starts[:0]
+	li.ends = li.ends[:0]
+}
+
+// build scans the reader once, recording the start and end of each line
+func (li *LineIndex) build() error {
+	if li.built {
+		return li.err
+	}
+	li.built = true
+	li.starts = append(li.starts[:0], 0)
+	li.ends = li.ends[:0]
+	size := li.r.Size()
+	for index := int64(0); index < size; {
+		ch, width, err := li.r.ReadRuneAt(index)
+		if err != nil {
+			li.err = err
+			return err
+		}
+		next := index + int64(width)
+		term := false
+		switch ch {
+		case '\n':
+			term = li.terms&LF != 0
+		case '\r':
+			if li.terms&CRLF != 0 && next < size {
+				var after rune
+				var w int
+				if after, w, li.err = li.r.ReadRuneAt(next); li.err != nil {
+					return li.err
+				} else if after == '\n' {
+					next += int64(w)
+					term = true
+					break
+				}
+			}
+			term = li.terms&CR != 0
+		case '\u0085':
+			term = li.terms&NEL != 0
+		case '\u2028':
+			term = li.terms&LS != 0
+		}
+		if term {
+			li.ends = append(li.ends, index)
+			li.starts = append(li.starts, next)
+		}
+		index = next
+	}
+	li.ends = append(li.ends, size)
+	return nil
+}
+
+// LineCount returns the number of lines in the text
+//
+// LineCount was added by go-corelibs
+func (li *LineIndex) LineCount() (count int, err error) {
+	if err = li.build(); err != nil {
+		return 0, err
+	}
+	return len(li.starts), nil
+}
+
+// LineStart returns the index of the first rune of line n
+//
+// LineStart was added by go-corelibs
+func (li *LineIndex) LineStart(n int) (index int64, err error) {
+	if err = li.build(); err != nil {
+		return -1, err
+	} else if n < 0 || n >= len(li.starts) {
+		return -1, errLineRange
+	}
+	return li.starts[n], nil
+}
+
+// LineEnd returns the index of the terminator of line n, or the size of the
+// text for the last line. The terminator itself is not part of the line
+//
+// LineEnd was added by go-corelibs
+func (li *LineIndex) LineEnd(n int) (index int64, err error) {
+	if err = li.build(); err != nil {
+		return -1, err
+	} else if n < 0 || n >= len(li.ends) {
+		return -1, errLineRange
+	}
+	return li.ends[n], nil
+}
+
+// LineOf returns the line number containing the index given. Indices within
+// a terminator belong to the line they terminate and the size of the text
+// belongs to the last line
+//
+// LineOf was added by go-corelibs
+func (li *LineIndex) LineOf(index int64) (n int, err error) {
+	if err = li.build(); err != nil {
+		return -1, err
+	} else if index < 0 || index > li.r.Size() {
+		return -1, errIndexRange
+	}
+	// the first line starting after index, less one
+	n = sort.Search(len(li.starts), func(i int) bool {
+		return li.starts[i] > index
+	}) - 1
+	return
+}
+
+// ReadLine returns the content of line n, without its terminator
+//
+// ReadLine was added by go-corelibs
+func (li *LineIndex) ReadLine(n int) (line string, err error) {
+	if err = li.build(); err != nil {
+		return "", err
+	} else if n < 0 || n >= len(li.starts) {
+		return "", errLineRange
+	}
+	start, end := li.starts[n], li.ends[n]
+	if start == end {
+		return "", nil
+	}
+	return li.r.ReadString(start, end-start)
+}
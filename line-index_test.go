@@ -0,0 +1,121 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes_test
+
+import (
+	"testing"
+
+	. "github.com/go-corelibs/runes"
+)
+
+func readLines(t *testing.T, li *LineIndex) (lines []string) {
+	count, err := li.LineCount()
+	if err != nil {
+		t.Fatalf("LineCount: unexpected error: %v", err)
+	}
+	for n := 0; n < count; n++ {
+		line, err := li.ReadLine(n)
+		if err != nil {
+			t.Fatalf("ReadLine(%d): unexpected error: %v", n, err)
+		}
+		lines = append(lines, line)
+	}
+	return
+}
+
+func TestLineIndex(t *testing.T) {
+	const text = "one\ntwo\r\nthree\rfour\u0085five\u2028six\n"
+	for _, tt := range []struct {
+		terms LineTerminator
+		want  []string
+	}{
+		{0, []string{"one", "two", "three\rfour\u0085five\u2028six", ""}},
+		{LF, []string{"one", "two\r", "three\rfour\u0085five\u2028six", ""}},
+		{CR, []string{"one\ntwo", "\nthree", "four\u0085five\u2028six\n"}},
+		{AllLineTerminators, []string{"one", "two", "three", "four", "five", "six", ""}},
+		{LF | CR, []string{"one", "two", "", "three", "four\u0085five\u2028six", ""}},
+	} {
+		for _, r := range []RuneReader{NewBytesReader([]byte(text)), NewStringReader(text), NewRunesReader([]rune(text))} {
+			lines := readLines(t, NewLineIndex(r, tt.terms))
+			if len(lines) != len(tt.want) {
+				t.Errorf("%T %05b: got %q; want %q", r, tt.terms, lines, tt.want)
+				continue
+			}
+			for idx := range lines {
+				if lines[idx] != tt.want[idx] {
+					t.Errorf("%T %05b: line %d got %q; want %q", r, tt.terms, idx, lines[idx], tt.want[idx])
+				}
+			}
+		}
+	}
+}
+
+//gocyclo:ignore
+func TestLineIndex_Lookups(t *testing.T) {
+	const text = "\u65e5\u672c\r\nab\n\nc"
+	// native offsets of each line start and end, for bytes and runes
+	for _, tt := range []struct {
+		r      RuneReader
+		starts []int64
+		ends   []int64
+	}{
+		{NewStringReader(text), []int64{0, 8, 11, 12}, []int64{6, 10, 11, 13}},
+		{NewRunesReader([]rune(text)), []int64{0, 4, 7, 8}, []int64{2, 6, 7, 9}},
+	} {
+		li := NewLineIndex(tt.r, DefaultLineTerminators)
+		if count, err := li.LineCount(); count != 4 || err != nil {
+			t.Errorf("%T LineCount: got %d, %v; want 4, nil", tt.r, count, err)
+		}
+		for n := range tt.starts {
+			if start, err := li.LineStart(n); start != tt.starts[n] || err != nil {
+				t.Errorf("%T LineStart(%d): got %d, %v; want %d", tt.r, n, start, err, tt.starts[n])
+			}
+			if end, err := li.LineEnd(n); end != tt.ends[n] || err != nil {
+				t.Errorf("%T LineEnd(%d): got %d, %v; want %d", tt.r, n, end, err, tt.ends[n])
+			}
+		}
+		for index := int64(0); index <= tt.r.Size(); index++ {
+			want := 0
+			for n := range tt.starts {
+				if tt.starts[n] <= index {
+					want = n
+				}
+			}
+			if n, err := li.LineOf(index); n != want || err != nil {
+				t.Errorf("%T LineOf(%d): got %d, %v; want %d", tt.r, index, n, err, want)
+			}
+		}
+		if _, err := li.LineOf(-1); err == nil {
+			t.Errorf("%T LineOf(-1): expected error", tt.r)
+		}
+		if _, err := li.LineOf(tt.r.Size() + 1); err == nil {
+			t.Errorf("%T LineOf(Size+1): expected error", tt.r)
+		}
+		if _, err := li.LineStart(4); err == nil {
+			t.Errorf("%T LineStart(4): expected error", tt.r)
+		}
+		if _, err := li.LineEnd(-1); err == nil {
+			t.Errorf("%T LineEnd(-1): expected error", tt.r)
+		}
+		if _, err := li.ReadLine(4); err == nil {
+			t.Errorf("%T ReadLine(4): expected error", tt.r)
+		}
+	}
+
+	r := NewStringReader("")
+	li := NewLineIndex(r, 0)
+	if count, err := li.LineCount(); count != 1 || err != nil {
+		t.Errorf("LineCount: got %d, %v; want 1, nil", count, err)
+	}
+	if line, err := li.ReadLine(0); line != "" || err != nil {
+		t.Errorf("ReadLine: got %q, %v; want \"\", nil", line, err)
+	}
+
+	r.Reset("a\nb\nc")
+	li.Reset()
+	if count, err := li.LineCount(); count != 3 || err != nil {
+		t.Errorf("LineCount after Reset: got %d, %v; want 3, nil", count, err)
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/clipperhouse/displaywidth"
+)
+
+// LineRange is one line of wrapped text
+type LineRange struct {
+	// Start is the index of the first rune of the line
+	Start int64
+	// End is the index following the last visible rune of the line, the
+	// trailing whitespace and line terminator are not included
+	End int64
+	// Width is the terminal display width of the line, in columns
+	Width int
+}
+
+// isTrailingSpace returns true if ch is whitespace which may hang past the
+// end of a wrapped line. The non-breaking spaces are not included
+func isTrailingSpace(ch rune) bool {
+	switch ch {
+	case '\u00A0', '\u2007', '\u202F':
+		return false
+	}
+	return unicode.IsSpace(ch)
+}
+
+// wrapper holds the state of Wrap
+type wrapper struct {
+	columns int
+	runes   bool
+	lines   []LineRange
+	line    LineRange
+	pending int // width of the trailing whitespace of the line so far
+}
+
+// units returns the length of s in native units
+func (w *wrapper) units(s string) int64 {
+	if w.runes {
+		return int64(utf8.RuneCountInString(s))
+	}
+	return int64(len(s))
+}
+
+// flush ends the current line and starts a new one at the index given
+func (w *wrapper) flush(start int64) {
+	w.lines = append(w.lines, w.line)
+	w.line = LineRange{Start: start, End: start}
+	w.pending = 0
+}
+
+// add appends the segment of text at the index given to the wrapped lines
+func (w *wrapper) add(index int64, segment string) {
+	content := strings.TrimRightFunc(segment, isTrailingSpace)
+	spaces := strings.TrimRightFunc(segment[len(content):], isParaSep)
+	if content == "" {
+		w.pending += displaywidth.String(spaces)
+		return
+	}
+
+	width := displaywidth.String(content)
+	if w.line.Width+w.pending+width > w.columns {
+		if w.line.End > w.line.Start {
+			w.flush(index)
+		} else {
+			// the leading whitespace of the line does not fit with the
+			// segment, leave it before the start of the line
+			w.line = LineRange{Start: index, End: index}
+			w.pending = 0
+		}
+	}
+	if w.line.Width+w.pending+width > w.columns {
+		// the segment is too wide for a line of its own, break it between
+		// grapheme clusters
+		gs := displaywidth.StringGraphemes(content)
+		for offset := 0; gs.Next(); {
+			value, gw := gs.Value(), gs.Width()
+			start := index + w.units(content[:offset])
+			if w.line.End > w.line.Start && w.line.Width+w.pending+gw > w.columns {
+				w.flush(start)
+			}
+			offset += len(value)
+			w.line.Width += w.pending + gw
+			w.line.End = index + w.units(content[:offset])
+			w.pending = 0
+		}
+	} else {
+		w.line.Width += w.pending + width
+		w.line.End = index + w.units(content)
+	}
+	w.pending = displaywidth.String(spaces)
+}
+
+// Wrap breaks the text of r into lines no wider than the number of terminal
+// columns given. Lines are broken at the UAX #14 line-break opportunities,
+// so a line may end after a hyphen but never at a non-breaking space, and
+// always end at a line terminator. Trailing whitespace hangs past the end of
+// a line and is not counted in its width, as does leading whitespace which
+// does not fit on a line with the text following it. Any text too wide to fit
+// on a line by itself is broken between grapheme clusters
+//
+// A text always has at least one line, and a line terminator at the very end
+// of the text is followed by one last empty line, as with LineIndex
+//
+// Wrap was added by go-corelibs
+func Wrap(r RuneReader, columns int) (lines []LineRange, err error) {
+	if columns < 1 {
+		return nil, errors.New("Wrap: columns must be greater than zero")
+	}
+	w := &wrapper{columns: columns}
+	if ri, ok := r.(runeIndexer); ok {
+		w.runes = ri.runeIndexed()
+	}
+
+	var start int64
+	lb := NewLineBreaker(r)
+	for lb.Next() {
+		end := lb.Index()
+		var segment string
+		if segment, err = r.ReadString(start, end-start); err != nil {
+			return nil, err
+		}
+		w.add(start, segment)
+		if lb.Mandatory() {
+			w.flush(end)
+		}
+		start = end
+	}
+	if err = lb.Err(); err != nil {
+		return nil, err
+	}
+	if size := r.Size(); size == 0 {
+		w.flush(0)
+	} else if ch, _, ee := r.ReadPrevRuneFrom(size); ee == nil && isParaSep(ch) {
+		w.flush(size)
+	}
+	return w.lines, nil
+}
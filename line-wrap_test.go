@@ -0,0 +1,65 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes_test
+
+import (
+	"reflect"
+	"testing"
+
+	. "github.com/go-corelibs/runes"
+)
+
+func TestWrap(t *testing.T) {
+	for _, tt := range []struct {
+		input   string
+		columns int
+		want    []LineRange
+		runes   []LineRange // when different for the rune reader
+	}{
+		{"", 10, []LineRange{{0, 0, 0}}, nil},
+		{"the quick brown fox", 10, []LineRange{{0, 9, 9}, {10, 19, 9}}, nil},
+		{"the quick brown fox", 100, []LineRange{{0, 19, 19}}, nil},
+		{"well-known fact", 6, []LineRange{{0, 5, 5}, {5, 10, 5}, {11, 15, 4}}, nil},
+		{"a b\u00a0c", 3, []LineRange{{0, 1, 1}, {2, 6, 3}}, []LineRange{{0, 1, 1}, {2, 5, 3}}},
+		{"abc   \ndef", 3, []LineRange{{0, 3, 3}, {7, 10, 3}}, nil},
+		{"abc\n", 3, []LineRange{{0, 3, 3}, {4, 4, 0}}, nil},
+		{"abc\r\n\r\n", 3, []LineRange{{0, 3, 3}, {5, 5, 0}, {7, 7, 0}}, nil},
+		{"  indented text", 10, []LineRange{{0, 10, 10}, {11, 15, 4}}, nil},
+		{"abcdefgh", 3, []LineRange{{0, 3, 3}, {3, 6, 3}, {6, 8, 2}}, nil},
+		{"   lead", 6, []LineRange{{3, 7, 4}}, nil},
+		{"  abcdefgh", 4, []LineRange{{2, 6, 4}, {6, 10, 4}}, nil},
+		{"  ab", 4, []LineRange{{0, 4, 4}}, nil},
+		{"ab\n   lead", 6, []LineRange{{0, 2, 2}, {6, 10, 4}}, nil},
+		{
+			"\u65e5\u672c\u8a9e\u30c6\u30ad\u30b9\u30c8", 6,
+			[]LineRange{{0, 9, 6}, {9, 18, 6}, {18, 21, 2}},
+			[]LineRange{{0, 3, 6}, {3, 6, 6}, {6, 7, 2}},
+		},
+		{
+			"\U0001F600\U0001F600\U0001F600", 4,
+			[]LineRange{{0, 8, 4}, {8, 12, 2}},
+			[]LineRange{{0, 2, 4}, {2, 3, 2}},
+		},
+		{
+			"e\u0301e\u0301e\u0301", 2,
+			[]LineRange{{0, 6, 2}, {6, 9, 1}},
+			[]LineRange{{0, 4, 2}, {4, 6, 1}},
+		},
+	} {
+		for _, r := range newCaseReaders(tt.input) {
+			want := tt.want
+			if _, ok := r.(*Reader); ok && tt.runes != nil {
+				want = tt.runes
+			}
+			if got, err := Wrap(r, tt.columns); !reflect.DeepEqual(got, want) || err != nil {
+				t.Errorf("%T Wrap(%+q, %d) got %v,%v; want %v", r, tt.input, tt.columns, got, err, want)
+			}
+		}
+	}
+
+	if _, err := Wrap(NewStringReader("text"), 0); err == nil || err.Error() != "Wrap: columns must be greater than zero" {
+		t.Errorf("Wrap(0) got %v; want error", err)
+	}
+}
@@ -0,0 +1,299 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// MarkupTag is one element of parsed markup, such as <b>, <fg=red> or
+// <a href="https://go-corelibs.org">
+type MarkupTag struct {
+	// Name is the name of the tag
+	Name string
+	// Value is the value given directly to the tag name, as in <fg=red>
+	Value string
+	// Attrs are the named attributes of the tag, as in <a href=...>
+	Attrs map[string]string
+	// Index is the index of the opening tag in the source
+	Index int64
+}
+
+// MarkupError describes markup which could not be parsed and where in the
+// source the problem was found
+type MarkupError struct {
+	// Index is the index of the problem in the source
+	Index int64
+	// Msg describes the problem
+	Msg string
+}
+
+// Error returns the message of the MarkupError with its position
+func (e *MarkupError) Error() string {
+	return "ParseMarkup: " + e.Msg + " at " + strconv.FormatInt(e.Index, 10)
+}
+
+// Markup is the plain text of parsed markup, with a span for each element
+// covering the text it encloses. Each span attribute is the *MarkupTag of
+// its element, so the attributes of a rune are the tags enclosing it from
+// the outermost to the innermost
+//
+// All indices are in the native units of the source reader. SourceIndex and
+// PlainIndex convert between the plain text and the source
+type Markup struct {
+	*AttributedText[*MarkupTag]
+	size  int64
+	edits []escapeEdit
+}
+
+// markupElement is an element which has been opened and not yet closed
+type markupElement struct {
+	tag  *MarkupTag
+	span int // index of the span of the element
+}
+
+// markupParser holds the state of ParseMarkup
+type markupParser struct {
+	text   string
+	runes  bool
+	offset int   // byte offset in the source text
+	src    int64 // native index in the source
+	plain  strings.Builder
+	index  int64 // native index in the plain text
+	edits  []escapeEdit
+	open   []markupElement
+	spans  []Span[*MarkupTag]
+}
+
+// ParseMarkup parses the text of r as tag markup, returning the plain text
+// with the tags removed and a span for each element
+//
+// An element is opened with <name>, <name=value> or <name key=value ...>,
+// where values may be quoted with single or double quotes, and closed with
+// </name> or </>, which closes the innermost element. Elements must be
+// properly nested. A < which is not followed by a letter or slash is plain
+// text, and the \<, \> and \\ escapes produce a literal <, > and \
+//
+// Markup which cannot be parsed is reported with a *MarkupError
+//
+// ParseMarkup was added by go-corelibs
+func ParseMarkup(r RuneReader) (m *Markup, err error) {
+	p := &markupParser{}
+	if p.text, p.runes, err = readText(r); err != nil {
+		return nil, err
+	}
+
+	for p.offset < len(p.text) {
+		switch c := p.text[p.offset]; {
+		case c == '\\' && p.offset+1 < len(p.text) && strings.IndexByte(`\<>`, p.text[p.offset+1]) >= 0:
+			p.remove(1)
+			p.keep(1)
+		case c == '<' && p.offset+1 < len(p.text) && isTagStart(p.text[p.offset+1]):
+			if err = p.tag(); err != nil {
+				return nil, err
+			}
+		default:
+			_, width := utf8.DecodeRuneInString(p.text[p.offset:])
+			p.keep(width)
+		}
+	}
+	if n := len(p.open); n > 0 {
+		tag := p.open[n-1].tag
+		return nil, &MarkupError{Index: tag.Index, Msg: "unclosed tag <" + tag.Name + ">"}
+	}
+
+	m = &Markup{
+		AttributedText: NewAttributedText[*MarkupTag](textReaderFor(p.plain.String(), p.runes)),
+		size:           r.Size(),
+		edits:          p.edits,
+	}
+	for _, span := range p.spans {
+		_ = m.AddSpan(span.Start, span.End, span.Attr)
+	}
+	return m, nil
+}
+
+// units returns the length of s in native units
+func (p *markupParser) units(s string) int64 {
+	if p.runes {
+		return int64(utf8.RuneCountInString(s))
+	}
+	return int64(len(s))
+}
+
+// keep copies the next width bytes of the source to the plain text
+func (p *markupParser) keep(width int) {
+	s := p.text[p.offset : p.offset+width]
+	p.plain.WriteString(s)
+	units := p.units(s)
+	p.index += units
+	p.src += units
+	p.offset += width
+}
+
+// remove skips the next width bytes of the source
+func (p *markupParser) remove(width int) {
+	units := p.units(p.text[p.offset : p.offset+width])
+	if n := len(p.edits) - 1; n >= 0 && p.edits[n].visible == p.index {
+		p.edits[n].width += units
+	} else {
+		p.edits = append(p.edits, escapeEdit{src: p.src, width: units, visible: p.index})
+	}
+	p.src += units
+	p.offset += width
+}
+
+// tag parses the opening or closing tag at the current position
+func (p *markupParser) tag() error {
+	width := scanTag(p.text[p.offset:])
+	if width < 0 {
+		return &MarkupError{Index: p.src, Msg: "unterminated tag"}
+	}
+	body := p.text[p.offset+1 : p.offset+width-1]
+
+	if name, ok := strings.CutPrefix(body, "/"); ok {
+		name = strings.TrimSpace(name)
+		n := len(p.open) - 1
+		if n < 0 {
+			return &MarkupError{Index: p.src, Msg: "unexpected closing tag </" + name + ">"}
+		} else if top := p.open[n].tag; name != "" && name != top.Name {
+			return &MarkupError{Index: p.src, Msg: "mismatched closing tag </" + name + ">, expected </" + top.Name + ">"}
+		}
+		p.spans[p.open[n].span].End = p.index
+		p.open = p.open[:n]
+	} else {
+		tag, ok := parseTag(body)
+		if !ok {
+			return &MarkupError{Index: p.src, Msg: "malformed tag <" + body + ">"}
+		}
+		tag.Index = p.src
+		p.open = append(p.open, markupElement{tag: tag, span: len(p.spans)})
+		p.spans = append(p.spans, Span[*MarkupTag]{Start: p.index, Attr: tag})
+	}
+	p.remove(width)
+	return nil
+}
+
+// isTagStart returns true if b may follow the < of a tag
+func isTagStart(b byte) bool {
+	return b == '/' || 'a' <= b && b <= 'z' || 'A' <= b && b <= 'Z'
+}
+
+// isTagName returns true if b may be part of a tag or attribute name
+func isTagName(b byte) bool {
+	return b == '-' || b == '_' || b == '.' || b == ':' ||
+		'a' <= b && b <= 'z' || 'A' <= b && b <= 'Z' || '0' <= b && b <= '9'
+}
+
+// scanTag returns the width of the tag at the start of s, including the
+// angle brackets, or -1 if it is not terminated
+func scanTag(s string) int {
+	var quote byte
+	for idx := 1; idx < len(s); idx++ {
+		switch c := s[idx]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '>':
+			return idx + 1
+		case c == '<':
+			return -1
+		}
+	}
+	return -1
+}
+
+// scanTagName returns the name at the start of s and the rest of s
+func scanTagName(s string) (name, rest string) {
+	var idx int
+	for idx < len(s) && isTagName(s[idx]) {
+		idx++
+	}
+	return s[:idx], s[idx:]
+}
+
+// scanTagValue returns the possibly quoted value at the start of s and the
+// rest of s
+func scanTagValue(s string) (value, rest string, err error) {
+	if s != "" && (s[0] == '"' || s[0] == '\'') {
+		if end := strings.IndexByte(s[1:], s[0]); end >= 0 {
+			return s[1 : end+1], s[end+2:], nil
+		}
+		return "", "", errors.New("unterminated quote")
+	}
+	end := strings.IndexAny(s, " \t\r\n")
+	if end < 0 {
+		end = len(s)
+	}
+	return s[:end], s[end:], nil
+}
+
+// parseTag parses the body of an opening tag
+func parseTag(body string) (tag *MarkupTag, ok bool) {
+	var err error
+	tag = &MarkupTag{}
+	if tag.Name, body = scanTagName(body); tag.Name == "" {
+		return nil, false
+	}
+	if strings.HasPrefix(body, "=") {
+		if tag.Value, body, err = scanTagValue(body[1:]); err != nil {
+			return nil, false
+		}
+	}
+	for {
+		trimmed := strings.TrimLeft(body, " \t\r\n")
+		if trimmed == "" {
+			return tag, true
+		} else if trimmed == body {
+			// attributes must be separated by whitespace
+			return nil, false
+		}
+		var key, value string
+		if key, body = scanTagName(trimmed); key == "" {
+			return nil, false
+		}
+		if strings.HasPrefix(body, "=") {
+			if value, body, err = scanTagValue(body[1:]); err != nil {
+				return nil, false
+			}
+		}
+		if tag.Attrs == nil {
+			tag.Attrs = make(map[string]string)
+		}
+		tag.Attrs[key] = value
+	}
+}
+
+// SourceIndex returns the index in the source of the plain text index given.
+// Tags and escapes preceding a plain rune are included before the source
+// index of that rune, and the end of the plain text maps to the end of the
+// source
+//
+// SourceIndex was added by go-corelibs
+func (m *Markup) SourceIndex(index int64) (src int64, err error) {
+	if index < 0 || index > m.Size() {
+		return -1, errors.New("Markup.SourceIndex: index out of range")
+	} else if index == m.Size() {
+		return m.size, nil
+	}
+	return sourceIndexOf(m.edits, index), nil
+}
+
+// PlainIndex returns the plain text index of the source index given. Indices
+// within a tag or escape map to the plain text following it
+//
+// PlainIndex was added by go-corelibs
+func (m *Markup) PlainIndex(src int64) (index int64, err error) {
+	if src < 0 || src > m.size {
+		return -1, errors.New("Markup.PlainIndex: index out of range")
+	}
+	return visibleIndexOf(m.edits, src), nil
+}
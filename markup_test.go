@@ -0,0 +1,129 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes_test
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	. "github.com/go-corelibs/runes"
+)
+
+func TestParseMarkup(t *testing.T) {
+	for _, src := range newCaseReaders("plain <b>bold</b> and <fg=red>red <i>both</i></>") {
+		m, err := ParseMarkup(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, _ := io.ReadAll(m); string(got) != "plain bold and red both" {
+			t.Errorf("plain text got %q", got)
+		}
+		var got []string
+		for _, span := range m.Spans() {
+			got = append(got, span.Attr.Name+"="+span.Attr.Value)
+			if text, _ := m.ReadString(span.Start, span.End-span.Start); span.Attr.Name == "i" && text != "both" {
+				t.Errorf("<i> text got %q", text)
+			}
+		}
+		if want := []string{"b=", "fg=red", "i="}; !reflect.DeepEqual(got, want) {
+			t.Errorf("spans got %q; want %q", got, want)
+		}
+		if attrs := m.AttrsAt(20); len(attrs) != 2 || attrs[0].Name != "fg" || attrs[1].Name != "i" || attrs[1].Index != 34 {
+			t.Errorf("AttrsAt(20) got %+v", attrs)
+		}
+	}
+}
+
+func TestParseMarkupTags(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		plain string
+		tag   MarkupTag
+	}{
+		{`<a href="https://x/?a>b" title='t t' disabled>link</a>`, "link", MarkupTag{
+			Name: "a", Attrs: map[string]string{"href": "https://x/?a>b", "title": "t t", "disabled": ""},
+		}},
+		{`<fg="light blue" bold>x</fg>`, "x", MarkupTag{Name: "fg", Value: "light blue", Attrs: map[string]string{"bold": ""}}},
+		{`a \<b\> \\ \x <b>y</>`, `a <b> \ \x y`, MarkupTag{Name: "b", Index: 14}},
+		{`a < b <3 <b>y</b>`, `a < b <3 y`, MarkupTag{Name: "b", Index: 9}},
+	} {
+		m, err := ParseMarkup(NewStringReader(tc.input))
+		if err != nil {
+			t.Errorf("%q: %v", tc.input, err)
+			continue
+		}
+		if got, _ := io.ReadAll(m); string(got) != tc.plain {
+			t.Errorf("%q: plain text got %q; want %q", tc.input, got, tc.plain)
+		}
+		if spans := m.Spans(); len(spans) != 1 || !reflect.DeepEqual(*spans[0].Attr, tc.tag) {
+			t.Errorf("%q: spans got %+v; want %+v", tc.input, spans, tc.tag)
+		}
+	}
+}
+
+func TestParseMarkupErrors(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		index int64
+		msg   string
+	}{
+		{"</b>", 0, "ParseMarkup: unexpected closing tag </b> at 0"},
+		{"<b>x</i>", 4, "ParseMarkup: mismatched closing tag </i>, expected </b> at 4"},
+		{"a<b>x<i>y</i>", 1, "ParseMarkup: unclosed tag <b> at 1"},
+		{"ab<b x", 2, "ParseMarkup: unterminated tag at 2"},
+		{`<b x="y>`, 0, "ParseMarkup: unterminated tag at 0"},
+		{"<b =x>", 0, "ParseMarkup: malformed tag <b =x> at 0"},
+		{`<b x="y"z>`, 0, `ParseMarkup: malformed tag <b x="y"z> at 0`},
+	} {
+		_, err := ParseMarkup(NewStringReader(tc.input))
+		var me *MarkupError
+		if !errors.As(err, &me) || me.Index != tc.index || err.Error() != tc.msg {
+			t.Errorf("%q: got %v; want %q", tc.input, err, tc.msg)
+		}
+	}
+}
+
+func TestMarkupIndex(t *testing.T) {
+	for _, src := range newCaseReaders(`ab<b>cd</b>\<e`) {
+		m, err := ParseMarkup(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for index, want := range []int64{0, 1, 5, 6, 12, 13, 14} {
+			if got, err := m.SourceIndex(int64(index)); got != want || err != nil {
+				t.Errorf("SourceIndex(%d): got %d,%v; want %d", index, got, err, want)
+			}
+		}
+		for index, want := range map[int64]int64{0: 0, 2: 2, 3: 2, 5: 2, 6: 3, 7: 4, 12: 4, 13: 5, 14: 6} {
+			if got, err := m.PlainIndex(index); got != want || err != nil {
+				t.Errorf("PlainIndex(%d): got %d,%v; want %d", index, got, err, want)
+			}
+		}
+		if _, err = m.SourceIndex(7); err == nil {
+			t.Errorf("SourceIndex(7): expected error")
+		}
+		if _, err = m.PlainIndex(15); err == nil {
+			t.Errorf("PlainIndex(15): expected error")
+		}
+	}
+
+	input := "<b>\u4e16</b>x"
+	m, _ := ParseMarkup(NewRunesReader([]rune(input)))
+	if got, _ := m.SourceIndex(1); got != 8 {
+		t.Errorf("runes SourceIndex(1): got %d; want 8", got)
+	}
+	if spans := m.Spans(); len(spans) != 1 || spans[0].End != 1 {
+		t.Errorf("runes spans got %+v", spans)
+	}
+	m, _ = ParseMarkup(NewStringReader(input))
+	if got, _ := m.SourceIndex(3); got != 10 {
+		t.Errorf("string SourceIndex(3): got %d; want 10", got)
+	}
+	if spans := m.Spans(); len(spans) != 1 || spans[0].End != 3 {
+		t.Errorf("string spans got %+v", spans)
+	}
+}
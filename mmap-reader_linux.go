@@ -0,0 +1,322 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"errors"
+	"io"
+	"os"
+	"runtime/debug"
+	"strings"
+	"syscall"
+)
+
+// ErrTruncated is returned by MmapReader methods when the underlying file was
+// truncated after it was mapped and the data requested is no longer present
+var ErrTruncated = errors.New("MmapReader: file truncated")
+
+// MmapReader is a RuneReader over a read-only memory mapping of a file. The
+// data is decoded the same way as a BytesReader and none of it is copied into
+// the Go heap until requested
+//
+// If the file is truncated while mapped, any method accessing the missing
+// data returns ErrTruncated instead of crashing the process. Close must be
+// called to release the mapping
+//
+// MmapReader is only available on Linux
+type MmapReader struct {
+	text textReader[[]byte]
+	data []byte
+}
+
+// OpenMmapReader maps the named file into memory and returns a new MmapReader
+// reading from it. Empty files are not mapped and read as an empty slice
+//
+// OpenMmapReader was added by go-corelibs
+func OpenMmapReader(name string) (r *MmapReader, err error) {
+	var fh *os.File
+	if fh, err = os.Open(name); err != nil {
+		return nil, err
+	}
+	// the mapping remains valid after the file is closed
+	defer fh.Close()
+
+	var info os.FileInfo
+	if info, err = fh.Stat(); err != nil {
+		return nil, err
+	}
+	size := info.Size()
+	if size < 0 || int64(int(size)) != size {
+		return nil, &os.PathError{Op: "mmap", Path: name, Err: syscall.EFBIG}
+	}
+
+	r = &MmapReader{}
+	if size > 0 {
+		if r.data, err = syscall.Mmap(int(fh.Fd()), 0, int(size), syscall.PROT_READ, syscall.MAP_SHARED); err != nil {
+			return nil, &os.PathError{Op: "mmap", Path: name, Err: err}
+		}
+	}
+	r.text.Reset(r.data)
+	return r, nil
+}
+
+// Close releases the memory mapping. After Close, the MmapReader behaves as
+// if reading from an empty file
+//
+// Close was added by go-corelibs
+func (r *MmapReader) Close() (err error) {
+	r.text.Reset(nil)
+	if r.data != nil {
+		err = syscall.Munmap(r.data)
+		r.data = nil
+	}
+	return
+}
+
+// guard is deferred by every method which accesses the mapped memory, with
+// the previous result of debug.SetPanicOnFault(true), converting the fault
+// raised by reading past the end of a truncated file into ErrTruncated
+func (r *MmapReader) guard(prev bool, err *error) {
+	debug.SetPanicOnFault(prev)
+	if v := recover(); v != nil {
+		if _, ok := v.(interface{ Addr() uintptr }); ok {
+			*err = ErrTruncated
+			return
+		}
+		panic(v)
+	}
+}
+
+// runeIndexed is always false, the native units of a MmapReader are bytes
+func (r *MmapReader) runeIndexed() bool { return false }
+
+// Len returns the number of bytes of the unread portion of the mapping
+func (r *MmapReader) Len() int { return r.text.Len() }
+
+// Size returns the original length of the mapping
+func (r *MmapReader) Size() int64 { return r.text.Size() }
+
+// Read implements the [io.Reader] interface
+func (r *MmapReader) Read(b []byte) (n int, err error) {
+	defer r.guard(debug.SetPanicOnFault(true), &err)
+	return r.text.Read(b)
+}
+
+// ReadAt implements the [io.ReaderAt] interface
+func (r *MmapReader) ReadAt(b []byte, off int64) (n int, err error) {
+	defer r.guard(debug.SetPanicOnFault(true), &err)
+	return r.text.ReadAt(b, off)
+}
+
+// ReadByte implements the [io.ByteReader] interface
+func (r *MmapReader) ReadByte() (b byte, err error) {
+	defer r.guard(debug.SetPanicOnFault(true), &err)
+	return r.text.ReadByte()
+}
+
+// UnreadByte complements ReadByte in implementing the [io.ByteScanner]
+// interface
+func (r *MmapReader) UnreadByte() error { return r.text.UnreadByte() }
+
+// ReadRune implements the [io.RuneReader] interface
+func (r *MmapReader) ReadRune() (ch rune, size int, err error) {
+	defer r.guard(debug.SetPanicOnFault(true), &err)
+	return r.text.ReadRune()
+}
+
+// UnreadRune complements ReadRune in implementing the [io.RuneScanner]
+// interface
+func (r *MmapReader) UnreadRune() error { return r.text.UnreadRune() }
+
+// Seek implements the [io.Seeker] interface
+func (r *MmapReader) Seek(offset int64, whence int) (int64, error) {
+	return r.text.Seek(offset, whence)
+}
+
+// WriteTo implements the [io.WriterTo] interface
+func (r *MmapReader) WriteTo(w io.Writer) (n int64, err error) {
+	defer r.guard(debug.SetPanicOnFault(true), &err)
+	return r.text.WriteTo(w)
+}
+
+// ReadRuneAt is the MmapReader version of BytesReader.ReadRuneAt
+//
+// ReadRuneAt was added by go-corelibs
+func (r *MmapReader) ReadRuneAt(index int64) (ch rune, size int, err error) {
+	defer r.guard(debug.SetPanicOnFault(true), &err)
+	return r.text.ReadRuneAt(index)
+}
+
+// ReadPrevRuneFrom is the MmapReader version of BytesReader.ReadPrevRuneFrom
+//
+// ReadPrevRuneFrom was added by go-corelibs
+func (r *MmapReader) ReadPrevRuneFrom(index int64) (ch rune, size int, err error) {
+	defer r.guard(debug.SetPanicOnFault(true), &err)
+	return r.text.ReadPrevRuneFrom(index)
+}
+
+// ReadNextRuneFrom is the MmapReader version of BytesReader.ReadNextRuneFrom
+//
+// ReadNextRuneFrom was added by go-corelibs
+func (r *MmapReader) ReadNextRuneFrom(index int64) (ch rune, size int, err error) {
+	defer r.guard(debug.SetPanicOnFault(true), &err)
+	return r.text.ReadNextRuneFrom(index)
+}
+
+// ReadRuneSlice is the MmapReader version of BytesReader.ReadRuneSlice
+//
+// ReadRuneSlice was added by go-corelibs
+func (r *MmapReader) ReadRuneSlice(index, count int64) (slice []rune, size int, err error) {
+	defer r.guard(debug.SetPanicOnFault(true), &err)
+	return r.text.ReadRuneSlice(index, count)
+}
+
+// ReadByteSlice is the MmapReader version of BytesReader.ReadByteSlice. The
+// slice returned is a copy and remains valid after Close
+//
+// ReadByteSlice was added by go-corelibs
+func (r *MmapReader) ReadByteSlice(index, count int64) (slice []byte, err error) {
+	defer r.guard(debug.SetPanicOnFault(true), &err)
+	return r.text.ReadByteSlice(index, count)
+}
+
+// ReadString is the MmapReader version of BytesReader.ReadString
+//
+// ReadString was added by go-corelibs
+func (r *MmapReader) ReadString(index, count int64) (slice string, err error) {
+	defer r.guard(debug.SetPanicOnFault(true), &err)
+	return r.text.ReadString(index, count)
+}
+
+// AppendRuneSlice is the MmapReader version of BytesReader.AppendRuneSlice
+//
+// AppendRuneSlice was added by go-corelibs
+func (r *MmapReader) AppendRuneSlice(dst []rune, index, count int64) (slice []rune, size int, err error) {
+	defer r.guard(debug.SetPanicOnFault(true), &err)
+	return r.text.AppendRuneSlice(dst, index, count)
+}
+
+// AppendByteSlice is the MmapReader version of BytesReader.AppendByteSlice
+//
+// AppendByteSlice was added by go-corelibs
+func (r *MmapReader) AppendByteSlice(dst []byte, index, count int64) (slice []byte, err error) {
+	defer r.guard(debug.SetPanicOnFault(true), &err)
+	return r.text.AppendByteSlice(dst, index, count)
+}
+
+// AppendString is the MmapReader version of BytesReader.AppendString
+//
+// AppendString was added by go-corelibs
+func (r *MmapReader) AppendString(dst *strings.Builder, index, count int64) (err error) {
+	defer r.guard(debug.SetPanicOnFault(true), &err)
+	return r.text.AppendString(dst, index, count)
+}
+
+// NextWordBoundary is the MmapReader version of BytesReader.NextWordBoundary
+//
+// NextWordBoundary was added by go-corelibs
+func (r *MmapReader) NextWordBoundary(index int64) (next int64, err error) {
+	return nextBoundary(r, "MmapReader.NextWordBoundary", index, wordSegments)
+}
+
+// PrevWordBoundary is the MmapReader version of BytesReader.PrevWordBoundary
+//
+// PrevWordBoundary was added by go-corelibs
+func (r *MmapReader) PrevWordBoundary(index int64) (prev int64, err error) {
+	return prevBoundary(r, "MmapReader.PrevWordBoundary", index, wordSegments)
+}
+
+// NextSentenceBoundary is the MmapReader version of
+// BytesReader.NextSentenceBoundary
+//
+// NextSentenceBoundary was added by go-corelibs
+func (r *MmapReader) NextSentenceBoundary(index int64) (next int64, err error) {
+	return nextBoundary(r, "MmapReader.NextSentenceBoundary", index, sentenceSegments)
+}
+
+// PrevSentenceBoundary is the MmapReader version of
+// BytesReader.PrevSentenceBoundary
+//
+// PrevSentenceBoundary was added by go-corelibs
+func (r *MmapReader) PrevSentenceBoundary(index int64) (prev int64, err error) {
+	return prevBoundary(r, "MmapReader.PrevSentenceBoundary", index, sentenceSegments)
+}
+
+// Words is the MmapReader version of BytesReader.Words
+//
+// Words was added by go-corelibs
+func (r *MmapReader) Words() *Segments {
+	return newSegments(r, wordSegments)
+}
+
+// Sentences is the MmapReader version of BytesReader.Sentences
+//
+// Sentences was added by go-corelibs
+func (r *MmapReader) Sentences() *Segments {
+	return newSegments(r, sentenceSegments)
+}
+
+// ReadEmojiAt is the MmapReader version of BytesReader.ReadEmojiAt
+//
+// ReadEmojiAt was added by go-corelibs
+func (r *MmapReader) ReadEmojiAt(index int64) (emoji Emoji, err error) {
+	emoji, _, err = readEmojiAt(r, "MmapReader.ReadEmojiAt", index)
+	return
+}
+
+// Emojis is the MmapReader version of BytesReader.Emojis
+//
+// Emojis was added by go-corelibs
+func (r *MmapReader) Emojis() *EmojiSpans {
+	return newEmojiSpans(r, "MmapReader.Emojis")
+}
+
+// ReadWhile is the MmapReader version of BytesReader.ReadWhile
+//
+// ReadWhile was added by go-corelibs
+func (r *MmapReader) ReadWhile(index int64, fn func(ch rune) bool) (slice []rune, size int, err error) {
+	defer r.guard(debug.SetPanicOnFault(true), &err)
+	return r.text.ReadWhile(index, fn)
+}
+
+// ReadUntil is the MmapReader version of BytesReader.ReadUntil
+//
+// ReadUntil was added by go-corelibs
+func (r *MmapReader) ReadUntil(index int64, delims *RuneSet, inclusive bool) (slice []rune, size int, err error) {
+	defer r.guard(debug.SetPanicOnFault(true), &err)
+	return r.text.ReadUntil(index, delims, inclusive)
+}
+
+// SkipWhile is the MmapReader version of BytesReader.SkipWhile
+//
+// SkipWhile was added by go-corelibs
+func (r *MmapReader) SkipWhile(index int64, fn func(ch rune) bool) (next int64, err error) {
+	defer r.guard(debug.SetPanicOnFault(true), &err)
+	return r.text.SkipWhile(index, fn)
+}
+
+// ReadLine is the MmapReader version of BytesReader.ReadLine
+//
+// ReadLine was added by go-corelibs
+func (r *MmapReader) ReadLine(index int64) (line []rune, next int64, err error) {
+	defer r.guard(debug.SetPanicOnFault(true), &err)
+	return r.text.ReadLine(index)
+}
+
+// IndexAny is the MmapReader version of BytesReader.IndexAny
+//
+// IndexAny was added by go-corelibs
+func (r *MmapReader) IndexAny(index int64, set *RuneSet) (found int64, err error) {
+	defer r.guard(debug.SetPanicOnFault(true), &err)
+	return r.text.IndexAny(index, set)
+}
+
+// Accept is the MmapReader version of BytesReader.Accept
+//
+// Accept was added by go-corelibs
+func (r *MmapReader) Accept(index int64, set *RuneSet) (ok bool, size int, err error) {
+	defer r.guard(debug.SetPanicOnFault(true), &err)
+	return r.text.Accept(index, set)
+}
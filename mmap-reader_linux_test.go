@@ -0,0 +1,143 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes_test
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode"
+
+	. "github.com/go-corelibs/runes"
+)
+
+func writeTempFile(t *testing.T, content string) (name string) {
+	name = filepath.Join(t.TempDir(), "mmap.txt")
+	if err := os.WriteFile(name, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return
+}
+
+func TestMmapReader(t *testing.T) {
+	text := strings.Repeat("hello, 世界 \U0001F600\n", 1000)
+	r, err := OpenMmapReader(writeTempFile(t, text))
+	if err != nil {
+		t.Fatalf("OpenMmapReader: unexpected error: %v", err)
+	}
+	defer r.Close()
+
+	var rr RuneReader = r
+	br := NewBytesReader([]byte(text))
+	if rr.Size() != br.Size() {
+		t.Errorf("Size: got %d; want %d", rr.Size(), br.Size())
+	}
+	for _, index := range []int64{0, 7, 8, 9, 10, 14, int64(len(text)) - 1, int64(len(text))} {
+		ch, size, err := rr.ReadRuneAt(index)
+		ech, esize, eerr := br.ReadRuneAt(index)
+		if ch != ech || size != esize || err != eerr {
+			t.Errorf("ReadRuneAt(%d): got %q,%d,%v; want %q,%d,%v", index, ch, size, err, ech, esize, eerr)
+		}
+		slice, size, err := rr.ReadRuneSlice(index, 5)
+		eslice, esize, eerr := br.ReadRuneSlice(index, 5)
+		if string(slice) != string(eslice) || size != esize || err != eerr {
+			t.Errorf("ReadRuneSlice(%d): got %q,%d,%v; want %q,%d,%v", index, string(slice), size, err, string(eslice), esize, eerr)
+		}
+	}
+
+	var words, ewords int
+	for it, eit := r.Words(), br.Words(); it.Next(); words++ {
+		if eit.Next() {
+			ewords++
+		}
+		if it.Start() != eit.Start() || it.End() != eit.End() {
+			t.Errorf("Words: got %d-%d; want %d-%d", it.Start(), it.End(), eit.Start(), eit.End())
+			break
+		}
+	}
+	if words != 8000 || words != ewords {
+		t.Errorf("Words: got %d, %d; want 8000", words, ewords)
+	}
+	var emojis int
+	for it := r.Emojis(); it.Next(); emojis++ {
+		if e := it.Emoji(); e.Kind != EmojiBasic || e.Size != 4 {
+			t.Errorf("Emojis: got %+v", e)
+			break
+		}
+	}
+	if emojis != 1000 {
+		t.Errorf("Emojis: got %d; want 1000", emojis)
+	}
+	if e, err := r.ReadEmojiAt(14); e.Size != 4 || err != nil {
+		t.Errorf("ReadEmojiAt(14): got %+v,%v", e, err)
+	}
+	if found, err := r.IndexAny(0, NewRuneSet("\n")); found != 18 || err != nil {
+		t.Errorf("IndexAny(0): got %d,%v; want 18", found, err)
+	}
+	if ok, size, err := r.Accept(7, NewRuneSetTable(unicode.Han)); !ok || size != 3 || err != nil {
+		t.Errorf("Accept(7): got %v,%d,%v", ok, size, err)
+	}
+	if next, err := r.NextSentenceBoundary(0); next != 19 || err != nil {
+		t.Errorf("NextSentenceBoundary(0): got %d,%v; want 19", next, err)
+	}
+
+	_, _ = rr.Seek(0, io.SeekStart)
+	if data, err := io.ReadAll(rr); err != nil || string(data) != text {
+		t.Errorf("ReadAll: got %d bytes, %v; want %d bytes", len(data), err, len(text))
+	}
+
+	if err := r.Close(); err != nil {
+		t.Errorf("Close: unexpected error: %v", err)
+	}
+	if ch, size, err := r.ReadRuneAt(0); ch != 0 || size != 0 || err != io.EOF {
+		t.Errorf("ReadRuneAt after Close: got %q,%d,%v; want 0,0,io.EOF", ch, size, err)
+	}
+	if err := r.Close(); err != nil {
+		t.Errorf("Close: unexpected error on second call: %v", err)
+	}
+}
+
+func TestMmapReaderTruncated(t *testing.T) {
+	text := strings.Repeat("0123456789", 1<<12)
+	name := writeTempFile(t, text)
+	r, err := OpenMmapReader(name)
+	if err != nil {
+		t.Fatalf("OpenMmapReader: unexpected error: %v", err)
+	}
+	defer r.Close()
+
+	if err = os.Truncate(name, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = r.ReadRuneAt(int64(len(text)) - 1); err != ErrTruncated {
+		t.Errorf("ReadRuneAt: got %v; want ErrTruncated", err)
+	}
+	if _, err = r.ReadString(0, 10); err != ErrTruncated {
+		t.Errorf("ReadString: got %v; want ErrTruncated", err)
+	}
+	if _, err = io.ReadAll(r); err != ErrTruncated {
+		t.Errorf("ReadAll: got %v; want ErrTruncated", err)
+	}
+}
+
+func TestMmapReaderEmpty(t *testing.T) {
+	r, err := OpenMmapReader(writeTempFile(t, ""))
+	if err != nil {
+		t.Fatalf("OpenMmapReader: unexpected error: %v", err)
+	}
+	if _, _, err = r.ReadRune(); err != io.EOF {
+		t.Errorf("ReadRune: got %v; want io.EOF", err)
+	}
+	if err = r.Close(); err != nil {
+		t.Errorf("Close: unexpected error: %v", err)
+	}
+
+	if _, err = OpenMmapReader(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Errorf("OpenMmapReader: expected error for missing file")
+	}
+}
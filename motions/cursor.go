@@ -0,0 +1,217 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+// Package motions provides vim and emacs style text motions over any
+// runes.RuneReader
+//
+// Each motion takes a reader and a start index and returns the target index,
+// in the native units of the reader: bytes for runes.BytesReader and
+// runes.StringReader, runes for runes.Reader. Motions move between the UAX #29
+// grapheme clusters of the text, so the target is never inside a cluster and
+// a start index inside a cluster is treated as the start of that cluster.
+// Lines end after each line feed and the position of the reader is
+// unspecified after each motion
+package motions
+
+import (
+	"errors"
+	"io"
+	"unicode"
+
+	"github.com/clipperhouse/uax29/v2/graphemes"
+
+	"github.com/go-corelibs/runes"
+)
+
+// cluster is one grapheme cluster of the text
+type cluster struct {
+	index   int64 // native index of the cluster
+	end     int64 // native index following the cluster
+	ch      rune  // the first rune of the cluster
+	first   bool  // the cluster is the first of its line
+	newline bool  // the cluster ends with a line feed
+}
+
+// blank returns true if the cluster is whitespace, including line endings
+func (c *cluster) blank() bool {
+	return unicode.IsSpace(c.ch)
+}
+
+// cursor walks the grapheme clusters of a reader one line at a time. The
+// position following the last cluster of the text is represented by an index
+// equal to the length of the last line
+type cursor struct {
+	r     runes.RuneReader
+	size  int64
+	start int64 // native index of the current line
+	end   int64 // native index following the current line
+	line  []cluster
+	i     int
+}
+
+// newCursor returns a cursor positioned at the cluster containing the index
+// given, or at the end of the text when the index is the size
+func newCursor(r runes.RuneReader, name string, index int64) (c *cursor, err error) {
+	c = &cursor{r: r, size: r.Size()}
+	if index < 0 {
+		return nil, errors.New(name + ": negative position")
+	} else if index > c.size {
+		return nil, io.EOF
+	}
+	var start int64
+	if start, err = c.lineStart(index); err != nil {
+		return nil, err
+	} else if err = c.load(start); err != nil {
+		return nil, err
+	}
+	for c.i = 0; c.i < len(c.line) && c.line[c.i].end <= index; c.i++ {
+	}
+	return c, nil
+}
+
+// lineStart returns the start of the line containing the index given
+func (c *cursor) lineStart(index int64) (start int64, err error) {
+	for start = index; start > 0; {
+		var ch rune
+		var width int
+		if ch, width, err = c.r.ReadPrevRuneFrom(start); err != nil {
+			return -1, err
+		} else if ch == '\n' {
+			break
+		}
+		start -= int64(width)
+	}
+	return start, nil
+}
+
+// load reads the clusters of the line starting at the index given
+func (c *cursor) load(start int64) (err error) {
+	var text []byte
+	var indices []int64 // native index of each byte of text, plus the end
+	index := start
+	for index < c.size {
+		var ch rune
+		var width int
+		if ch, width, err = c.r.ReadRuneAt(index); err != nil {
+			return err
+		}
+		n := len(text)
+		text = append(text, string(ch)...)
+		for range text[n:] {
+			indices = append(indices, index)
+		}
+		if index += int64(width); ch == '\n' {
+			break
+		}
+	}
+	indices = append(indices, index)
+
+	c.start, c.end, c.line = start, index, c.line[:0]
+	it := graphemes.FromBytes(text)
+	for it.Next() {
+		value := it.Value()
+		ch, last := []rune(string(value))[0], value[len(value)-1]
+		c.line = append(c.line, cluster{
+			index:   indices[it.Start()],
+			end:     indices[it.End()],
+			ch:      ch,
+			first:   it.Start() == 0,
+			newline: last == '\n',
+		})
+	}
+	return nil
+}
+
+// atEnd returns true if the cursor is past the last cluster of the text
+func (c *cursor) atEnd() bool {
+	return c.i >= len(c.line)
+}
+
+// cur returns the current cluster, which must not be at the end
+func (c *cursor) cur() *cluster {
+	return &c.line[c.i]
+}
+
+// pos returns the native index of the cursor
+func (c *cursor) pos() int64 {
+	if c.atEnd() {
+		return c.end
+	}
+	return c.line[c.i].index
+}
+
+// next moves to the next cluster, returning false when there is none and
+// the cursor is left at the end of the text
+func (c *cursor) next() (ok bool, err error) {
+	if c.i+1 < len(c.line) {
+		c.i++
+		return true, nil
+	} else if c.end >= c.size {
+		c.i = len(c.line)
+		return false, nil
+	} else if err = c.load(c.end); err != nil {
+		return false, err
+	}
+	c.i = 0
+	return len(c.line) > 0, nil
+}
+
+// prev moves to the previous cluster, returning false at the start of the
+// text
+func (c *cursor) prev() (ok bool, err error) {
+	if c.i > 0 {
+		c.i--
+		return true, nil
+	} else if c.start <= 0 {
+		return false, nil
+	}
+	var start int64
+	if start, err = c.lineStart(c.start - 1); err != nil {
+		return false, err
+	} else if err = c.load(start); err != nil {
+		return false, err
+	}
+	c.i = len(c.line) - 1
+	return true, nil
+}
+
+// blankLine returns true if the current line is empty or only whitespace
+func (c *cursor) blankLine() bool {
+	for i := range c.line {
+		if !c.line[i].blank() {
+			return false
+		}
+	}
+	return true
+}
+
+// nextLine moves to the start of the next line, returning false at the end
+// of the text
+func (c *cursor) nextLine() (ok bool, err error) {
+	if c.end >= c.size {
+		c.i = len(c.line)
+		return false, nil
+	} else if err = c.load(c.end); err != nil {
+		return false, err
+	}
+	c.i = 0
+	return true, nil
+}
+
+// prevLine moves to the start of the previous line, returning false at the
+// start of the text
+func (c *cursor) prevLine() (ok bool, err error) {
+	if c.start <= 0 {
+		c.i = 0
+		return false, nil
+	}
+	var start int64
+	if start, err = c.lineStart(c.start - 1); err != nil {
+		return false, err
+	} else if err = c.load(start); err != nil {
+		return false, err
+	}
+	c.i = 0
+	return true, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package motions
+
+import (
+	"errors"
+
+	"github.com/go-corelibs/runes"
+)
+
+// LineStart returns the index of the start of the line containing the index
+// given, like the vim 0 motion and the emacs C-a command
+//
+// LineStart was added by go-corelibs
+func LineStart(r runes.RuneReader, index int64) (start int64, err error) {
+	var c *cursor
+	if c, err = newCursor(r, "LineStart", index); err != nil {
+		return -1, err
+	}
+	return c.start, nil
+}
+
+// LineEnd returns the index of the end of the line containing the index
+// given, before its line ending, like the emacs C-e command. This is the
+// position following the last cluster of the line, the vim $ motion is the
+// cluster before it
+//
+// LineEnd was added by go-corelibs
+func LineEnd(r runes.RuneReader, index int64) (end int64, err error) {
+	var c *cursor
+	if c, err = newCursor(r, "LineEnd", index); err != nil {
+		return -1, err
+	} else if n := len(c.line); n > 0 && c.line[n-1].newline {
+		return c.line[n-1].index, nil
+	}
+	return c.end, nil
+}
+
+// FirstNonBlank returns the index of the first cluster of the line containing
+// the index given which is not whitespace, like the vim ^ motion and the
+// emacs M-m command, or the end of the line when it is blank
+//
+// FirstNonBlank was added by go-corelibs
+func FirstNonBlank(r runes.RuneReader, index int64) (next int64, err error) {
+	var c *cursor
+	if c, err = newCursor(r, "FirstNonBlank", index); err != nil {
+		return -1, err
+	}
+	for i := range c.line {
+		if cl := &c.line[i]; !cl.blank() || cl.newline {
+			return cl.index, nil
+		}
+	}
+	return c.end, nil
+}
+
+// FindForward returns the index of the next cluster after the index given on
+// the same line whose first rune is ch, like the vim f motion. When till is
+// true, the index of the cluster before it is returned instead, like the vim
+// t motion
+//
+// FindForward was added by go-corelibs
+func FindForward(r runes.RuneReader, index int64, ch rune, till bool) (next int64, err error) {
+	var c *cursor
+	if c, err = newCursor(r, "FindForward", index); err != nil {
+		return -1, err
+	}
+	for i := c.i + 1; i < len(c.line); i++ {
+		if c.line[i].ch == ch {
+			if till {
+				return c.line[i-1].index, nil
+			}
+			return c.line[i].index, nil
+		}
+	}
+	return -1, errors.New("FindForward: rune not found")
+}
+
+// FindBackward returns the index of the previous cluster before the index
+// given on the same line whose first rune is ch, like the vim F motion. When
+// till is true, the index of the cluster after it is returned instead, like
+// the vim T motion
+//
+// FindBackward was added by go-corelibs
+func FindBackward(r runes.RuneReader, index int64, ch rune, till bool) (prev int64, err error) {
+	var c *cursor
+	if c, err = newCursor(r, "FindBackward", index); err != nil {
+		return -1, err
+	}
+	for i := c.i - 1; i >= 0; i-- {
+		if c.line[i].ch == ch {
+			if till && i+1 == len(c.line) {
+				return c.end, nil
+			} else if till {
+				return c.line[i+1].index, nil
+			}
+			return c.line[i].index, nil
+		}
+	}
+	return -1, errors.New("FindBackward: rune not found")
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package motions_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/go-corelibs/runes"
+	. "github.com/go-corelibs/runes/motions"
+)
+
+const lineText = "  ab\u0301c d\tx\r\n\t \r\nlast a\U0001F469\u200d\U0001F4BBb"
+
+func TestLineMotions(t *testing.T) {
+	find := func(fn func(runes.RuneReader, int64, rune, bool) (int64, error), ch rune, till bool) motion {
+		return func(r runes.RuneReader, index int64) (int64, error) { return fn(r, index, ch, till) }
+	}
+	at := func(sub string, n int) int { return offset(lineText, sub, n) }
+	blank := at("\t ", 0)
+	for _, tc := range []struct {
+		name     string
+		fn       motion
+		from, to int
+	}{
+		{"0", LineStart, at("x", 0), 0},
+		{"0", LineStart, at(" \r", 0), blank},
+		{"0", LineStart, at("b", 1), at("last", 0)},
+		{"0", LineStart, len(lineText), at("last", 0)},
+		{"$", LineEnd, 0, at("\r", 0)},
+		{"$", LineEnd, blank, at("\r", 1)},
+		{"$", LineEnd, at("last", 0), len(lineText)},
+		{"^", FirstNonBlank, at("x", 0), at("a", 0)},
+		{"^", FirstNonBlank, blank, at("\r", 1)},
+		{"^", FirstNonBlank, len(lineText), at("last", 0)},
+		{"fc", find(FindForward, 'c', false), 0, at("c", 0)},
+		{"fb", find(FindForward, 'b', false), 0, at("b", 0)},
+		{"tc", find(FindForward, 'c', true), 0, at("b", 0)}, // not inside the cluster
+		{"tb", find(FindForward, 'b', true), at("a", 1), at("\U0001F469", 0)},
+		{"Fa", find(FindBackward, 'a', false), at("x", 0), at("a", 0)},
+		{"Ta", find(FindBackward, 'a', true), at("x", 0), at("b", 0)},
+		{"Ta", find(FindBackward, 'a', true), at("b", 1), at("\U0001F469", 0)},
+		{"Tb", find(FindBackward, 'b', true), len(lineText), len(lineText)},
+	} {
+		checkMotion(t, tc.name, tc.fn, lineText, tc.from, tc.to)
+	}
+
+	for _, r := range newReaders(lineText) {
+		if _, err := FindForward(r, 0, 'l', false); err == nil || err.Error() != "FindForward: rune not found" {
+			t.Errorf("%T FindForward on another line: got %v", r, err)
+		}
+		if _, err := FindBackward(r, 0, 'a', false); err == nil || err.Error() != "FindBackward: rune not found" {
+			t.Errorf("%T FindBackward at the start: got %v", r, err)
+		}
+		if _, err := LineStart(r, r.Size()+1); err != io.EOF {
+			t.Errorf("%T LineStart(size+1): got %v", r, err)
+		}
+		if _, err := LineEnd(r, -1); err == nil || err.Error() != "LineEnd: negative position" {
+			t.Errorf("%T LineEnd(-1): got %v", r, err)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package motions
+
+import (
+	"io"
+	"strings"
+
+	"github.com/go-corelibs/runes"
+)
+
+// ParagraphForward returns the index of the start of the next blank line
+// after the paragraph containing the index given, like the vim } motion and
+// the emacs M-} command. Blank lines are empty or only whitespace and the
+// size is returned when there are no more blank lines. The size and io.EOF
+// are returned when the index is at or past the end of the text
+//
+// ParagraphForward was added by go-corelibs
+func ParagraphForward(r runes.RuneReader, index int64) (next int64, err error) {
+	if index >= r.Size() && index >= 0 {
+		return r.Size(), io.EOF
+	}
+	var c *cursor
+	if c, err = newCursor(r, "ParagraphForward", index); err != nil {
+		return -1, err
+	}
+	var ok bool
+	for blank := true; ; blank = false {
+		// skip the blank lines, then the paragraph
+		for c.blankLine() == blank {
+			if ok, err = c.nextLine(); err != nil {
+				return -1, err
+			} else if !ok {
+				return c.size, nil
+			}
+		}
+		if !blank {
+			return c.start, nil
+		}
+	}
+}
+
+// ParagraphBackward returns the index of the start of the blank line before
+// the paragraph containing the index given, like the vim { motion and the
+// emacs M-{ command, or zero when there is none. Zero and io.EOF are returned
+// when the index is zero
+//
+// ParagraphBackward was added by go-corelibs
+func ParagraphBackward(r runes.RuneReader, index int64) (prev int64, err error) {
+	if index == 0 {
+		return 0, io.EOF
+	} else if index > r.Size() {
+		index = r.Size()
+	}
+	var c *cursor
+	if c, err = newCursor(r, "ParagraphBackward", index); err != nil {
+		return -1, err
+	}
+	var ok bool
+	for blank := true; ; blank = false {
+		// skip the blank lines, then the paragraph
+		for c.blankLine() == blank {
+			if ok, err = c.prevLine(); err != nil {
+				return -1, err
+			} else if !ok {
+				return 0, nil
+			}
+		}
+		if !blank {
+			return c.start, nil
+		}
+	}
+}
+
+// paragraphStart moves the cursor to the start of the first line of its
+// paragraph, the line following a blank line or the start of the text
+func (c *cursor) paragraphStart() (err error) {
+	for c.start > 0 {
+		start := c.start
+		if _, err = c.prevLine(); err != nil {
+			return err
+		} else if c.blankLine() {
+			return c.load(start)
+		}
+	}
+	c.i = 0
+	return nil
+}
+
+// sentenceStarts walks the clusters from the start of the paragraph of the
+// cursor, which is returned, calling fn with the index of each sentence start
+// until fn returns false. A sentence starts at the first non-blank cluster of
+// a paragraph and at the first non-blank cluster following whitespace after a
+// '.', '!' or '?' and any closing parentheses, brackets or quotes following
+// it
+func (c *cursor) sentenceStarts(fn func(index int64) bool) (para int64, err error) {
+	if err = c.paragraphStart(); err != nil {
+		return -1, err
+	}
+	para = c.start
+	start, term, text := true, false, false
+	for !c.atEnd() {
+		if cl := c.cur(); cl.blank() {
+			if term {
+				start, term = true, false
+			}
+			if cl.newline {
+				// a blank line starts a new paragraph
+				start, text = start || !text, false
+			}
+		} else {
+			if text = true; start {
+				if !fn(cl.index) {
+					return para, nil
+				}
+				start = false
+			}
+			switch {
+			case strings.ContainsRune(".!?", cl.ch):
+				term = true
+			case term && strings.ContainsRune(`)]"'`, cl.ch):
+			default:
+				term = false
+			}
+		}
+		if _, err = c.next(); err != nil {
+			return -1, err
+		}
+	}
+	return para, nil
+}
+
+// SentenceForward returns the index of the start of the next sentence after
+// the index given, like the vim ) motion and the emacs M-e command, or the
+// size when there is none. The size and io.EOF are returned when the index is
+// at or past the end of the text
+//
+// SentenceForward was added by go-corelibs
+func SentenceForward(r runes.RuneReader, index int64) (next int64, err error) {
+	if index >= r.Size() && index >= 0 {
+		return r.Size(), io.EOF
+	}
+	var c *cursor
+	if c, err = newCursor(r, "SentenceForward", index); err != nil {
+		return -1, err
+	}
+	next = c.size
+	if _, err = c.sentenceStarts(func(start int64) bool {
+		if start > index {
+			next = start
+			return false
+		}
+		return true
+	}); err != nil {
+		return -1, err
+	}
+	return next, nil
+}
+
+// SentenceBackward returns the index of the start of the sentence before the
+// index given, or of the sentence containing it, like the vim ( motion and
+// the emacs M-a command, or zero when there is none. Zero and io.EOF are
+// returned when the index is zero
+//
+// SentenceBackward was added by go-corelibs
+func SentenceBackward(r runes.RuneReader, index int64) (prev int64, err error) {
+	if index == 0 {
+		return 0, io.EOF
+	} else if index > r.Size() {
+		index = r.Size()
+	}
+	for at := index; ; {
+		var c *cursor
+		if c, err = newCursor(r, "SentenceBackward", at); err != nil {
+			return -1, err
+		}
+		var para int64
+		prev = -1
+		if para, err = c.sentenceStarts(func(start int64) bool {
+			if start < index {
+				prev = start
+				return true
+			}
+			return false
+		}); err != nil {
+			return -1, err
+		} else if prev >= 0 {
+			return prev, nil
+		} else if para == 0 {
+			return 0, nil
+		}
+		// none in this paragraph, continue with the one before it
+		at = para - 1
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package motions_test
+
+import (
+	"io"
+	"testing"
+
+	. "github.com/go-corelibs/runes/motions"
+)
+
+const textText = "One (\"two.\") Three!  Four\ncontinues? e.g.x\n \nSecond\n\n\n\u00c9nd."
+
+func TestTextMotions(t *testing.T) {
+	at := func(sub string, n int) int { return offset(textText, sub, n) }
+	blank := at(" \n", 0)
+	empty := at("\n\n", 0) + 1
+	for _, tc := range []struct {
+		name     string
+		fn       motion
+		from, to int
+	}{
+		{"}", ParagraphForward, 0, blank},
+		{"}", ParagraphForward, at("e.g", 0), blank},
+		{"}", ParagraphForward, blank, empty},
+		{"}", ParagraphForward, at("Second", 0), empty},
+		{"}", ParagraphForward, empty, len(textText)},
+		{"{", ParagraphBackward, at("\u00c9", 0), empty + 1},
+		{"{", ParagraphBackward, empty + 1, blank},
+		{"{", ParagraphBackward, at("Second", 0), blank},
+		{"{", ParagraphBackward, blank, 0},
+		{"{", ParagraphBackward, at("Four", 0), 0},
+		{")", SentenceForward, 0, at("Three", 0)}, // after the closing quote
+		{")", SentenceForward, at("Three", 0), at("Four", 0)},
+		{")", SentenceForward, at("Four", 0), at("e.g", 0)}, // across a line
+		{")", SentenceForward, at("e.g", 0), at("Second", 0)},
+		{")", SentenceForward, at("Second", 0), at("\u00c9", 0)},
+		{")", SentenceForward, at("\u00c9", 0), len(textText)},
+		{"(", SentenceBackward, at("Three", 0), 0},
+		{"(", SentenceBackward, at("hree", 0), at("Three", 0)},
+		{"(", SentenceBackward, at("continues", 0), at("Four", 0)},
+		{"(", SentenceBackward, at("Second", 0), at("e.g", 0)},
+		{"(", SentenceBackward, at("\u00c9", 0), at("Second", 0)},
+		{"(", SentenceBackward, len(textText), at("\u00c9", 0)},
+	} {
+		checkMotion(t, tc.name, tc.fn, textText, tc.from, tc.to)
+	}
+
+	for _, r := range newReaders(textText) {
+		if next, err := ParagraphForward(r, r.Size()); next != r.Size() || err != io.EOF {
+			t.Errorf("%T ParagraphForward(size): got %d,%v", r, next, err)
+		}
+		if next, err := SentenceForward(r, r.Size()); next != r.Size() || err != io.EOF {
+			t.Errorf("%T SentenceForward(size): got %d,%v", r, next, err)
+		}
+		if prev, err := ParagraphBackward(r, 0); prev != 0 || err != io.EOF {
+			t.Errorf("%T ParagraphBackward(0): got %d,%v", r, prev, err)
+		}
+		if prev, err := SentenceBackward(r, 0); prev != 0 || err != io.EOF {
+			t.Errorf("%T SentenceBackward(0): got %d,%v", r, prev, err)
+		}
+		if _, err := SentenceBackward(r, -1); err == nil || err.Error() != "SentenceBackward: negative position" {
+			t.Errorf("%T SentenceBackward(-1): got %v", r, err)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package motions
+
+import (
+	"io"
+	"unicode"
+
+	"github.com/go-corelibs/runes"
+)
+
+// WordKind selects what the word motions consider to be a word
+type WordKind uint8
+
+const (
+	// Word is a run of letters, digits, marks and underscores, or a run of
+	// other non-blank runes, like the vim word
+	Word WordKind = iota
+	// BigWord is a run of non-blank runes, like the vim WORD
+	BigWord
+)
+
+// charClass is the class of a cluster for the word motions, clusters of the
+// same class form a word
+type charClass uint8
+
+const (
+	blankClass charClass = iota
+	punctClass
+	wordClass
+)
+
+// class returns the charClass of the cluster given
+func (k WordKind) class(c *cluster) charClass {
+	switch {
+	case c.blank():
+		return blankClass
+	case k == BigWord:
+		return punctClass
+	case c.ch == '_' || unicode.IsLetter(c.ch) || unicode.IsDigit(c.ch) || unicode.IsMark(c.ch):
+		return wordClass
+	}
+	return punctClass
+}
+
+// WordForward returns the index of the start of the next word after the
+// index given, like the vim w and W motions. An empty line counts as a word
+// and the size is returned when there are no more words. The size and io.EOF
+// are returned when the index is at or past the end of the text
+//
+// WordForward was added by go-corelibs
+func WordForward(r runes.RuneReader, index int64, kind WordKind) (next int64, err error) {
+	if index >= r.Size() && index >= 0 {
+		return r.Size(), io.EOF
+	}
+	var c *cursor
+	if c, err = newCursor(r, "WordForward", index); err != nil {
+		return -1, err
+	}
+	start := c.pos()
+	var ok bool
+	if class := kind.class(c.cur()); class != blankClass {
+		// the rest of the current word
+		for {
+			if ok, err = c.next(); err != nil {
+				return -1, err
+			} else if !ok || kind.class(c.cur()) != class {
+				break
+			}
+		}
+	}
+	for !c.atEnd() && c.cur().blank() {
+		if cur := c.cur(); cur.first && cur.newline && cur.index != start {
+			break // an empty line
+		} else if _, err = c.next(); err != nil {
+			return -1, err
+		}
+	}
+	return c.pos(), nil
+}
+
+// WordBackward returns the index of the start of the word before the index
+// given, or of the word containing it, like the vim b and B motions. An empty
+// line counts as a word. Zero and io.EOF are returned when the index is zero
+//
+// WordBackward was added by go-corelibs
+func WordBackward(r runes.RuneReader, index int64, kind WordKind) (prev int64, err error) {
+	if index == 0 {
+		return 0, io.EOF
+	} else if index > r.Size() {
+		index = r.Size()
+	}
+	var c *cursor
+	if c, err = newCursor(r, "WordBackward", index); err != nil {
+		return -1, err
+	}
+	var ok bool
+	if ok, err = c.prev(); err != nil || !ok {
+		return 0, err
+	}
+	for c.cur().blank() {
+		if cur := c.cur(); cur.first && cur.newline {
+			return cur.index, nil // an empty line
+		} else if ok, err = c.prev(); err != nil || !ok {
+			return 0, err
+		}
+	}
+	class := kind.class(c.cur())
+	for {
+		prev = c.pos()
+		if ok, err = c.prev(); err != nil {
+			return -1, err
+		} else if !ok || kind.class(c.cur()) != class {
+			return prev, nil
+		}
+	}
+}
+
+// WordEnd returns the index of the last cluster of the next word end after
+// the index given, like the vim e and E motions. The size is returned when
+// there are no more words. The size and io.EOF are returned when the index
+// is at or past the end of the text
+//
+// WordEnd was added by go-corelibs
+func WordEnd(r runes.RuneReader, index int64, kind WordKind) (next int64, err error) {
+	if index >= r.Size() && index >= 0 {
+		return r.Size(), io.EOF
+	}
+	var c *cursor
+	if c, err = newCursor(r, "WordEnd", index); err != nil {
+		return -1, err
+	}
+	var ok bool
+	if ok, err = c.next(); err != nil || !ok {
+		return c.pos(), err
+	}
+	for c.cur().blank() {
+		if ok, err = c.next(); err != nil || !ok {
+			return c.pos(), err
+		}
+	}
+	class := kind.class(c.cur())
+	for {
+		next = c.pos()
+		if ok, err = c.next(); err != nil {
+			return -1, err
+		} else if !ok || kind.class(c.cur()) != class {
+			return next, nil
+		}
+	}
+}
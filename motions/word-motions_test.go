@@ -0,0 +1,119 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package motions_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/go-corelibs/runes"
+	. "github.com/go-corelibs/runes/motions"
+)
+
+// newReaders returns the three readers of the text given
+func newReaders(text string) []runes.RuneReader {
+	return []runes.RuneReader{
+		runes.NewBytesReader([]byte(text)),
+		runes.NewStringReader(text),
+		runes.NewRunesReader([]rune(text)),
+	}
+}
+
+// native returns the native index of the byte offset given in text
+func native(r runes.RuneReader, text string, offset int) int64 {
+	if _, ok := r.(*runes.Reader); ok {
+		return int64(utf8.RuneCountInString(text[:offset]))
+	}
+	return int64(offset)
+}
+
+// offset returns the byte offset of the n-th occurrence of sub in text,
+// counting from zero
+func offset(text, sub string, n int) int {
+	var index int
+	for ; ; n-- {
+		next := strings.Index(text[index:], sub)
+		if n == 0 {
+			return index + next
+		}
+		index += next + len(sub)
+	}
+}
+
+// motion is a motion of this package with a fixed kind or rune
+type motion func(r runes.RuneReader, index int64) (int64, error)
+
+// checkMotion checks that fn moves from the byte offset given to want
+func checkMotion(t *testing.T, name string, fn motion, text string, from, want int) {
+	t.Helper()
+	for _, r := range newReaders(text) {
+		if got, err := fn(r, native(r, text, from)); got != native(r, text, want) || err != nil {
+			t.Errorf("%T %s(%d): got %d,%v; want %d", r, name, from, got, err, native(r, text, want))
+		}
+	}
+}
+
+const wordText = "foo.bar  baz_1 e\u0301te\n\n  x-y\nend"
+
+func TestWordMotions(t *testing.T) {
+	word := func(fn func(runes.RuneReader, int64, WordKind) (int64, error), kind WordKind) motion {
+		return func(r runes.RuneReader, index int64) (int64, error) { return fn(r, index, kind) }
+	}
+	at := func(sub string, n int) int { return offset(wordText, sub, n) }
+	empty := at("\n\n", 0) + 1
+	for _, tc := range []struct {
+		name     string
+		fn       motion
+		from, to int
+	}{
+		{"w", word(WordForward, Word), 0, at(".", 0)},
+		{"w", word(WordForward, Word), at(".", 0), at("bar", 0)},
+		{"w", word(WordForward, Word), at("bar", 0), at("baz", 0)},
+		{"w", word(WordForward, Word), at("baz", 0), at("e", 0)},
+		{"w", word(WordForward, Word), at("e", 0), empty}, // an empty line is a word
+		{"w", word(WordForward, Word), empty, at("x", 0)},
+		{"w", word(WordForward, Word), at("y", 0), at("end", 0)},
+		{"w", word(WordForward, Word), at("end", 0), len(wordText)},
+		{"W", word(WordForward, BigWord), 0, at("baz", 0)},
+		{"W", word(WordForward, BigWord), at("x", 0), at("end", 0)},
+		{"b", word(WordBackward, Word), at("x", 0), empty},
+		{"b", word(WordBackward, Word), empty, at("e", 0)},
+		{"b", word(WordBackward, Word), at("te", 0) + 1, at("e", 0)},
+		{"b", word(WordBackward, Word), at("e", 0) + 1, at("baz", 0)}, // inside a cluster
+		{"b", word(WordBackward, Word), at("bar", 0), at(".", 0)},
+		{"b", word(WordBackward, Word), at("ar", 0), at("bar", 0)},
+		{"b", word(WordBackward, Word), len(wordText), at("end", 0)},
+		{"B", word(WordBackward, BigWord), at("baz", 0), 0},
+		{"B", word(WordBackward, BigWord), at("y", 0), at("x", 0)},
+		{"e", word(WordEnd, Word), 0, at(".", 0) - 1},
+		{"e", word(WordEnd, Word), at(".", 0) - 1, at(".", 0)},
+		{"e", word(WordEnd, Word), at("baz", 0), at("_1", 0) + 1},
+		{"e", word(WordEnd, Word), at("e", 0), at("te", 0) + 1},
+		{"e", word(WordEnd, Word), at("e", 0) + 1, at("te", 0) + 1}, // inside a cluster
+		{"e", word(WordEnd, Word), at("te", 0) + 1, at("x", 0)},     // empty lines are skipped
+		{"e", word(WordEnd, Word), at("end", 0) + 2, len(wordText)},
+		{"E", word(WordEnd, BigWord), 0, at("bar", 0) + 2},
+		{"E", word(WordEnd, BigWord), at("x", 0), at("y", 0)},
+	} {
+		checkMotion(t, tc.name, tc.fn, wordText, tc.from, tc.to)
+	}
+
+	for _, r := range newReaders(wordText) {
+		if next, err := WordForward(r, r.Size(), Word); next != r.Size() || err != io.EOF {
+			t.Errorf("%T WordForward(size): got %d,%v", r, next, err)
+		}
+		if next, err := WordEnd(r, r.Size(), Word); next != r.Size() || err != io.EOF {
+			t.Errorf("%T WordEnd(size): got %d,%v", r, next, err)
+		}
+		if prev, err := WordBackward(r, 0, Word); prev != 0 || err != io.EOF {
+			t.Errorf("%T WordBackward(0): got %d,%v", r, prev, err)
+		}
+		if _, err := WordForward(r, -1, Word); err == nil || err.Error() != "WordForward: negative position" {
+			t.Errorf("%T WordForward(-1): got %v", r, err)
+		}
+	}
+}
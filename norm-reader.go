@@ -0,0 +1,177 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"errors"
+	"sort"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// normEdit records a segment of the source which changed when normalized
+type normEdit struct {
+	src      int64 // index of the segment in the source
+	srcWidth int64 // width of the segment in the source
+	norm     int64 // index of the segment in the normalized text
+	width    int64 // width of the segment in the normalized text
+}
+
+// NormReader is a RuneReader presenting the text of another RuneReader in one
+// of the Unicode normalization forms. All indices are in the native units of
+// the source reader, as they are in the normalized text. SourceIndex and
+// NormalizedIndex convert between the two
+//
+// The normalized text is produced when the NormReader is created and the
+// source reader is not used after that
+type NormReader struct {
+	textRuneReader
+	form  norm.Form
+	runes bool
+	size  int64 // size of the source text
+	edits []normEdit
+}
+
+// NewNormReader returns a new NormReader with the text of src normalized to
+// the form given
+//
+// NewNormReader was added by go-corelibs
+func NewNormReader(src RuneReader, form norm.Form) (r *NormReader, err error) {
+	var text string
+	r = &NormReader{form: form, size: src.Size()}
+	if text, r.runes, err = readText(src); err != nil {
+		return nil, err
+	}
+
+	units := func(s string) int64 {
+		if r.runes {
+			return int64(utf8.RuneCountInString(s))
+		}
+		return int64(len(s))
+	}
+
+	var it norm.Iter
+	var srcIndex, normIndex, pending int64
+	var group int // start of the current group of segments in buf
+	buf := make([]byte, 0, len(text))
+	it.InitString(form, text)
+	for !it.Done() {
+		start := it.Pos()
+		segment := it.Next()
+		original := text[start:it.Pos()]
+		buf = append(buf, segment...)
+		width := pending + units(string(segment))
+		if original == "" {
+			// a long decomposition can be returned in more than one segment,
+			// group these with the segment which consumes the source
+			pending = width
+			continue
+		}
+		pending = 0
+		srcWidth := units(original)
+		if width != srcWidth || string(buf[group:]) != original {
+			r.edits = append(r.edits, normEdit{src: srcIndex, srcWidth: srcWidth, norm: normIndex, width: width})
+		}
+		srcIndex += srcWidth
+		normIndex += width
+		group = len(buf)
+	}
+	r.textRuneReader = textReaderFor(string(buf), r.runes)
+	return r, nil
+}
+
+// Form returns the normalization form of the NormReader
+func (r *NormReader) Form() norm.Form {
+	return r.form
+}
+
+// runeIndexed returns true if the native units of the source are runes
+func (r *NormReader) runeIndexed() bool { return r.runes }
+
+// SourceIndex returns the index in the source text corresponding to the
+// normalized index given. Indices within a segment which was changed by
+// normalization map to the start of the source segment
+//
+// SourceIndex was added by go-corelibs
+func (r *NormReader) SourceIndex(index int64) (src int64, err error) {
+	if index < 0 || index > r.Size() {
+		return -1, errors.New("NormReader.SourceIndex: index out of range")
+	}
+	k := sort.Search(len(r.edits), func(i int) bool { return r.edits[i].norm > index }) - 1
+	if k < 0 {
+		return index, nil
+	} else if edit := r.edits[k]; index < edit.norm+edit.width {
+		return edit.src, nil
+	} else {
+		return index - edit.norm - edit.width + edit.src + edit.srcWidth, nil
+	}
+}
+
+// NormalizedIndex returns the normalized index corresponding to the source
+// index given. Indices within a segment which was changed by normalization
+// map to the start of the normalized segment
+//
+// NormalizedIndex was added by go-corelibs
+func (r *NormReader) NormalizedIndex(src int64) (index int64, err error) {
+	if src < 0 || src > r.size {
+		return -1, errors.New("NormReader.NormalizedIndex: index out of range")
+	}
+	k := sort.Search(len(r.edits), func(i int) bool { return r.edits[i].src > src }) - 1
+	if k < 0 {
+		return src, nil
+	} else if edit := r.edits[k]; src < edit.src+edit.srcWidth {
+		return edit.norm, nil
+	} else {
+		return src - edit.src - edit.srcWidth + edit.norm + edit.width, nil
+	}
+}
+
+// Normalize returns the entire text of r in the normalization form given
+//
+// Normalize was added by go-corelibs
+func Normalize(r RuneReader, form norm.Form) (normalized string, err error) {
+	var text string
+	if text, _, err = readText(r); err != nil {
+		return "", err
+	}
+	return form.String(text), nil
+}
+
+// NFC returns the entire text of r in Normalization Form C
+//
+// NFC was added by go-corelibs
+func NFC(r RuneReader) (string, error) { return Normalize(r, norm.NFC) }
+
+// NFD returns the entire text of r in Normalization Form D
+//
+// NFD was added by go-corelibs
+func NFD(r RuneReader) (string, error) { return Normalize(r, norm.NFD) }
+
+// NFKC returns the entire text of r in Normalization Form KC
+//
+// NFKC was added by go-corelibs
+func NFKC(r RuneReader) (string, error) { return Normalize(r, norm.NFKC) }
+
+// NFKD returns the entire text of r in Normalization Form KD
+//
+// NFKD was added by go-corelibs
+func NFKD(r RuneReader) (string, error) { return Normalize(r, norm.NFKD) }
+
+// IsNormalized reports whether the entire text of r is already in the
+// normalization form given. The quick check property is tried first and the
+// text is only fully normalized when the quick check is inconclusive
+//
+// IsNormalized was added by go-corelibs
+func IsNormalized(r RuneReader, form norm.Form) (ok bool, err error) {
+	var text string
+	if text, _, err = readText(r); err != nil {
+		return false, err
+	}
+	if form.QuickSpanString(text) == len(text) {
+		return true, nil
+	}
+	return form.IsNormalString(text), nil
+}
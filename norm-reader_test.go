@@ -0,0 +1,235 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes_test
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/unicode/rangetable"
+
+	. "github.com/go-corelibs/runes"
+)
+
+// parseCodePoints parses a space separated list of hexadecimal code points
+func parseCodePoints(t *testing.T, field string) string {
+	var buf strings.Builder
+	for _, hex := range strings.Fields(field) {
+		cp, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil {
+			t.Fatalf("invalid code point %q: %v", hex, err)
+		}
+		buf.WriteRune(rune(cp))
+	}
+	return buf.String()
+}
+
+// assignedIn returns true if all the runes of text are assigned in the given
+// version of Unicode
+func assignedIn(table *unicode.RangeTable, text string) bool {
+	for _, ch := range text {
+		if !unicode.Is(table, ch) {
+			return false
+		}
+	}
+	return true
+}
+
+func checkNormalize(t *testing.T, line int, form norm.Form, name, input, want string) {
+	t.Helper()
+	for _, r := range []RuneReader{NewStringReader(input), NewRunesReader([]rune(input))} {
+		if got, err := Normalize(r, form); got != want || err != nil {
+			t.Errorf("line %d: %s(%+q) got %+q,%v; want %+q", line, name, input, got, err, want)
+		}
+		nr, err := NewNormReader(r, form)
+		if err != nil {
+			t.Fatalf("line %d: NewNormReader: %v", line, err)
+		}
+		if got, _ := io.ReadAll(nr); string(got) != want {
+			t.Errorf("line %d: NormReader %s(%+q) got %+q; want %+q", line, name, input, got, want)
+		}
+		if ok, _ := IsNormalized(r, form); ok != (input == want) {
+			t.Errorf("line %d: IsNormalized %s(%+q) got %v", line, name, input, ok)
+		}
+	}
+}
+
+//gocyclo:ignore
+func TestNormalizationConformance(t *testing.T) {
+	fh, err := os.Open("testdata/NormalizationTest.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fh.Close()
+
+	// the test data may be for a newer version of Unicode than the norm
+	// tables, lines with code points unknown to the tables are skipped
+	assigned := rangetable.Assigned(norm.Version)
+	if assigned == nil {
+		t.Fatalf("rangetable has no data for Unicode %s", norm.Version)
+	}
+
+	part1 := make(map[rune]struct{})
+	var line, tested, skipped int
+	var inPart1 bool
+	scanner := bufio.NewScanner(fh)
+	for scanner.Scan() {
+		line++
+		text := scanner.Text()
+		if idx := strings.IndexByte(text, '#'); idx >= 0 {
+			text = text[:idx]
+		}
+		if text = strings.TrimSpace(text); text == "" {
+			continue
+		} else if text[0] == '@' {
+			inPart1 = strings.HasPrefix(text, "@Part1")
+			continue
+		}
+		fields := strings.Split(text, ";")
+		if len(fields) < 5 {
+			t.Fatalf("line %d: expected 5 fields, got %d", line, len(fields))
+		}
+		var c [6]string
+		for idx := 0; idx < 5; idx++ {
+			c[idx+1] = parseCodePoints(t, fields[idx])
+		}
+		if inPart1 {
+			for _, ch := range c[1] {
+				part1[ch] = struct{}{}
+			}
+		}
+		if !assignedIn(assigned, c[1]) {
+			skipped++
+			continue
+		}
+		tested++
+		for idx := 1; idx <= 3; idx++ {
+			checkNormalize(t, line, norm.NFC, "NFC", c[idx], c[2])
+			checkNormalize(t, line, norm.NFD, "NFD", c[idx], c[3])
+		}
+		for idx := 4; idx <= 5; idx++ {
+			checkNormalize(t, line, norm.NFC, "NFC", c[idx], c[4])
+			checkNormalize(t, line, norm.NFD, "NFD", c[idx], c[5])
+		}
+		for idx := 1; idx <= 5; idx++ {
+			checkNormalize(t, line, norm.NFKC, "NFKC", c[idx], c[4])
+			checkNormalize(t, line, norm.NFKD, "NFKD", c[idx], c[5])
+		}
+		if t.Failed() {
+			t.FailNow()
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if tested == 0 {
+		t.Fatalf("no conformance tests run, %d skipped", skipped)
+	}
+
+	// every other assigned code point is unchanged by all forms
+	forms := []norm.Form{norm.NFC, norm.NFD, norm.NFKC, norm.NFKD}
+	for ch := rune(0); ch <= unicode.MaxRune; ch++ {
+		if _, listed := part1[ch]; listed || !unicode.Is(assigned, ch) || unicode.Is(unicode.Cs, ch) {
+			continue
+		}
+		text := string(ch)
+		for _, form := range forms {
+			if got, _ := Normalize(NewStringReader(text), form); got != text {
+				t.Fatalf("%U: form %d got %+q; want unchanged", ch, form, got)
+			}
+		}
+	}
+}
+
+//gocyclo:ignore
+func TestNormReader_Mapping(t *testing.T) {
+	// "e\u0301" composes to "\u00e9", "\ufb01" decomposes to "fi" under NFKC
+	const text = "ae\u0301b\ufb01c"
+
+	r, err := NewNormReader(NewStringReader(text), norm.NFKC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Form() != norm.NFKC {
+		t.Errorf("Form: got %v", r.Form())
+	}
+	if data, _ := io.ReadAll(r); string(data) != "a\u00e9bfic" {
+		t.Errorf("ReadAll: got %q", data)
+	}
+	// bytes: a=0 e=1 U+0301=2,3 b=4 U+FB01=5,6,7 c=8 end=9
+	// NFKC:  a=0 \u00e9=1,2 b=3 f=4 i=5 c=6 end=7
+	source := []int64{0, 1, 1, 4, 5, 5, 8, 9}
+	for index, want := range source {
+		if src, err := r.SourceIndex(int64(index)); src != want || err != nil {
+			t.Errorf("SourceIndex(%d): got %d,%v; want %d", index, src, err, want)
+		}
+	}
+	normalized := []int64{0, 1, 1, 1, 3, 4, 4, 4, 6, 7}
+	for src, want := range normalized {
+		if index, err := r.NormalizedIndex(int64(src)); index != want || err != nil {
+			t.Errorf("NormalizedIndex(%d): got %d,%v; want %d", src, index, err, want)
+		}
+	}
+	if _, err = r.SourceIndex(8); err == nil {
+		t.Errorf("SourceIndex(8): expected error")
+	}
+	if _, err = r.NormalizedIndex(-1); err == nil {
+		t.Errorf("NormalizedIndex(-1): expected error")
+	}
+
+	// the same in rune units
+	r, err = NewNormReader(NewRunesReader([]rune(text)), norm.NFKC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ch, size, err := r.ReadRuneAt(1); ch != '\u00e9' || size != 1 || err != nil {
+		t.Errorf("ReadRuneAt(1): got %q,%d,%v; want '\u00e9',1,nil", ch, size, err)
+	}
+	// runes: a=0 e=1 U+0301=2 b=3 U+FB01=4 c=5 end=6
+	// NFKC:  a=0 \u00e9=1 b=2 f=3 i=4 c=5 end=6
+	source = []int64{0, 1, 3, 4, 4, 5, 6}
+	for index, want := range source {
+		if src, err := r.SourceIndex(int64(index)); src != want || err != nil {
+			t.Errorf("runes SourceIndex(%d): got %d,%v; want %d", index, src, err, want)
+		}
+	}
+	normalized = []int64{0, 1, 1, 2, 3, 5, 6}
+	for src, want := range normalized {
+		if index, err := r.NormalizedIndex(int64(src)); index != want || err != nil {
+			t.Errorf("runes NormalizedIndex(%d): got %d,%v; want %d", src, index, err, want)
+		}
+	}
+}
+
+func TestNormalizeFunctions(t *testing.T) {
+	const text = "\u1e9b\u0323"
+	r := NewStringReader(text)
+	for _, tt := range []struct {
+		name string
+		fn   func(RuneReader) (string, error)
+		want string
+	}{
+		{"NFC", NFC, "\u1e9b\u0323"},
+		{"NFD", NFD, "\u017f\u0323\u0307"},
+		{"NFKC", NFKC, "\u1e69"},
+		{"NFKD", NFKD, "s\u0323\u0307"},
+	} {
+		if got, err := tt.fn(r); got != tt.want || err != nil {
+			t.Errorf("%s: got %+q,%v; want %+q", tt.name, got, err, tt.want)
+		}
+	}
+	if ok, err := IsNormalized(NewStringReader("plain ascii"), norm.NFKD); !ok || err != nil {
+		t.Errorf("IsNormalized: got %v,%v; want true", ok, err)
+	}
+	if ok, err := IsNormalized(NewStringReader("e\u0301"), norm.NFC); ok || err != nil {
+		t.Errorf("IsNormalized: got %v,%v; want false", ok, err)
+	}
+}
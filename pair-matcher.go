@@ -0,0 +1,362 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"errors"
+	"io"
+	"strings"
+)
+
+// BracketPair is an opening rune and the closing rune which matches it
+type BracketPair struct {
+	Open  rune
+	Close rune
+}
+
+// PairMatcher finds matching brackets and quotes in the text of a RuneReader,
+// for jumping to the matching bracket or selecting the text inside a pair
+// without parsing the text. Each line is scanned from its start, so quoted
+// regions end at the end of their line and a quote which is not closed on
+// the same line is treated as an ordinary rune. A rune following one of the
+// Escapes is skipped, whether inside a quoted region or not
+//
+// Brackets inside a quoted region only match other brackets inside the same
+// region and brackets outside of any quoted region, which may span any number
+// of lines, only match each other. Only pairs of the same kind are counted
+// when nesting, so mismatched brackets of other kinds are ignored
+//
+// All indices are in the native units of the reader and the position of the
+// reader is unspecified after each call
+type PairMatcher struct {
+	// Pairs are the brackets which match each other
+	Pairs []BracketPair
+	// Quotes are the runes which both open and close a quoted region
+	Quotes string
+	// Escapes are the runes which cause the rune following them to be
+	// skipped
+	Escapes string
+	// MaxDepth limits how deeply pairs of the same kind may nest before an
+	// error is returned, zero means there is no limit
+	MaxDepth int
+
+	r RuneReader
+}
+
+// NewPairMatcher returns a new PairMatcher for the reader given, matching
+// parentheses, square brackets and braces, with double quotes, single quotes
+// and backticks delimiting quoted regions and backslash as the escape
+//
+// NewPairMatcher was added by go-corelibs
+func NewPairMatcher(r RuneReader) *PairMatcher {
+	return &PairMatcher{
+		Pairs:   []BracketPair{{'(', ')'}, {'[', ']'}, {'{', '}'}},
+		Quotes:  "\"'`",
+		Escapes: `\`,
+		r:       r,
+	}
+}
+
+// pairKind is the role of a pairToken
+type pairKind uint8
+
+const (
+	pairOpen pairKind = iota
+	pairClose
+	pairQuote
+)
+
+// pairToken is a bracket or quote found by PairMatcher.tokenize
+type pairToken struct {
+	kind    pairKind
+	index   int64
+	pair    int   // index of the bracket in Pairs
+	region  int64 // opening quote of the region containing the token, or -1
+	partner int64 // the other quote of a pairQuote
+}
+
+// bracket returns the index in Pairs of the bracket given and whether it is
+// an opening bracket, or -1
+func (m *PairMatcher) bracket(ch rune) (pair int, open bool) {
+	for i, p := range m.Pairs {
+		if ch == p.Open {
+			return i, true
+		} else if ch == p.Close {
+			return i, false
+		}
+	}
+	return -1, false
+}
+
+// tokenize returns the brackets and quotes of the line starting at the index
+// given, in order, and the end of the line
+func (m *PairMatcher) tokenize(start int64) (tokens []pairToken, end int64, err error) {
+	if end, err = segmentEnd(m.r, start); err != nil {
+		return nil, -1, err
+	}
+	var chs []rune
+	var indices []int64
+	for index := start; index < end; {
+		var ch rune
+		var width int
+		if ch, width, err = m.r.ReadRuneAt(index); err != nil {
+			return nil, -1, err
+		}
+		chs = append(chs, ch)
+		indices = append(indices, index)
+		index += int64(width)
+	}
+
+	quote, mark, skip := -1, 0, -1
+	for i := 0; i < len(chs); i++ {
+		ch := chs[i]
+		switch {
+		case strings.ContainsRune(m.Escapes, ch):
+			i++ // the escaped rune
+		case quote >= 0 && ch == chs[quote]:
+			tokens[mark].partner = indices[i]
+			tokens = append(tokens, pairToken{kind: pairQuote, index: indices[i], region: -1, partner: indices[quote]})
+			quote = -1
+		case quote < 0 && i != skip && strings.ContainsRune(m.Quotes, ch):
+			quote, mark = i, len(tokens)
+			tokens = append(tokens, pairToken{kind: pairQuote, index: indices[i], region: -1})
+		default:
+			if pair, open := m.bracket(ch); pair >= 0 {
+				t := pairToken{kind: pairClose, index: indices[i], pair: pair, region: -1}
+				if open {
+					t.kind = pairOpen
+				}
+				if quote >= 0 {
+					t.region = indices[quote]
+				}
+				tokens = append(tokens, t)
+			}
+		}
+		if quote >= 0 && i >= len(chs)-1 {
+			// the quote is not closed on this line, scan again after it
+			tokens, i, skip, quote = tokens[:mark], quote-1, quote, -1
+		}
+	}
+	return tokens, end, nil
+}
+
+// pairScan walks the tokens of the lines of a PairMatcher in either
+// direction, starting from a given token
+type pairScan struct {
+	m          *PairMatcher
+	tokens     []pairToken
+	start, end int64 // the current line
+	i          int
+	forward    bool
+}
+
+// newPairScan tokenizes the line containing the index given and returns a
+// pairScan positioned at the token at the index, or at the position where it
+// would be
+func (m *PairMatcher) newPairScan(name string, index int64) (s *pairScan, found bool, err error) {
+	if index < 0 {
+		return nil, false, errors.New(name + ": negative position")
+	} else if index >= m.r.Size() {
+		return nil, false, io.EOF
+	}
+	s = &pairScan{m: m}
+	if s.start, err = segmentStart(m.r, index); err != nil {
+		return nil, false, err
+	} else if s.tokens, s.end, err = m.tokenize(s.start); err != nil {
+		return nil, false, err
+	}
+	for s.i = 0; s.i < len(s.tokens) && s.tokens[s.i].index < index; s.i++ {
+	}
+	found = s.i < len(s.tokens) && s.tokens[s.i].index == index
+	return s, found, nil
+}
+
+// token returns the current token
+func (s *pairScan) token() *pairToken {
+	return &s.tokens[s.i]
+}
+
+// next moves to the next token in the direction of the scan, loading further
+// lines as needed, and returns false at the start or end of the text
+func (s *pairScan) next() (ok bool, err error) {
+	if s.forward {
+		s.i++
+	} else {
+		s.i--
+	}
+	for s.i < 0 || s.i >= len(s.tokens) {
+		if s.forward {
+			if s.end >= s.m.r.Size() {
+				return false, nil
+			}
+			s.start = s.end
+		} else {
+			if s.start <= 0 {
+				return false, nil
+			}
+			var width int
+			if _, width, err = s.m.r.ReadPrevRuneFrom(s.start); err != nil {
+				return false, err
+			} else if s.start, err = segmentStart(s.m.r, s.start-int64(width)); err != nil {
+				return false, err
+			}
+		}
+		if s.tokens, s.end, err = s.m.tokenize(s.start); err != nil {
+			return false, err
+		}
+		if s.i = 0; !s.forward {
+			s.i = len(s.tokens) - 1
+		}
+	}
+	return true, nil
+}
+
+// leavesRegion returns true if the token given is a quote of the region
+// given, ending a scan within it
+func leavesRegion(t *pairToken, region int64) bool {
+	return region >= 0 && t.kind == pairQuote && (t.index == region || t.partner == region)
+}
+
+// match finds the bracket matching the current token of the scan
+func (s *pairScan) match(name string) (match int64, ok bool, err error) {
+	t := *s.token()
+	s.forward = t.kind == pairOpen
+	var depth int
+	for {
+		var more bool
+		if more, err = s.next(); err != nil {
+			return -1, false, err
+		} else if !more {
+			break
+		}
+		other := s.token()
+		if leavesRegion(other, t.region) {
+			break
+		} else if other.kind == pairQuote || other.region != t.region || other.pair != t.pair {
+			continue
+		} else if other.kind == t.kind {
+			if depth++; s.m.MaxDepth > 0 && depth >= s.m.MaxDepth {
+				return -1, false, errors.New(name + ": maximum depth exceeded")
+			}
+		} else if depth == 0 {
+			return other.index, true, nil
+		} else {
+			depth--
+		}
+	}
+	return -1, false, nil
+}
+
+// FindMatching returns the index of the bracket or quote which matches the one
+// at the index given. Opening brackets are matched forwards, closing brackets
+// backwards and quotes with the other quote of their region
+//
+// FindMatching was added by go-corelibs
+func (m *PairMatcher) FindMatching(index int64) (match int64, err error) {
+	const name = "PairMatcher.FindMatching"
+	var s *pairScan
+	var found bool
+	if s, found, err = m.newPairScan(name, index); err != nil {
+		return -1, err
+	} else if !found {
+		return -1, errors.New(name + ": no bracket or quote at index")
+	} else if t := s.token(); t.kind == pairQuote {
+		return t.partner, nil
+	}
+	var ok bool
+	if match, ok, err = s.match(name); err == nil && !ok {
+		err = errors.New(name + ": no match found")
+	}
+	return
+}
+
+// EnclosingPair returns the indices of the opening and closing runes of the
+// innermost pair of brackets or quotes surrounding the index given. When the
+// index is of a matched bracket or quote, its own pair is returned. The text
+// inside the pair begins after the rune at open and ends at close
+//
+// EnclosingPair was added by go-corelibs
+func (m *PairMatcher) EnclosingPair(index int64) (open, close int64, err error) {
+	const name = "PairMatcher.EnclosingPair"
+	var s *pairScan
+	var found bool
+	if s, found, err = m.newPairScan(name, index); err != nil {
+		return -1, -1, err
+	}
+
+	// the quoted region containing the index, if any
+	region, regionEnd := int64(-1), int64(-1)
+	for _, t := range s.tokens {
+		if t.kind == pairQuote && t.index <= index && index <= t.partner {
+			region, regionEnd = t.index, t.partner
+			break
+		}
+	}
+
+	if found {
+		t := s.token()
+		switch t.kind {
+		case pairQuote:
+			return min(t.index, t.partner), max(t.index, t.partner), nil
+		case pairOpen:
+			open, close = index, -1
+		default:
+			open, close = -1, index
+		}
+		if match, ok, ee := s.match(name); ee != nil {
+			return -1, -1, ee
+		} else if ok && open < 0 {
+			return match, close, nil
+		} else if ok {
+			return open, match, nil
+		}
+		// an unmatched bracket, look for the pair enclosing it
+		if s, _, err = m.newPairScan(name, index); err != nil {
+			return -1, -1, err
+		}
+	}
+
+	// count the closing brackets before the index, the first opening bracket
+	// without a closing one before the index is the innermost candidate
+	counts := make([]int, len(m.Pairs))
+	s.forward = false
+	for {
+		var ok bool
+		if ok, err = s.next(); err != nil {
+			return -1, -1, err
+		} else if !ok {
+			break
+		}
+		t := *s.token()
+		if leavesRegion(&t, region) {
+			break
+		} else if t.kind == pairQuote || t.region != region {
+			continue
+		} else if t.kind == pairClose {
+			if counts[t.pair]++; m.MaxDepth > 0 && counts[t.pair] >= m.MaxDepth {
+				return -1, -1, errors.New(name + ": maximum depth exceeded")
+			}
+		} else if counts[t.pair] > 0 {
+			counts[t.pair]--
+		} else if close, ok, err = m.matchFrom(name, t.index); err != nil {
+			return -1, -1, err
+		} else if ok {
+			return t.index, close, nil
+		}
+	}
+	if region >= 0 {
+		return region, regionEnd, nil
+	}
+	return -1, -1, errors.New(name + ": no enclosing pair")
+}
+
+// matchFrom finds the bracket matching the opening bracket at the index given
+func (m *PairMatcher) matchFrom(name string, index int64) (match int64, ok bool, err error) {
+	var s *pairScan
+	if s, _, err = m.newPairScan(name, index); err != nil {
+		return -1, false, err
+	}
+	return s.match(name)
+}
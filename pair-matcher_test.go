@@ -0,0 +1,132 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	. "github.com/go-corelibs/runes"
+)
+
+const pairText = "f(a[\u4e16], \"b)\\\"(\", g(c))\n{\n\t'x}' (y\n\tdon't }\n}"
+
+// pairIndex returns the native index of the n-th occurrence of sub in
+// pairText, counting from zero
+func pairIndex(r RuneReader, sub string, n int) int64 {
+	var index int
+	for i := 0; i <= n; i++ {
+		next := strings.Index(pairText[index:], sub)
+		if i < n {
+			index += next + len(sub)
+		} else {
+			index += next
+		}
+	}
+	return int64(nativeSize(r, pairText[:index]))
+}
+
+//gocyclo:ignore
+func TestFindMatching(t *testing.T) {
+	for _, r := range newCaseReaders(pairText) {
+		m := NewPairMatcher(r)
+		for _, tc := range []struct {
+			from, to string
+			fn, tn   int
+		}{
+			{"(", ")", 0, 2},   // f( matches the outer closing parenthesis
+			{"[", "]", 0, 0},   // across a wide rune
+			{"]", "[", 0, 0},   // backwards
+			{"(", ")", 2, 1},   // g( skips the brackets inside the quotes
+			{"\"", "\"", 0, 2}, // the escaped quote is skipped
+			{"\"", "\"", 2, 0}, // and the other way
+			{"{", "}", 0, 1},   // across lines, the quoted brace is ignored
+			{"}", "{", 1, 0},   // backwards across lines
+			{"'", "'", 0, 1},   // 'x}' is quoted, the quote of don't is not
+		} {
+			from := pairIndex(r, tc.from, tc.fn)
+			want := pairIndex(r, tc.to, tc.tn)
+			if got, err := m.FindMatching(from); got != want || err != nil {
+				t.Errorf("%T FindMatching(%s#%d): got %d,%v; want %d", r, tc.from, tc.fn, got, err, want)
+			}
+		}
+
+		if _, err := m.FindMatching(pairIndex(r, "a", 0)); err == nil || err.Error() != "PairMatcher.FindMatching: no bracket or quote at index" {
+			t.Errorf("%T FindMatching(a): got %v", r, err)
+		}
+		for _, at := range []int64{pairIndex(r, "(y", 0), pairIndex(r, ")", 0), pairIndex(r, "}", 2)} {
+			// never closed, only quoted brackets match inside quotes and an
+			// extra closing brace
+			if _, err := m.FindMatching(at); err == nil || err.Error() != "PairMatcher.FindMatching: no match found" {
+				t.Errorf("%T FindMatching(%d): got %v", r, at, err)
+			}
+		}
+		if _, err := m.FindMatching(-1); err == nil {
+			t.Errorf("%T FindMatching(-1): expected error", r)
+		}
+		if _, err := m.FindMatching(r.Size()); err != io.EOF {
+			t.Errorf("%T FindMatching(size): got %v; want io.EOF", r, err)
+		}
+
+		m.MaxDepth = 1
+		if _, err := m.FindMatching(pairIndex(r, "(", 0)); err == nil || err.Error() != "PairMatcher.FindMatching: maximum depth exceeded" {
+			t.Errorf("%T FindMatching MaxDepth: got %v", r, err)
+		}
+		if _, err := m.FindMatching(pairIndex(r, "[", 0)); err != nil {
+			t.Errorf("%T FindMatching MaxDepth [: got %v", r, err)
+		}
+	}
+}
+
+//gocyclo:ignore
+func TestEnclosingPair(t *testing.T) {
+	for _, r := range newCaseReaders(pairText) {
+		m := NewPairMatcher(r)
+		for _, tc := range []struct {
+			at    string
+			an    int
+			open  string
+			on    int
+			close string
+			cn    int
+		}{
+			{"a", 0, "(", 0, ")", 2}, // f(...)
+			{"\u4e16", 0, "[", 0, "]", 0},
+			{"b", 0, "\"", 0, "\"", 2}, // inside the quotes
+			{")", 0, "\"", 0, "\"", 2}, // an unmatched bracket inside quotes
+			{"c", 0, "(", 2, ")", 1},
+			{"x", 0, "'", 0, "'", 1},
+			{"}", 0, "'", 0, "'", 1}, // a quoted brace
+			{"y", 0, "{", 0, "}", 1}, // (y is never closed
+			{"don", 0, "{", 0, "}", 1},
+			{"[", 0, "[", 0, "]", 0}, // on a bracket
+			{"}", 1, "{", 0, "}", 1},
+		} {
+			at := pairIndex(r, tc.at, tc.an)
+			wantOpen := pairIndex(r, tc.open, tc.on)
+			wantClose := pairIndex(r, tc.close, tc.cn)
+			if open, close, err := m.EnclosingPair(at); open != wantOpen || close != wantClose || err != nil {
+				t.Errorf("%T EnclosingPair(%s#%d): got %d,%d,%v; want %d,%d", r, tc.at, tc.an, open, close, err, wantOpen, wantClose)
+			}
+		}
+		if _, _, err := m.EnclosingPair(0); err == nil || err.Error() != "PairMatcher.EnclosingPair: no enclosing pair" {
+			t.Errorf("%T EnclosingPair(0): got %v", r, err)
+		}
+	}
+
+	// custom pairs, quotes and escapes
+	r := NewStringReader("<a |<b>| c>")
+	m := NewPairMatcher(r)
+	m.Pairs = []BracketPair{{'<', '>'}}
+	m.Quotes = "|"
+	m.Escapes = ""
+	if open, close, err := m.EnclosingPair(1); open != 0 || close != 10 || err != nil {
+		t.Errorf("custom EnclosingPair: got %d,%d,%v", open, close, err)
+	}
+	if open, close, err := m.EnclosingPair(5); open != 4 || close != 6 || err != nil {
+		t.Errorf("custom EnclosingPair quoted: got %d,%d,%v", open, close, err)
+	}
+}
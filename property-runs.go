@@ -0,0 +1,214 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"sort"
+	"sync"
+	"unicode"
+)
+
+// PropertyRun is a run of runes sharing the same value of a Unicode property
+type PropertyRun struct {
+	// Start is the index of the first rune of the run
+	Start int64
+	// End is the index following the last rune of the run
+	End int64
+	// Value is the name of the property value, as used by unicode.Scripts or
+	// unicode.Categories
+	Value string
+}
+
+// propertyRange is a range of runes sharing the same property value
+type propertyRange struct {
+	lo, hi rune
+	name   string
+}
+
+var (
+	propertyRangesOnce sync.Once
+	scriptRanges       []propertyRange
+	categoryRanges     []propertyRange
+)
+
+// loadPropertyRanges builds the sorted ranges of the scripts and of the two
+// letter general categories, excluding the LC grouping of the cased letters,
+// for binary searching
+func loadPropertyRanges() {
+	propertyRangesOnce.Do(func() {
+		scriptRanges = propertyRangesOf(unicode.Scripts, func(string) bool { return true })
+		categoryRanges = propertyRangesOf(unicode.Categories, func(name string) bool {
+			return len(name) == 2 && name != "LC"
+		})
+	})
+}
+
+// propertyRangesOf returns the ranges of the tables included, sorted and with
+// the adjacent ranges of the same table merged. The tables must not overlap
+func propertyRangesOf(tables map[string]*unicode.RangeTable, include func(name string) bool) (ranges []propertyRange) {
+	for name, table := range tables {
+		if !include(name) {
+			continue
+		}
+		for _, r16 := range table.R16 {
+			ranges = appendPropertyRange(ranges, rune(r16.Lo), rune(r16.Hi), rune(r16.Stride), name)
+		}
+		for _, r32 := range table.R32 {
+			ranges = appendPropertyRange(ranges, rune(r32.Lo), rune(r32.Hi), rune(r32.Stride), name)
+		}
+	}
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].lo < ranges[j].lo })
+	merged := ranges[:0]
+	for _, pr := range ranges {
+		if n := len(merged); n > 0 && merged[n-1].name == pr.name && merged[n-1].hi+1 == pr.lo {
+			merged[n-1].hi = pr.hi
+			continue
+		}
+		merged = append(merged, pr)
+	}
+	return merged
+}
+
+// appendPropertyRange appends the runes from lo to hi, stepping by stride,
+// as ranges of contiguous runes
+func appendPropertyRange(ranges []propertyRange, lo, hi, stride rune, name string) []propertyRange {
+	if stride == 1 {
+		return append(ranges, propertyRange{lo: lo, hi: hi, name: name})
+	}
+	// strided ranges interleave with those of other values
+	for ch := lo; ch <= hi; ch += stride {
+		ranges = append(ranges, propertyRange{lo: ch, hi: ch, name: name})
+	}
+	return ranges
+}
+
+// propertyOf returns the name of the range containing ch, or fallback if
+// there is none
+func propertyOf(ranges []propertyRange, ch rune, fallback string) string {
+	k := sort.Search(len(ranges), func(i int) bool { return ranges[i].hi >= ch })
+	if k < len(ranges) && ranges[k].lo <= ch {
+		return ranges[k].name
+	}
+	return fallback
+}
+
+// scriptOf returns the name of the script of ch, as used by unicode.Scripts,
+// or "Unknown" if it has no script
+func scriptOf(ch rune) string {
+	if ch < 0x80 {
+		if 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z' {
+			return "Latin"
+		}
+		return "Common"
+	}
+	loadPropertyRanges()
+	return propertyOf(scriptRanges, ch, "Unknown")
+}
+
+// categoryOf returns the two letter name of the general category of ch, as
+// used by unicode.Categories, or "Cn" if it is unassigned
+func categoryOf(ch rune) string {
+	loadPropertyRanges()
+	return propertyOf(categoryRanges, ch, "Cn")
+}
+
+// scriptBracket is an opening paired bracket waiting for its closing bracket
+type scriptBracket struct {
+	close rune
+	run   int // index of the run containing the opening bracket
+}
+
+// ScriptRuns splits the text of r into runs of the same script. Runes of the
+// Common and Inherited scripts, such as spaces, punctuation and combining
+// marks, are resolved to the script of the preceding run, or of the following
+// run at the start of the text, and a closing paired bracket takes the script
+// of its opening bracket. A text of only Common and Inherited runes is one
+// run of the Common script
+//
+// ScriptRuns was added by go-corelibs
+func ScriptRuns(r RuneReader) (runs []PropertyRun, err error) {
+	var brackets []scriptBracket
+	size := r.Size()
+	for index := int64(0); index < size; {
+		var ch rune
+		var width int
+		if ch, width, err = r.ReadRuneAt(index); err != nil {
+			return nil, err
+		}
+
+		script := scriptOf(ch)
+		if script == "Common" || script == "Inherited" {
+			script = ""
+			if k := matchScriptBracket(brackets, ch); k >= 0 {
+				script = runs[brackets[k].run].Value
+				brackets = brackets[:k]
+			}
+		}
+
+		last := len(runs) - 1
+		switch {
+		case last < 0:
+			runs = append(runs, PropertyRun{Start: index, Value: script})
+		case runs[last].Value == "":
+			// resolve the leading Common runes
+			runs[last].Value = script
+		case script != "" && script != runs[last].Value:
+			runs[last].End = index
+			runs = append(runs, PropertyRun{Start: index, Value: script})
+		}
+		if closing, ok := bidiOpeningBrackets[ch]; ok {
+			brackets = append(brackets, scriptBracket{close: closing, run: len(runs) - 1})
+		}
+		index += int64(width)
+	}
+	if last := len(runs) - 1; last >= 0 {
+		runs[last].End = size
+		if runs[last].Value == "" {
+			runs[last].Value = "Common"
+		}
+	}
+	return runs, nil
+}
+
+// matchScriptBracket returns the index of the innermost open bracket closed
+// by ch, or -1 if ch does not close any
+func matchScriptBracket(brackets []scriptBracket, ch rune) int {
+	if _, ok := bidiClosingBrackets[ch]; !ok {
+		return -1
+	}
+	for k := len(brackets) - 1; k >= 0; k-- {
+		if brackets[k].close == ch {
+			return k
+		}
+	}
+	return -1
+}
+
+// CategoryRuns splits the text of r into runs of the same two letter general
+// category, such as "Lu" for uppercase letters and "Zs" for space separators
+//
+// CategoryRuns was added by go-corelibs
+func CategoryRuns(r RuneReader) (runs []PropertyRun, err error) {
+	size := r.Size()
+	for index := int64(0); index < size; {
+		var ch rune
+		var width int
+		if ch, width, err = r.ReadRuneAt(index); err != nil {
+			return nil, err
+		}
+		category := categoryOf(ch)
+		if last := len(runs) - 1; last < 0 || runs[last].Value != category {
+			if last >= 0 {
+				runs[last].End = index
+			}
+			runs = append(runs, PropertyRun{Start: index, Value: category})
+		}
+		index += int64(width)
+	}
+	if last := len(runs) - 1; last >= 0 {
+		runs[last].End = size
+	}
+	return runs, nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes_test
+
+import (
+	"reflect"
+	"testing"
+
+	. "github.com/go-corelibs/runes"
+)
+
+func TestScriptRuns(t *testing.T) {
+	for _, tc := range []struct {
+		text  string
+		bytes []PropertyRun
+		runes []PropertyRun
+	}{
+		{"", nil, nil},
+		{"123 !", []PropertyRun{{0, 5, "Common"}}, []PropertyRun{{0, 5, "Common"}}},
+		{
+			"- Hello \u041f\u0440\u0438\u0432\u0435\u0442!",
+			[]PropertyRun{{0, 8, "Latin"}, {8, 21, "Cyrillic"}},
+			[]PropertyRun{{0, 8, "Latin"}, {8, 15, "Cyrillic"}},
+		},
+		{
+			"a (\u03b1\u03b2) b",
+			[]PropertyRun{{0, 3, "Latin"}, {3, 7, "Greek"}, {7, 10, "Latin"}},
+			[]PropertyRun{{0, 3, "Latin"}, {3, 5, "Greek"}, {5, 8, "Latin"}},
+		},
+		{
+			"\u6f22\u5b57\u0301 \u304b\u306a",
+			[]PropertyRun{{0, 9, "Han"}, {9, 15, "Hiragana"}},
+			[]PropertyRun{{0, 4, "Han"}, {4, 6, "Hiragana"}},
+		},
+	} {
+		for _, src := range newCaseReaders(tc.text) {
+			want := tc.bytes
+			if _, ok := src.(*Reader); ok {
+				want = tc.runes
+			}
+			if got, err := ScriptRuns(src); err != nil || !reflect.DeepEqual(got, want) {
+				t.Errorf("%+q: got %v,%v; want %v", tc.text, got, err, want)
+			}
+		}
+	}
+}
+
+func TestCategoryRuns(t *testing.T) {
+	text := "Go 1.22\u00a0\u4e16!"
+	want := []PropertyRun{
+		{0, 1, "Lu"}, {1, 2, "Ll"}, {2, 3, "Zs"}, {3, 4, "Nd"}, {4, 5, "Po"},
+		{5, 7, "Nd"}, {7, 9, "Zs"}, {9, 12, "Lo"}, {12, 13, "Po"},
+	}
+	if got, err := CategoryRuns(NewStringReader(text)); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v,%v; want %v", got, err, want)
+	}
+	got, err := CategoryRuns(NewRunesReader([]rune(text)))
+	if err != nil || len(got) != len(want) || got[7] != (PropertyRun{8, 9, "Lo"}) || got[8] != (PropertyRun{9, 10, "Po"}) {
+		t.Errorf("runes: got %v,%v", got, err)
+	}
+	if got, _ = CategoryRuns(NewStringReader("\U000e0080")); len(got) != 1 || got[0].Value != "Cn" {
+		t.Errorf("unassigned: got %v", got)
+	}
+}
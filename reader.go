@@ -4,157 +4,21 @@
 
 package runes
 
-import (
-	"errors"
-	"io"
-	"unicode/utf8"
-)
-
 // A Reader implements the io.Reader, io.ReaderAt, io.WriterTo, io.Seeker,
 // io.ByteScanner, and io.RuneScanner interfaces by reading from
 // a rune slice.
 // Unlike a [Buffer], a Reader is read-only and supports seeking.
 // The zero value for Reader operates like a Reader of an empty slice.
+//
+// All indices and lengths used by a Reader are rune counts, with the exception
+// of the byte counts returned by Read, ReadAt, ReadRune and WriteTo.
 type Reader struct {
-	s        []rune
-	i        int64 // current reading index
-	prevRune int   // index of previous rune; or < 0
-}
-
-// Len returns the number of bytes of the unread portion of the
-// slice.
-func (r *Reader) Len() int {
-	if r.i >= int64(len(r.s)) {
-		return 0
-	}
-	return int(int64(len(r.s)) - r.i)
-}
-
-// Size returns the original length of the underlying byte slice.
-// Size is the number of bytes available for reading via [Reader.ReadAt].
-// The result is unaffected by any method calls except [Reader.Reset].
-func (r *Reader) Size() int64 { return int64(len(r.s)) }
-
-// Read implements the [io.Reader] interface.
-func (r *Reader) Read(b []byte) (n int, err error) {
-	if r.i >= int64(len(r.s)) {
-		return 0, io.EOF
-	}
-	r.prevRune = -1
-	n = copy(b, string(r.s[r.i:]))
-	r.i += int64(n)
-	return
-}
-
-// ReadAt implements the [io.ReaderAt] interface.
-func (r *Reader) ReadAt(b []byte, off int64) (n int, err error) {
-	// cannot modify state - see io.ReaderAt
-	if off < 0 {
-		return 0, errors.New("Reader.ReadAt: negative offset")
-	}
-	if off >= int64(len(r.s)) {
-		return 0, io.EOF
-	}
-	n = copy(b, string(r.s[off:]))
-	if n < len(b) {
-		err = io.EOF
-	}
-	return
-}
-
-// ReadByte implements the [io.ByteReader] interface.
-func (r *Reader) ReadByte() (byte, error) {
-	r.prevRune = -1
-	if r.i >= int64(len(r.s)) {
-		return 0, io.EOF
-	}
-	b := byte(r.s[r.i])
-	r.i++
-	return b, nil
+	textReader[[]rune]
 }
 
-// UnreadByte complements [Reader.ReadByte] in implementing the [io.ByteScanner] interface.
-func (r *Reader) UnreadByte() error {
-	if r.i <= 0 {
-		return errors.New("Reader.UnreadByte: at beginning of slice")
-	}
-	r.prevRune = -1
-	r.i--
-	return nil
+// NewRunesReader returns a new [Reader] reading from runes.
+func NewRunesReader(runes []rune) *Reader {
+	r := &Reader{}
+	r.Reset(runes)
+	return r
 }
-
-// ReadRune implements the [io.RuneReader] interface.
-func (r *Reader) ReadRune() (ch rune, size int, err error) {
-	if r.i >= int64(len(r.s)) {
-		r.prevRune = -1
-		return 0, 0, io.EOF
-	}
-	r.prevRune = int(r.i)
-	if c := r.s[r.i]; c < utf8.RuneSelf {
-		r.i++
-		return rune(c), 1, nil
-	}
-	ch = r.s[r.i]
-	size = utf8.RuneLen(ch)
-	r.i += 1 // rune slice moves by rune, not byte!
-	return
-}
-
-// UnreadRune complements [Reader.ReadRune] in implementing the [io.RuneScanner] interface.
-func (r *Reader) UnreadRune() error {
-	if r.i <= 0 {
-		return errors.New("Reader.UnreadRune: at beginning of slice")
-	}
-	if r.prevRune < 0 {
-		return errors.New("Reader.UnreadRune: previous operation was not ReadRune")
-	}
-	r.i = int64(r.prevRune)
-	r.prevRune = -1
-	return nil
-}
-
-// Seek implements the [io.Seeker] interface.
-func (r *Reader) Seek(offset int64, whence int) (int64, error) {
-	r.prevRune = -1
-	var abs int64
-	switch whence {
-	case io.SeekStart:
-		abs = offset
-	case io.SeekCurrent:
-		abs = r.i + offset
-	case io.SeekEnd:
-		abs = int64(len(r.s)) + offset
-	default:
-		return 0, errors.New("Reader.Seek: invalid whence")
-	}
-	if abs < 0 {
-		return 0, errors.New("Reader.Seek: negative position")
-	}
-	r.i = abs
-	return abs, nil
-}
-
-// WriteTo implements the [io.WriterTo] interface.
-func (r *Reader) WriteTo(w io.Writer) (n int64, err error) {
-	r.prevRune = -1
-	if r.i >= int64(len(r.s)) {
-		return 0, nil
-	}
-	b := r.s[r.i:]
-	m, err := w.Write([]byte(string(b)))
-	if m > len(b) {
-		panic("Reader.WriteTo: invalid Write count")
-	}
-	r.i += int64(m)
-	n = int64(m)
-	if m != len(b) && err == nil {
-		err = io.ErrShortWrite
-	}
-	return
-}
-
-// Reset resets the [Reader] to be reading from b.
-func (r *Reader) Reset(runes []rune) { *r = Reader{runes, 0, -1} }
-
-// NewRunesReader returns a new [Reader.Reader] reading from b.
-func NewRunesReader(runes []rune) *Reader { return &Reader{runes, 0, -1} }
@@ -0,0 +1,101 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/fs"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+var (
+	bomUTF8    = []byte{0xEF, 0xBB, 0xBF}
+	bomUTF16BE = []byte{0xFE, 0xFF}
+	bomUTF16LE = []byte{0xFF, 0xFE}
+	bomUTF32BE = []byte{0x00, 0x00, 0xFE, 0xFF}
+	bomUTF32LE = []byte{0xFF, 0xFE, 0x00, 0x00}
+)
+
+// OpenRuneReader reads the entire named file from fsys and returns it as a
+// RuneReader, see NewRuneReaderFrom for details
+//
+// OpenRuneReader was added by go-corelibs
+func OpenRuneReader(fsys fs.FS, name string) (rr RuneReader, err error) {
+	var data []byte
+	if data, err = fs.ReadFile(fsys, name); err != nil {
+		return nil, err
+	}
+	return newRuneReaderFromBytes(data), nil
+}
+
+// NewRuneReaderFrom reads all of the content from r and returns it as a
+// RuneReader
+//
+// If the content starts with a UTF-32 or UTF-16 byte order mark, the BOM is
+// removed and the remaining content is decoded into a Reader. Otherwise, the
+// content is assumed to be UTF-8, any UTF-8 BOM is removed and a BytesReader
+// is returned. Malformed UTF-16 and UTF-32 sequences are decoded as
+// utf8.RuneError
+//
+// NewRuneReaderFrom was added by go-corelibs
+func NewRuneReaderFrom(r io.Reader) (rr RuneReader, err error) {
+	var data []byte
+	if data, err = io.ReadAll(r); err != nil {
+		return nil, err
+	}
+	return newRuneReaderFromBytes(data), nil
+}
+
+func newRuneReaderFromBytes(data []byte) (rr RuneReader) {
+	// UTF-32LE must be checked before UTF-16LE, they share the same prefix
+	switch {
+	case bytes.HasPrefix(data, bomUTF32BE):
+		return NewRunesReader(decodeUTF32(data[len(bomUTF32BE):], binary.BigEndian))
+	case bytes.HasPrefix(data, bomUTF32LE):
+		return NewRunesReader(decodeUTF32(data[len(bomUTF32LE):], binary.LittleEndian))
+	case bytes.HasPrefix(data, bomUTF16BE):
+		return NewRunesReader(decodeUTF16(data[len(bomUTF16BE):], binary.BigEndian))
+	case bytes.HasPrefix(data, bomUTF16LE):
+		return NewRunesReader(decodeUTF16(data[len(bomUTF16LE):], binary.LittleEndian))
+	case bytes.HasPrefix(data, bomUTF8):
+		return NewBytesReader(data[len(bomUTF8):])
+	}
+	return NewBytesReader(data)
+}
+
+// decodeUTF16 decodes the UTF-16 encoded data, with any trailing odd byte
+// decoded as utf8.RuneError
+func decodeUTF16(data []byte, order binary.ByteOrder) (decoded []rune) {
+	units := make([]uint16, len(data)/2)
+	for idx := range units {
+		units[idx] = order.Uint16(data[idx*2:])
+	}
+	decoded = utf16.Decode(units)
+	if len(data)%2 != 0 {
+		decoded = append(decoded, utf8.RuneError)
+	}
+	return
+}
+
+// decodeUTF32 decodes the UTF-32 encoded data, with any invalid code points
+// and trailing partial units decoded as utf8.RuneError
+func decodeUTF32(data []byte, order binary.ByteOrder) (decoded []rune) {
+	decoded = make([]rune, 0, (len(data)+3)/4)
+	for len(data) >= 4 {
+		if ch := rune(order.Uint32(data)); utf8.ValidRune(ch) {
+			decoded = append(decoded, ch)
+		} else {
+			decoded = append(decoded, utf8.RuneError)
+		}
+		data = data[4:]
+	}
+	if len(data) > 0 {
+		decoded = append(decoded, utf8.RuneError)
+	}
+	return
+}
@@ -0,0 +1,99 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+	"unicode/utf16"
+
+	. "github.com/go-corelibs/runes"
+)
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) { return 0, errors.New("broken") }
+
+func encodeUTF16(text string, order binary.AppendByteOrder, bom []byte) []byte {
+	data := append([]byte(nil), bom...)
+	for _, unit := range utf16.Encode([]rune(text)) {
+		data = order.AppendUint16(data, unit)
+	}
+	return data
+}
+
+func encodeUTF32(text string, order binary.AppendByteOrder, bom []byte) []byte {
+	data := append([]byte(nil), bom...)
+	for _, ch := range text {
+		data = order.AppendUint32(data, uint32(ch))
+	}
+	return data
+}
+
+func TestNewRuneReaderFrom(t *testing.T) {
+	const text = "hello, 世界 \U0001F600"
+	for _, tt := range []struct {
+		name  string
+		data  []byte
+		want  string
+		runes bool
+	}{
+		{"plain", []byte(text), text, false},
+		{"empty", nil, "", false},
+		{"utf-8", append([]byte{0xEF, 0xBB, 0xBF}, text...), text, false},
+		{"utf-16be", encodeUTF16(text, binary.BigEndian, []byte{0xFE, 0xFF}), text, true},
+		{"utf-16le", encodeUTF16(text, binary.LittleEndian, []byte{0xFF, 0xFE}), text, true},
+		{"utf-32be", encodeUTF32(text, binary.BigEndian, []byte{0, 0, 0xFE, 0xFF}), text, true},
+		{"utf-32le", encodeUTF32(text, binary.LittleEndian, []byte{0xFF, 0xFE, 0, 0}), text, true},
+		{"utf-16le odd", []byte{0xFF, 0xFE, 'a', 0, 'b'}, "a�", true},
+		{"utf-16be lone surrogate", []byte{0xFE, 0xFF, 0xD8, 0x00, 0, 'a'}, "�a", true},
+		{"utf-32be invalid", []byte{0, 0, 0xFE, 0xFF, 0, 0x11, 0, 0, 0, 0, 0, 'a', 0}, "�a�", true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewRuneReaderFrom(bytes.NewReader(tt.data))
+			if err != nil {
+				t.Fatalf("NewRuneReaderFrom: unexpected error: %v", err)
+			}
+			if _, ok := r.(*Reader); ok != tt.runes {
+				t.Errorf("NewRuneReaderFrom: got %T", r)
+			}
+			if data, _ := io.ReadAll(r); string(data) != tt.want {
+				t.Errorf("NewRuneReaderFrom: got %q; want %q", data, tt.want)
+			}
+		})
+	}
+
+	if r, err := NewRuneReaderFrom(errReader{}); r != nil || err == nil {
+		t.Errorf("NewRuneReaderFrom: got %v, %v; want nil, error", r, err)
+	}
+}
+
+func TestOpenRuneReader(t *testing.T) {
+	fsys := fstest.MapFS{
+		"utf8.txt":  {Data: []byte("\xEF\xBB\xBFstuff")},
+		"utf16.txt": {Data: encodeUTF16("日本語", binary.LittleEndian, []byte{0xFF, 0xFE})},
+	}
+
+	if r, err := OpenRuneReader(fsys, "utf8.txt"); err != nil {
+		t.Errorf("OpenRuneReader: unexpected error: %v", err)
+	} else if ch, size, err := r.ReadRuneAt(0); ch != 's' || size != 1 || err != nil {
+		t.Errorf("OpenRuneReader: got %q, %d, %v; want 's', 1, nil", ch, size, err)
+	}
+
+	if r, err := OpenRuneReader(fsys, "utf16.txt"); err != nil {
+		t.Errorf("OpenRuneReader: unexpected error: %v", err)
+	} else if slice, size, err := r.ReadRuneSlice(1, 2); string(slice) != "本語" || size != 2 || err != nil {
+		t.Errorf("OpenRuneReader: got %q, %d, %v; want \"本語\", 2, nil", string(slice), size, err)
+	}
+
+	if r, err := OpenRuneReader(fsys, "missing.txt"); r != nil || !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("OpenRuneReader: got %v, %v; want nil, fs.ErrNotExist", r, err)
+	}
+}
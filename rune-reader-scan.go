@@ -0,0 +1,89 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"errors"
+	"io"
+)
+
+// scanRunes reads the runes of r from the index given for as long as fn
+// returns true, collecting them when collect is true, and returns the index
+// following the last rune read. When inclusive is true, the rune which stopped
+// the scan is also read. The reader is left positioned as by ReadRuneSlice:
+// after the last rune read, which may be unread with UnreadRune
+func scanRunes(r RuneReader, name string, index int64, collect, inclusive bool, fn func(ch rune) bool) (slice []rune, end int64, err error) {
+	size := r.Size()
+	if index < 0 {
+		return nil, index, errors.New(name + ": negative position")
+	} else if index >= size {
+		return nil, size, io.EOF
+	}
+	last := int64(-1)
+	for end = index; end < size; {
+		var ch rune
+		var width int
+		if ch, width, err = r.ReadRuneAt(end); err != nil {
+			return slice, end, err
+		}
+		stop := !fn(ch)
+		if stop && !inclusive {
+			break
+		}
+		if collect {
+			slice = append(slice, ch)
+		}
+		last = end
+		end += int64(width)
+		if stop {
+			break
+		}
+	}
+	if last >= 0 {
+		// leave the last rune read ready to be unread
+		_, _, err = r.ReadRuneAt(last)
+	} else {
+		_, err = r.Seek(index, io.SeekStart)
+	}
+	return slice, end, err
+}
+
+// readWhile implements RuneScanReader.ReadWhile
+func readWhile(r RuneReader, name string, index int64, fn func(ch rune) bool) (slice []rune, size int, err error) {
+	var end int64
+	slice, end, err = scanRunes(r, name, index, true, false, fn)
+	return slice, int(max(end-index, 0)), err
+}
+
+// readUntil implements RuneScanReader.ReadUntil
+func readUntil(r RuneReader, name string, index int64, delims *RuneSet, inclusive bool) (slice []rune, size int, err error) {
+	var end int64
+	slice, end, err = scanRunes(r, name, index, true, inclusive, func(ch rune) bool {
+		return !delims.Contains(ch)
+	})
+	return slice, int(max(end-index, 0)), err
+}
+
+// skipWhile implements RuneScanReader.SkipWhile
+func skipWhile(r RuneReader, name string, index int64, fn func(ch rune) bool) (next int64, err error) {
+	_, next, err = scanRunes(r, name, index, false, false, fn)
+	return
+}
+
+// readLine implements RuneScanReader.ReadLine
+func readLine(r RuneReader, name string, index int64) (line []rune, next int64, err error) {
+	if line, next, err = scanRunes(r, name, index, true, true, func(ch rune) bool {
+		return ch != '\n'
+	}); err != nil {
+		return
+	}
+	if n := len(line); n > 0 && line[n-1] == '\n' {
+		line = line[:n-1]
+		if n > 1 && line[n-2] == '\r' {
+			line = line[:n-2]
+		}
+	}
+	return
+}
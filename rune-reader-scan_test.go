@@ -0,0 +1,134 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes_test
+
+import (
+	"io"
+	"testing"
+	"unicode"
+
+	. "github.com/go-corelibs/runes"
+)
+
+const scanText = "key \u4e16 = value;\r\nnext\n\nlast"
+
+// scanReader is a RuneReader which also implements RuneScanReader
+type scanReader interface {
+	RuneReader
+	RuneScanReader
+}
+
+// scanIndex returns the native index of the prefix given of scanText
+func scanIndex(r RuneReader, prefix string) int64 {
+	return int64(nativeSize(r, prefix))
+}
+
+//gocyclo:ignore
+func TestReadWhile(t *testing.T) {
+	delims := NewRuneSet("=;")
+	for _, rr := range newCaseReaders(scanText) {
+		r := rr.(scanReader)
+		slice, size, err := r.ReadWhile(0, unicode.IsLetter)
+		if string(slice) != "key" || size != 3 || err != nil {
+			t.Errorf("%T ReadWhile: got %q,%d,%v", r, string(slice), size, err)
+		}
+		// the reader is left after the last rune read, ready to be unread
+		if ch, _, _ := r.ReadRune(); ch != ' ' {
+			t.Errorf("%T ReadWhile: next rune %q", r, ch)
+		}
+		if err = r.UnreadRune(); err != nil {
+			t.Errorf("%T ReadWhile: UnreadRune %v", r, err)
+		}
+
+		slice, size, err = r.ReadUntil(0, delims, false)
+		if want := "key \u4e16 "; string(slice) != want || size != nativeSize(r, want) || err != nil {
+			t.Errorf("%T ReadUntil: got %q,%d,%v", r, string(slice), size, err)
+		}
+		if ch, _, _ := r.ReadRune(); ch != '=' {
+			t.Errorf("%T ReadUntil: next rune %q", r, ch)
+		}
+		slice, size, err = r.ReadUntil(scanIndex(r, "key \u4e16 ="), delims, true)
+		if string(slice) != " value;" || size != 7 || err != nil {
+			t.Errorf("%T ReadUntil inclusive: got %q,%d,%v", r, string(slice), size, err)
+		}
+		if err = r.UnreadRune(); err != nil {
+			t.Errorf("%T ReadUntil inclusive: UnreadRune %v", r, err)
+		} else if ch, _, _ := r.ReadRune(); ch != ';' {
+			t.Errorf("%T ReadUntil inclusive: unread rune %q", r, ch)
+		}
+		slice, size, err = r.ReadUntil(scanIndex(r, scanText[:len(scanText)-4]), delims, true)
+		if string(slice) != "last" || size != 4 || err != nil {
+			t.Errorf("%T ReadUntil to the end: got %q,%d,%v", r, string(slice), size, err)
+		}
+		// a nil delims is an empty set
+		slice, size, err = r.ReadUntil(scanIndex(r, "key \u4e16 = value;\r\n"), nil, false)
+		if string(slice) != "next\n\nlast" || size != 10 || err != nil {
+			t.Errorf("%T ReadUntil nil: got %q,%d,%v", r, string(slice), size, err)
+		}
+
+		next, err := r.SkipWhile(3, func(ch rune) bool { return ch == ' ' || ch > unicode.MaxASCII })
+		if want := scanIndex(r, "key \u4e16 "); next != want || err != nil {
+			t.Errorf("%T SkipWhile: got %d,%v; want %d", r, next, err, want)
+		}
+		if next, err = r.SkipWhile(0, unicode.IsDigit); next != 0 || err != nil {
+			t.Errorf("%T SkipWhile none: got %d,%v", r, next, err)
+		}
+		if ch, _, _ := r.ReadRune(); ch != 'k' {
+			t.Errorf("%T SkipWhile none: next rune %q", r, ch)
+		}
+		if next, err = r.SkipWhile(0, func(rune) bool { return true }); next != r.Size() || err != nil {
+			t.Errorf("%T SkipWhile all: got %d,%v", r, next, err)
+		}
+
+		if _, _, err = r.ReadWhile(-1, unicode.IsLetter); err == nil {
+			t.Errorf("%T ReadWhile(-1): expected error", r)
+		}
+		if _, _, err = r.ReadUntil(r.Size(), delims, false); err != io.EOF {
+			t.Errorf("%T ReadUntil(size): got %v; want io.EOF", r, err)
+		}
+		if _, err = r.SkipWhile(r.Size(), unicode.IsLetter); err != io.EOF {
+			t.Errorf("%T SkipWhile(size): got %v; want io.EOF", r, err)
+		}
+	}
+}
+
+func TestReadLine(t *testing.T) {
+	for _, r := range []scanReader{
+		NewBytesReader([]byte(scanText)),
+		NewStringReader(scanText),
+		NewRunesReader([]rune(scanText)),
+		NewLineEndingReader(NewStringReader(scanText), 0),
+	} {
+		var lines []string
+		var index int64
+		for {
+			line, next, err := r.ReadLine(index)
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("%T ReadLine(%d): %v", r, index, err)
+			}
+			lines = append(lines, string(line))
+			index = next
+		}
+		if len(lines) != 4 || lines[0] != "key \u4e16 = value;" || lines[1] != "next" || lines[2] != "" || lines[3] != "last" {
+			t.Errorf("%T ReadLine: got %q", r, lines)
+		}
+		if index != r.Size() {
+			t.Errorf("%T ReadLine: ended at %d; want %d", r, index, r.Size())
+		}
+	}
+
+	r := NewStringReader("a\r\nb")
+	if line, next, err := r.ReadLine(0); string(line) != "a" || next != 3 || err != nil {
+		t.Errorf("ReadLine: got %q,%d,%v", string(line), next, err)
+	}
+	if ch, _, _ := r.ReadRune(); ch != 'b' {
+		t.Errorf("ReadLine: next rune %q", ch)
+	}
+	if _, _, err := r.ReadLine(-1); err == nil || err.Error() != "StringReader.ReadLine: negative position" {
+		t.Errorf("ReadLine(-1): got %v", err)
+	}
+}
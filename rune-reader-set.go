@@ -0,0 +1,52 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"errors"
+	"io"
+)
+
+// indexAny implements RuneSetReader.IndexAny, leaving the reader positioned
+// at the rune found
+func indexAny(r RuneReader, name string, index int64, set *RuneSet) (found int64, err error) {
+	size := r.Size()
+	if index < 0 {
+		return -1, errors.New(name + ": negative position")
+	} else if index >= size {
+		return -1, io.EOF
+	}
+	for found = index; found < size; {
+		var ch rune
+		var width int
+		if ch, width, err = r.ReadRuneAt(found); err != nil {
+			return -1, err
+		} else if set.Contains(ch) {
+			if _, err = r.Seek(found, io.SeekStart); err != nil {
+				return -1, err
+			}
+			return found, nil
+		}
+		found += int64(width)
+	}
+	return -1, nil
+}
+
+// accept implements RuneSetReader.Accept
+func accept(r RuneReader, name string, index int64, set *RuneSet) (ok bool, size int, err error) {
+	if index < 0 {
+		return false, 0, errors.New(name + ": negative position")
+	} else if index >= r.Size() {
+		return false, 0, io.EOF
+	}
+	var ch rune
+	if ch, size, err = r.ReadRuneAt(index); err != nil {
+		return false, 0, err
+	} else if !set.Contains(ch) {
+		_, err = r.Seek(index, io.SeekStart)
+		return false, 0, err
+	}
+	return true, size, nil
+}
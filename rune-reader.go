@@ -6,10 +6,14 @@ package runes
 
 import (
 	"io"
+	"strings"
 )
 
 // RuneReader defines the interface for additional rune-specific features when
 // reading data from a string, bytes or rune slices
+//
+// All indices, counts and sizes are in the native units of the underlying
+// data: bytes for BytesReader and StringReader, runes for Reader
 type RuneReader interface {
 	io.Reader
 	io.ReaderAt
@@ -18,7 +22,7 @@ type RuneReader interface {
 	io.ByteScanner
 	io.RuneScanner
 
-	// Len returns the byte length of the underlying memory
+	// Len returns the length of the unread portion of the underlying data
 	Len() int
 
 	// Size returns the length of the underlying slice
@@ -39,22 +43,69 @@ type RuneReader interface {
 	// up to the count requested, returning a slice and the total size
 	//
 	// For byte and string readers, size is the number of bytes in the rune
-	// slice. For the rune reader, the size is always 1, which works because the
-	// underlying data type is just a slice of runes (no decoding of multi-bytes
-	// needed)
+	// slice. For the rune reader, size is the number of runes in the slice
 	ReadRuneSlice(index, count int64) (slice []rune, size int, err error)
 
-	// ReadByteSlice is like ReadRuneSlice, but for byte slices
+	// ReadByteSlice seeks to the index given and returns the UTF-8 encoding of
+	// the next count native units
 	ReadByteSlice(index, count int64) (slice []byte, err error)
 
-	// ReadString is like ReadRuneSlice, but for a string
+	// ReadString is like ReadByteSlice, but returns a string
 	ReadString(index, count int64) (slice string, err error)
 }
 
+// RuneAppender is an optional interface implemented by the readers of this
+// package, for reusing the caller's buffer instead of allocating a new one on
+// each call
+type RuneAppender interface {
+	// AppendRuneSlice is like ReadRuneSlice, but appends to dst
+	AppendRuneSlice(dst []rune, index, count int64) (slice []rune, size int, err error)
+
+	// AppendByteSlice is like ReadByteSlice, but appends to dst
+	AppendByteSlice(dst []byte, index, count int64) (slice []byte, err error)
+
+	// AppendString is like ReadString, but writes to dst
+	AppendString(dst *strings.Builder, index, count int64) (err error)
+}
+
+// RuneSetReader is an optional interface implemented by the readers of this
+// package, for searching and accepting the runes of a RuneSet
+type RuneSetReader interface {
+	// IndexAny returns the index of the first rune in the set, from the
+	// index given, or -1 when there is none
+	IndexAny(index int64, set *RuneSet) (found int64, err error)
+
+	// Accept reads the rune at the index given when it is in the set,
+	// returning true and its size
+	Accept(index int64, set *RuneSet) (ok bool, size int, err error)
+}
+
+// RuneScanReader is an optional interface implemented by the readers of this
+// package, for reading the runes which satisfy a predicate and reading lines
+type RuneScanReader interface {
+	// ReadWhile is like ReadRuneSlice, but reads the runes from the index
+	// given for as long as fn returns true
+	ReadWhile(index int64, fn func(ch rune) bool) (slice []rune, size int, err error)
+
+	// ReadUntil is like ReadRuneSlice, but reads the runes from the index
+	// given up to the first rune in delims, which is also read when inclusive
+	// is true. A nil delims is an empty set, reading to the end of the text
+	ReadUntil(index int64, delims *RuneSet, inclusive bool) (slice []rune, size int, err error)
+
+	// SkipWhile returns the index of the first rune, from the index given,
+	// for which fn returns false, or the size when there is none
+	SkipWhile(index int64, fn func(ch rune) bool) (next int64, err error)
+
+	// ReadLine reads the line at the index given, up to the next line feed,
+	// and returns it without the line feed or carriage return line feed,
+	// along with the index of the next line
+	ReadLine(index int64) (line []rune, next int64, err error)
+}
+
 // NewRuneReader is a generic wrapper around constructing a NewBytesReader,
 // NewStringReader or NewRunesReader depending on the input type given and
 // returned as a RuneReader
-func NewRuneReader[V []rune | []byte | string](input V) (rb RuneReader) {
+func NewRuneReader[V Text](input V) (rb RuneReader) {
 	v := &input
 	switch t := interface{}(v).(type) {
 	case *[]byte:
@@ -67,3 +118,48 @@ func NewRuneReader[V []rune | []byte | string](input V) (rb RuneReader) {
 		panic("the universe is broken")
 	}
 }
+
+// runeReader is an unexported alias of RuneReader, for embedding without
+// exporting the field
+type runeReader = RuneReader
+
+// textRuneReader is the reader returned by textReaderFor, along with the
+// optional interfaces it implements, for embedding so that the wrappers of
+// this package promote the optional methods as well
+type textRuneReader interface {
+	RuneReader
+	RuneAppender
+	RuneSetReader
+	RuneScanReader
+}
+
+// runeIndexer is implemented by the readers of this package to report if
+// their native units are runes instead of bytes
+type runeIndexer interface {
+	runeIndexed() bool
+}
+
+// readText returns the entire text of r, along with whether the native units
+// of r are runes instead of bytes
+func readText(r RuneReader) (text string, runes bool, err error) {
+	if size := r.Size(); size > 0 {
+		if text, err = r.ReadString(0, size); err != nil {
+			return "", false, err
+		}
+		// when the sizes match, byte and rune indices are the same
+		runes = int64(len(text)) != size
+	}
+	if ri, ok := r.(runeIndexer); ok {
+		runes = ri.runeIndexed()
+	}
+	return
+}
+
+// textReaderFor returns a new RuneReader for text, using the same native units
+// as indicated by runes
+func textReaderFor(text string, runes bool) textRuneReader {
+	if runes {
+		return NewRunesReader([]rune(text))
+	}
+	return NewStringReader(text)
+}
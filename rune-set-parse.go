@@ -0,0 +1,265 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// RuneSetError describes a character class which could not be parsed and
+// where in the pattern the problem was found
+type RuneSetError struct {
+	// Index is the byte offset of the problem in the pattern
+	Index int
+	// Msg describes the problem
+	Msg string
+}
+
+// Error returns the message of the RuneSetError with its position
+func (e *RuneSetError) Error() string {
+	return "ParseRuneSet: " + e.Msg + " at " + strconv.Itoa(e.Index)
+}
+
+var (
+	digitRuneSet = NewRuneSetRange('0', '9')
+	wordRuneSet  = NewRuneSet("_").Union(digitRuneSet, NewRuneSetRange('A', 'Z'), NewRuneSetRange('a', 'z'))
+	spaceRuneSet = NewRuneSet("\t\n\f\r ")
+)
+
+// ParseRuneSet returns a new RuneSet of the regular expression character
+// class given, such as `[a-z\p{Greek}&&[^x]]`. The class may contain runes,
+// ranges, nested classes and the escapes of the Go regexp package, such as
+// \d, \w, \s, \pL, \p{Greek}, \P{Lu}, \x{263a} and \n. The && operator
+// intersects the items on either side of it and a leading ^ negates the
+// class. A pattern of a single escape, such as `\p{Greek}`, is also accepted
+//
+// ParseRuneSet was added by go-corelibs
+func ParseRuneSet(pattern string) (s *RuneSet, err error) {
+	p := &runeSetParser{src: pattern}
+	if strings.HasPrefix(pattern, "[") {
+		s, err = p.class()
+	} else if strings.HasPrefix(pattern, `\`) {
+		var ch rune
+		if s, ch, err = p.escape(); err == nil && s == nil {
+			s = NewRuneSetRange(ch, ch)
+		}
+	} else {
+		return nil, &RuneSetError{Index: 0, Msg: "expected [ or \\"}
+	}
+	if err == nil && p.pos < len(p.src) {
+		return nil, &RuneSetError{Index: p.pos, Msg: "unexpected text after class"}
+	}
+	return
+}
+
+// runeSetParser parses the regular expression syntax of ParseRuneSet
+type runeSetParser struct {
+	src string
+	pos int
+}
+
+// peek returns the rune at the current position and its width, or -1 at the
+// end of the pattern
+func (p *runeSetParser) peek() (ch rune, width int) {
+	if p.pos >= len(p.src) {
+		return -1, 0
+	}
+	return utf8.DecodeRuneInString(p.src[p.pos:])
+}
+
+// class parses a bracketed character class
+func (p *runeSetParser) class() (s *RuneSet, err error) {
+	start := p.pos
+	p.pos++ // the [
+	negate := strings.HasPrefix(p.src[p.pos:], "^")
+	if negate {
+		p.pos++
+	}
+	if s, err = p.union(); err != nil {
+		return nil, err
+	}
+	for {
+		if strings.HasPrefix(p.src[p.pos:], "&&") {
+			p.pos += 2
+			var other *RuneSet
+			if other, err = p.union(); err != nil {
+				return nil, err
+			}
+			s = s.Intersect(other)
+		} else if strings.HasPrefix(p.src[p.pos:], "]") {
+			p.pos++
+			break
+		} else {
+			return nil, &RuneSetError{Index: start, Msg: "unterminated class"}
+		}
+	}
+	if negate {
+		s = s.Negate()
+	}
+	return s, nil
+}
+
+// union parses the items of a class up to the next && or closing bracket
+func (p *runeSetParser) union() (s *RuneSet, err error) {
+	var sets []*RuneSet
+	var ranges []runeRange
+	for {
+		ch, width := p.peek()
+		if ch < 0 || ch == ']' || strings.HasPrefix(p.src[p.pos:], "&&") {
+			break
+		}
+		var set *RuneSet
+		start := p.pos
+		switch ch {
+		case '[':
+			set, err = p.class()
+		case '\\':
+			set, ch, err = p.escape()
+		default:
+			p.pos += width
+		}
+		if err != nil {
+			return nil, err
+		} else if set != nil {
+			sets = append(sets, set)
+			continue
+		}
+
+		lo, hi := ch, ch
+		if strings.HasPrefix(p.src[p.pos:], "-") && p.pos+1 < len(p.src) && p.src[p.pos+1] != ']' {
+			p.pos++
+			if hi, err = p.single(); err != nil {
+				return nil, err
+			} else if hi < lo {
+				return nil, &RuneSetError{Index: start, Msg: "invalid range"}
+			}
+		}
+		ranges = append(ranges, runeRange{lo, hi})
+	}
+	return newRuneSet(ranges).Union(sets...), nil
+}
+
+// single parses one rune, the end of a range
+func (p *runeSetParser) single() (ch rune, err error) {
+	start := p.pos
+	var width int
+	switch ch, width = p.peek(); ch {
+	case -1, '[':
+		return 0, &RuneSetError{Index: start, Msg: "invalid range"}
+	case '\\':
+		var set *RuneSet
+		if set, ch, err = p.escape(); err == nil && set != nil {
+			return 0, &RuneSetError{Index: start, Msg: "invalid range"}
+		}
+		return
+	}
+	p.pos += width
+	return ch, nil
+}
+
+// escape parses an escape sequence, returning either the set of a class
+// escape or the rune of a character escape
+func (p *runeSetParser) escape() (s *RuneSet, ch rune, err error) {
+	start := p.pos
+	p.pos++ // the backslash
+	var width int
+	if ch, width = p.peek(); ch < 0 {
+		return nil, 0, &RuneSetError{Index: start, Msg: "trailing backslash"}
+	}
+	p.pos += width
+	switch ch {
+	case 'd':
+		return digitRuneSet, 0, nil
+	case 'D':
+		return digitRuneSet.Negate(), 0, nil
+	case 'w':
+		return wordRuneSet, 0, nil
+	case 'W':
+		return wordRuneSet.Negate(), 0, nil
+	case 's':
+		return spaceRuneSet, 0, nil
+	case 'S':
+		return spaceRuneSet.Negate(), 0, nil
+	case 'p', 'P':
+		s, err = p.property(start, ch == 'P')
+		return s, 0, err
+	case 'a':
+		return nil, '\a', nil
+	case 'f':
+		return nil, '\f', nil
+	case 'n':
+		return nil, '\n', nil
+	case 'r':
+		return nil, '\r', nil
+	case 't':
+		return nil, '\t', nil
+	case 'v':
+		return nil, '\v', nil
+	case 'x', 'u', 'U':
+		ch, err = p.hex(start, ch)
+		return nil, ch, err
+	}
+	if ch < utf8.RuneSelf && !unicode.IsLetter(ch) && !unicode.IsDigit(ch) {
+		return nil, ch, nil
+	}
+	return nil, 0, &RuneSetError{Index: start, Msg: "unknown escape"}
+}
+
+// property parses the name of a \p or \P escape
+func (p *runeSetParser) property(start int, negate bool) (s *RuneSet, err error) {
+	var name string
+	if strings.HasPrefix(p.src[p.pos:], "{") {
+		end := strings.IndexByte(p.src[p.pos:], '}')
+		if end < 0 {
+			return nil, &RuneSetError{Index: start, Msg: "unterminated property"}
+		}
+		name = p.src[p.pos+1 : p.pos+end]
+		p.pos += end + 1
+	} else if ch, width := p.peek(); ch >= 0 {
+		name = string(ch)
+		p.pos += width
+	}
+	if strings.HasPrefix(name, "^") {
+		name, negate = name[1:], !negate
+	}
+	if s, err = NewRuneSetProperty(name); err != nil {
+		return nil, &RuneSetError{Index: start, Msg: "unknown property " + strconv.Quote(name)}
+	} else if negate {
+		s = s.Negate()
+	}
+	return s, nil
+}
+
+// hex parses the digits of a \x, \u or \U escape: \xHH, \x{H...}, \uHHHH or
+// \UHHHHHHHH
+func (p *runeSetParser) hex(start int, kind rune) (ch rune, err error) {
+	n := 2
+	if kind == 'u' {
+		n = 4
+	} else if kind == 'U' {
+		n = 8
+	}
+	var digits string
+	switch {
+	case kind == 'x' && strings.HasPrefix(p.src[p.pos:], "{"):
+		end := strings.IndexByte(p.src[p.pos:], '}')
+		if end < 0 {
+			return 0, &RuneSetError{Index: start, Msg: "unterminated escape"}
+		}
+		digits = p.src[p.pos+1 : p.pos+end]
+		p.pos += end + 1
+	case p.pos+n <= len(p.src):
+		digits = p.src[p.pos : p.pos+n]
+		p.pos += n
+	}
+	value, ee := strconv.ParseUint(digits, 16, 32)
+	if ee != nil || value > unicode.MaxRune {
+		return 0, &RuneSetError{Index: start, Msg: "invalid escape"}
+	}
+	return rune(value), nil
+}
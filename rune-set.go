@@ -0,0 +1,274 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// runeRange is an inclusive range of runes
+type runeRange struct {
+	lo, hi rune
+}
+
+// RuneSet is an immutable set of runes, such as a character class of a
+// lexer. Membership of the Latin-1 runes is tested with a bitmap and the
+// other runes with a binary search of the ranges of the set
+//
+// RuneSets are built from ranges, strings, unicode.RangeTables and property
+// names, combined with Union, Intersect, Difference and Negate, or parsed
+// from regular expression character classes with ParseRuneSet. The Contains
+// method may be used wherever a func(rune) bool is wanted
+//
+// A nil RuneSet is empty, both as the receiver and as the argument of the
+// methods
+type RuneSet struct {
+	latin  [4]uint64   // bitmap of U+0000 to U+00FF
+	ranges []runeRange // sorted, neither overlapping nor adjacent
+}
+
+// newRuneSet returns a new RuneSet of the ranges given, which are clamped to
+// the valid runes, sorted and merged
+func newRuneSet(ranges []runeRange) *RuneSet {
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].lo < ranges[j].lo })
+	s := &RuneSet{}
+	for _, rr := range ranges {
+		rr.lo, rr.hi = max(rr.lo, 0), min(rr.hi, unicode.MaxRune)
+		if rr.lo > rr.hi {
+			continue
+		} else if last := len(s.ranges) - 1; last >= 0 && rr.lo <= s.ranges[last].hi+1 {
+			s.ranges[last].hi = max(s.ranges[last].hi, rr.hi)
+		} else {
+			s.ranges = append(s.ranges, rr)
+		}
+	}
+	for _, rr := range s.ranges {
+		if rr.lo > unicode.MaxLatin1 {
+			break
+		}
+		for ch := rr.lo; ch <= min(rr.hi, unicode.MaxLatin1); ch++ {
+			s.latin[ch>>6] |= 1 << (ch & 63)
+		}
+	}
+	return s
+}
+
+// NewRuneSet returns a new RuneSet of the runes of the strings given
+//
+// NewRuneSet was added by go-corelibs
+func NewRuneSet(text ...string) *RuneSet {
+	var ranges []runeRange
+	for _, s := range text {
+		for _, ch := range s {
+			ranges = append(ranges, runeRange{ch, ch})
+		}
+	}
+	return newRuneSet(ranges)
+}
+
+// NewRuneSetRange returns a new RuneSet of the runes from lo to hi,
+// inclusive. The range is clamped to the runes from zero to unicode.MaxRune
+// and the set is empty when hi is less than lo
+//
+// NewRuneSetRange was added by go-corelibs
+func NewRuneSetRange(lo, hi rune) *RuneSet {
+	return newRuneSet([]runeRange{{lo, hi}})
+}
+
+// NewRuneSetTable returns a new RuneSet of the runes of the tables given
+//
+// NewRuneSetTable was added by go-corelibs
+func NewRuneSetTable(tables ...*unicode.RangeTable) *RuneSet {
+	var ranges []runeRange
+	add := func(lo, hi, stride rune) {
+		if stride == 1 {
+			ranges = append(ranges, runeRange{lo, hi})
+			return
+		}
+		for ch := lo; ch <= hi; ch += stride {
+			ranges = append(ranges, runeRange{ch, ch})
+		}
+	}
+	for _, table := range tables {
+		for _, r16 := range table.R16 {
+			add(rune(r16.Lo), rune(r16.Hi), rune(r16.Stride))
+		}
+		for _, r32 := range table.R32 {
+			add(rune(r32.Lo), rune(r32.Hi), rune(r32.Stride))
+		}
+	}
+	return newRuneSet(ranges)
+}
+
+// NewRuneSetProperty returns a new RuneSet of the runes with the Unicode
+// property given, which is the name of a general category, script or
+// property of the unicode package, such as "Lu", "Greek" or "White_Space",
+// or one of "Any" and "ASCII"
+//
+// NewRuneSetProperty was added by go-corelibs
+func NewRuneSetProperty(name string) (s *RuneSet, err error) {
+	switch name {
+	case "Any":
+		return NewRuneSetRange(0, unicode.MaxRune), nil
+	case "ASCII":
+		return NewRuneSetRange(0, unicode.MaxASCII), nil
+	}
+	for _, tables := range []map[string]*unicode.RangeTable{unicode.Categories, unicode.Scripts, unicode.Properties} {
+		if table, ok := tables[name]; ok {
+			return NewRuneSetTable(table), nil
+		}
+	}
+	return nil, errors.New("NewRuneSetProperty: unknown property " + strconv.Quote(name))
+}
+
+// Contains returns true if ch is in the set. A nil RuneSet is empty
+//
+// Contains was added by go-corelibs
+func (s *RuneSet) Contains(ch rune) bool {
+	if s == nil {
+		return false
+	}
+	if 0 <= ch && ch <= unicode.MaxLatin1 {
+		return s.latin[ch>>6]&(1<<(ch&63)) != 0
+	}
+	k := sort.Search(len(s.ranges), func(i int) bool { return s.ranges[i].hi >= ch })
+	return k < len(s.ranges) && s.ranges[k].lo <= ch
+}
+
+// rangesOf returns the ranges of the set, which are none for a nil RuneSet
+func (s *RuneSet) rangesOf() []runeRange {
+	if s == nil {
+		return nil
+	}
+	return s.ranges
+}
+
+// IsEmpty returns true if the set has no runes
+//
+// IsEmpty was added by go-corelibs
+func (s *RuneSet) IsEmpty() bool {
+	return len(s.rangesOf()) == 0
+}
+
+// Len returns the number of runes in the set
+//
+// Len was added by go-corelibs
+func (s *RuneSet) Len() (n int) {
+	for _, rr := range s.rangesOf() {
+		n += int(rr.hi-rr.lo) + 1
+	}
+	return
+}
+
+// Union returns a new RuneSet of the runes in s or any of the others
+//
+// Union was added by go-corelibs
+func (s *RuneSet) Union(others ...*RuneSet) *RuneSet {
+	ranges := append([]runeRange(nil), s.rangesOf()...)
+	for _, other := range others {
+		ranges = append(ranges, other.rangesOf()...)
+	}
+	return newRuneSet(ranges)
+}
+
+// Intersect returns a new RuneSet of the runes in both s and other
+//
+// Intersect was added by go-corelibs
+func (s *RuneSet) Intersect(other *RuneSet) *RuneSet {
+	var ranges []runeRange
+	sr, or := s.rangesOf(), other.rangesOf()
+	for i, j := 0, 0; i < len(sr) && j < len(or); {
+		a, b := sr[i], or[j]
+		if lo, hi := max(a.lo, b.lo), min(a.hi, b.hi); lo <= hi {
+			ranges = append(ranges, runeRange{lo, hi})
+		}
+		if a.hi < b.hi {
+			i++
+		} else {
+			j++
+		}
+	}
+	return newRuneSet(ranges)
+}
+
+// Negate returns a new RuneSet of the runes which are not in s
+//
+// Negate was added by go-corelibs
+func (s *RuneSet) Negate() *RuneSet {
+	var ranges []runeRange
+	next := rune(0)
+	for _, rr := range s.rangesOf() {
+		if rr.lo > next {
+			ranges = append(ranges, runeRange{next, rr.lo - 1})
+		}
+		next = rr.hi + 1
+	}
+	if next <= unicode.MaxRune {
+		ranges = append(ranges, runeRange{next, unicode.MaxRune})
+	}
+	return newRuneSet(ranges)
+}
+
+// Difference returns a new RuneSet of the runes in s which are not in other
+//
+// Difference was added by go-corelibs
+func (s *RuneSet) Difference(other *RuneSet) *RuneSet {
+	return s.Intersect(other.Negate())
+}
+
+// RangeTable returns a new unicode.RangeTable of the runes in the set
+//
+// RangeTable was added by go-corelibs
+func (s *RuneSet) RangeTable() *unicode.RangeTable {
+	table := &unicode.RangeTable{}
+	for _, rr := range s.rangesOf() {
+		if rr.lo <= 0xffff {
+			table.R16 = append(table.R16, unicode.Range16{Lo: uint16(rr.lo), Hi: uint16(min(rr.hi, 0xffff)), Stride: 1})
+			if rr.hi <= unicode.MaxLatin1 {
+				table.LatinOffset++
+			}
+		}
+		if rr.hi > 0xffff {
+			table.R32 = append(table.R32, unicode.Range32{Lo: uint32(max(rr.lo, 0x10000)), Hi: uint32(rr.hi), Stride: 1})
+		}
+	}
+	return table
+}
+
+// String returns the set as a character class, such as [0-9A-Fa-f]
+//
+// String was added by go-corelibs
+func (s *RuneSet) String() string {
+	var buf strings.Builder
+	buf.WriteByte('[')
+	for _, rr := range s.rangesOf() {
+		writeClassRune(&buf, rr.lo)
+		if rr.hi > rr.lo {
+			if rr.hi > rr.lo+1 {
+				buf.WriteByte('-')
+			}
+			writeClassRune(&buf, rr.hi)
+		}
+	}
+	buf.WriteByte(']')
+	return buf.String()
+}
+
+// writeClassRune writes ch to buf, escaped for use in a character class
+func writeClassRune(buf *strings.Builder, ch rune) {
+	switch {
+	case strings.ContainsRune(`\[]^-&`, ch):
+		buf.WriteByte('\\')
+		buf.WriteRune(ch)
+	case ch > ' ' && ch < unicode.MaxASCII:
+		buf.WriteRune(ch)
+	default:
+		buf.WriteString(`\x{` + strconv.FormatInt(int64(ch), 16) + `}`)
+	}
+}
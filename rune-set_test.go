@@ -0,0 +1,213 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes_test
+
+import (
+	"io"
+	"testing"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+
+	. "github.com/go-corelibs/runes"
+)
+
+func checkRuneSet(t *testing.T, name string, s *RuneSet, in, out string) {
+	t.Helper()
+	for _, ch := range in {
+		if !s.Contains(ch) {
+			t.Errorf("%s: %+q not in %v", name, ch, s)
+		}
+	}
+	for _, ch := range out {
+		if s.Contains(ch) {
+			t.Errorf("%s: %+q in %v", name, ch, s)
+		}
+	}
+}
+
+func TestRuneSet(t *testing.T) {
+	hex := NewRuneSetRange('0', '9').Union(NewRuneSetRange('a', 'f'), NewRuneSet("ABCDEF"))
+	checkRuneSet(t, "hex", hex, "09afAF", "gG/:@` \u00e9\u0660")
+	if got := hex.String(); got != "[0-9A-Fa-f]" || hex.Len() != 22 {
+		t.Errorf("hex: got %s %d", got, hex.Len())
+	}
+
+	greek, err := NewRuneSetProperty("Greek")
+	if err != nil {
+		t.Fatal(err)
+	}
+	letters := NewRuneSetTable(unicode.L)
+	checkRuneSet(t, "greek", greek, "\u03b1\u03a9\u1f00", "a\u0430\u00b5")
+	checkRuneSet(t, "latin letters", letters.Difference(greek).Intersect(NewRuneSetRange(0, 0x24f)), "aZ\u00e9\u00b5\u01c5", "1 \u03b1\u0430")
+	checkRuneSet(t, "not letters", letters.Negate(), "1 -\U0010ffff", "a\u03b1\U00020000")
+	if !NewRuneSet().IsEmpty() || NewRuneSet().Negate().Len() != unicode.MaxRune+1 {
+		t.Errorf("empty negation")
+	}
+	if !letters.Negate().Negate().Intersect(letters).Difference(letters).IsEmpty() {
+		t.Errorf("double negation")
+	}
+
+	// a nil RuneSet is empty
+	var none *RuneSet
+	if !none.IsEmpty() || none.Len() != 0 || none.String() != "[]" || len(none.RangeTable().R16) != 0 {
+		t.Errorf("nil: got %v %d", none, none.Len())
+	}
+	checkRuneSet(t, "nil union", none.Union(nil, hex, nil), "09afAF", "gG")
+	checkRuneSet(t, "nil negation", none.Negate(), "a\u03b1\U0010ffff", "")
+	if none.Negate().Len() != unicode.MaxRune+1 || !none.Union().IsEmpty() {
+		t.Errorf("nil negation and union")
+	}
+	if !none.Intersect(hex).IsEmpty() || !hex.Intersect(nil).IsEmpty() || !none.Difference(hex).IsEmpty() {
+		t.Errorf("nil intersection and difference")
+	}
+	if got := hex.Difference(nil); got.String() != hex.String() {
+		t.Errorf("nil difference: got %v", got)
+	}
+
+	// ranges are clamped to the valid runes
+	clamped := NewRuneSetRange(-5, 10).Union(NewRuneSetRange(unicode.MaxRune-1, unicode.MaxRune+10))
+	checkRuneSet(t, "clamped", clamped, "\x00\n\U0010fffe\U0010ffff", "\v")
+	if clamped.Len() != 13 || clamped.Contains(-1) || clamped.Contains(unicode.MaxRune+1) {
+		t.Errorf("clamped: got %v %d", clamped, clamped.Len())
+	}
+	if !NewRuneSetRange(-10, -5).IsEmpty() || !NewRuneSetRange(unicode.MaxRune+1, unicode.MaxRune+5).IsEmpty() {
+		t.Errorf("out of range sets are not empty")
+	}
+
+	for _, name := range []string{"Lu", "L", "Han", "White_Space", "Any", "ASCII"} {
+		if s, err := NewRuneSetProperty(name); err != nil || s.IsEmpty() {
+			t.Errorf("NewRuneSetProperty(%q): got %v,%v", name, s, err)
+		}
+	}
+	if _, err = NewRuneSetProperty("Klingon"); err == nil || err.Error() != `NewRuneSetProperty: unknown property "Klingon"` {
+		t.Errorf("NewRuneSetProperty(Klingon): got %v", err)
+	}
+}
+
+func TestRuneSetRangeTable(t *testing.T) {
+	s := NewRuneSetTable(unicode.Greek, unicode.Nd).Union(NewRuneSetRange(0xfff0, 0x10010))
+	table := s.RangeTable()
+	for ch := rune(0); ch <= 0x20000; ch++ {
+		if unicode.Is(table, ch) != s.Contains(ch) {
+			t.Fatalf("%U: table %v; set %v", ch, unicode.Is(table, ch), s.Contains(ch))
+		}
+	}
+	if table.LatinOffset != 1 || table.R32[0].Lo != 0x10000 {
+		t.Errorf("got LatinOffset %d, R32 %+v", table.LatinOffset, table.R32[0])
+	}
+	// strided tables are expanded
+	checkRuneSet(t, "strided", NewRuneSetTable(unicode.Lu), "AZ\u0100\u0102", "az\u0101\u0103")
+}
+
+//gocyclo:ignore
+func TestRuneSetReader(t *testing.T) {
+	const text = "ab \u4e16 = 1;"
+	digits := NewRuneSetTable(unicode.Nd)
+	nfc, err := NewNormReader(NewStringReader(text), norm.NFC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	readers := append(newCaseReaders(text), NewLineEndingReader(NewStringReader(text), 0), nfc)
+	for _, rr := range readers {
+		r, ok := rr.(RuneSetReader)
+		if !ok {
+			t.Errorf("%T: does not implement RuneSetReader", rr)
+			continue
+		}
+		want := int64(nativeSize(rr, "ab \u4e16 = "))
+		if found, err := r.IndexAny(0, digits); found != want || err != nil {
+			t.Errorf("%T IndexAny: got %d,%v; want %d", rr, found, err, want)
+		}
+		if ch, _, _ := rr.ReadRune(); ch != '1' {
+			t.Errorf("%T IndexAny: next rune %q", rr, ch)
+		}
+		if found, err := r.IndexAny(want+1, digits); found != -1 || err != nil {
+			t.Errorf("%T IndexAny none: got %d,%v", rr, found, err)
+		}
+		if found, err := r.IndexAny(0, nil); found != -1 || err != nil {
+			t.Errorf("%T IndexAny nil: got %d,%v", rr, found, err)
+		}
+		if _, err := r.IndexAny(-1, digits); err == nil {
+			t.Errorf("%T IndexAny(-1): expected error", rr)
+		}
+		if _, err := r.IndexAny(rr.Size(), digits); err != io.EOF {
+			t.Errorf("%T IndexAny(size): got %v; want io.EOF", rr, err)
+		}
+
+		at := int64(nativeSize(rr, "ab "))
+		if ok, size, err := r.Accept(at, NewRuneSetTable(unicode.Han)); !ok || size != nativeSize(rr, "\u4e16") || err != nil {
+			t.Errorf("%T Accept: got %v,%d,%v", rr, ok, size, err)
+		}
+		if ch, _, _ := rr.ReadRune(); ch != ' ' {
+			t.Errorf("%T Accept: next rune %q", rr, ch)
+		}
+		if ok, size, err := r.Accept(at, digits); ok || size != 0 || err != nil {
+			t.Errorf("%T Accept not in set: got %v,%d,%v", rr, ok, size, err)
+		}
+		if ch, _, _ := rr.ReadRune(); ch != '\u4e16' {
+			t.Errorf("%T Accept not in set: next rune %q", rr, ch)
+		}
+		if ok, _, err := r.Accept(rr.Size(), digits); ok || err != io.EOF {
+			t.Errorf("%T Accept(size): got %v,%v; want io.EOF", rr, ok, err)
+		}
+	}
+}
+
+func TestParseRuneSet(t *testing.T) {
+	for _, tc := range []struct {
+		pattern string
+		in, out string
+	}{
+		{`[a-z\p{Greek}&&[^x]]`, "aw\u03b1", "xA\u0430"},
+		{`[^a-c]`, "dA\u00e9", "abc"},
+		{`[-a]`, "-a", "b"},
+		{`[a-]`, "-a", "b"},
+		{`[\d\s_]`, "09 \t_", "a-\u0660"},
+		{`[\W]`, "-\u00e9 ", "a_0"},
+		{`[\pL&&\p{^Latin}]`, "\u03b1\u0430", "a1"},
+		{`[\P{L}]`, "1 ", "a\u03b1"},
+		{`[\x41-\x{43}\u00e9\U0001F600]`, "ABC\u00e9\U0001f600", "D\u00e8"},
+		{`[\]\[\\\-\^\&&]`, `][\-^&`, "a"},
+		{`[\n\t\x{263a}]`, "\n\t\u263a", " "},
+		{`[a-z&&b-y&&[^m]]`, "bly", "amz"},
+		{`[[a-c][x-z]]`, "acxz", "dw"},
+		{`[]`, "", "a]"},
+		{`[^]`, "a]\U0010ffff", ""},
+		{`\p{Greek}`, "\u03b1", "a"},
+		{`\x41`, "A", "B"},
+	} {
+		s, err := ParseRuneSet(tc.pattern)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.pattern, err)
+			continue
+		}
+		checkRuneSet(t, tc.pattern, s, tc.in, tc.out)
+	}
+
+	for _, tc := range []struct {
+		pattern string
+		err     string
+	}{
+		{`a`, `ParseRuneSet: expected [ or \ at 0`},
+		{`[a-z`, "ParseRuneSet: unterminated class at 0"},
+		{`[a[b]`, "ParseRuneSet: unterminated class at 0"},
+		{`[z-a]`, "ParseRuneSet: invalid range at 1"},
+		{`[a-\d]`, "ParseRuneSet: invalid range at 3"},
+		{`[a-[b]]`, "ParseRuneSet: invalid range at 3"},
+		{`[\p{Klingon}]`, `ParseRuneSet: unknown property "Klingon" at 1`},
+		{`[\p{Greek]`, "ParseRuneSet: unterminated property at 1"},
+		{`[\q]`, "ParseRuneSet: unknown escape at 1"},
+		{`[\x{zz}]`, "ParseRuneSet: invalid escape at 1"},
+		{`[\x{110000}]`, "ParseRuneSet: invalid escape at 1"},
+		{`[\u12]`, "ParseRuneSet: invalid escape at 1"},
+		{`[a]b`, "ParseRuneSet: unexpected text after class at 3"},
+		{`\`, "ParseRuneSet: trailing backslash at 0"},
+	} {
+		if _, err := ParseRuneSet(tc.pattern); err == nil || err.Error() != tc.err {
+			t.Errorf("%s: got %v; want %s", tc.pattern, err, tc.err)
+		}
+	}
+}
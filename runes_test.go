@@ -118,3 +118,29 @@ func BenchmarkRunesReaderReadSliceRuneFrom(b *testing.B) {
 		_, _, _ = r.ReadRuneSlice(testIndex[i], 10)
 	}
 }
+
+// Property Runs
+
+// testScripts is mixed script test data for the property runs
+var testScripts = "Latin \u0395\u03bb\u03bb\u03b7\u03bd\u03b9\u03ba\u03ac \u0420\u0443\u0441\u0441\u043a\u0438\u0439 \u65e5\u672c\u8a9e\u306e\u30c6\u30ad\u30b9\u30c8 \ud55c\uad6d\uc5b4 \u0627\u0644\u0639\u0631\u0628\u064a\u0629 \u0939\u093f\u0928\u094d\u0926\u0940 "
+
+func BenchmarkScriptRuns(b *testing.B) {
+	r := NewStringReader(testScripts)
+	for i := 0; i < b.N; i++ {
+		_, _ = ScriptRuns(r)
+	}
+}
+
+func BenchmarkCategoryRuns(b *testing.B) {
+	r := NewStringReader(testScripts)
+	for i := 0; i < b.N; i++ {
+		_, _ = CategoryRuns(r)
+	}
+}
+
+func BenchmarkFindMixedScripts(b *testing.B) {
+	r := NewStringReader(testScripts)
+	for i := 0; i < b.N; i++ {
+		_, _ = FindMixedScripts(r)
+	}
+}
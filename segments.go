@@ -0,0 +1,266 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"errors"
+	"io"
+	"unicode/utf8"
+
+	"github.com/clipperhouse/uax29/v2/sentences"
+	"github.com/clipperhouse/uax29/v2/words"
+)
+
+// segmentKind selects the UAX #29 boundaries to find
+type segmentKind uint8
+
+const (
+	wordSegments segmentKind = iota
+	sentenceSegments
+)
+
+// boundaryIterator is implemented by the words and sentences iterators
+type boundaryIterator interface {
+	Next() bool
+	End() int
+}
+
+// isParaSep returns true if there is always a word and sentence boundary
+// after ch, with the exception of a carriage return followed by a line feed
+func isParaSep(ch rune) bool {
+	switch ch {
+	case '\n', '\r', '\u0085', '\u2028', '\u2029':
+		return true
+	}
+	return false
+}
+
+// segmentStart returns the start of the paragraph containing the index
+// given. Segmentation can begin there without needing any earlier context
+func segmentStart(r RuneReader, index int64) (start int64, err error) {
+	for start = index; start > 0; {
+		var ch rune
+		var width int
+		if ch, width, err = r.ReadPrevRuneFrom(start); err != nil {
+			return -1, err
+		}
+		if isParaSep(ch) {
+			if ch != '\r' {
+				return start, nil
+			} else if next, _, ee := r.ReadRuneAt(start); ee != nil || next != '\n' {
+				return start, nil
+			}
+		}
+		start -= int64(width)
+	}
+	return 0, nil
+}
+
+// segmentEnd returns the end of the paragraph starting at the index given,
+// including its separator
+func segmentEnd(r RuneReader, start int64) (end int64, err error) {
+	size := r.Size()
+	for end = start; end < size; {
+		var ch rune
+		var width int
+		if ch, width, err = r.ReadRuneAt(end); err != nil {
+			return -1, err
+		}
+		end += int64(width)
+		if isParaSep(ch) {
+			if ch == '\r' && end < size {
+				if next, w, ee := r.ReadRuneAt(end); ee == nil && next == '\n' {
+					end += int64(w)
+				}
+			}
+			return end, nil
+		}
+	}
+	return size, nil
+}
+
+// segmenter finds the boundaries within one paragraph of a RuneReader
+type segmenter struct {
+	r      RuneReader
+	kind   segmentKind
+	runes  bool
+	text   string // the text of the paragraph
+	base   int64  // native index of the paragraph
+	it     boundaryIterator
+	offset int   // byte offset of the last boundary returned
+	native int64 // native index of the last boundary returned
+}
+
+func newSegmenter(r RuneReader, kind segmentKind) *segmenter {
+	s := &segmenter{r: r, kind: kind}
+	if ri, ok := r.(runeIndexer); ok {
+		s.runes = ri.runeIndexed()
+	}
+	return s
+}
+
+// load prepares the segmenter to find the boundaries of the paragraph
+// starting at the index given
+func (s *segmenter) load(start int64) (err error) {
+	var end int64
+	if end, err = segmentEnd(s.r, start); err != nil {
+		return
+	}
+	s.text = ""
+	if end > start {
+		if s.text, err = s.r.ReadString(start, end-start); err != nil {
+			return
+		}
+	}
+	if s.kind == wordSegments {
+		s.it = words.FromString(s.text)
+	} else {
+		s.it = sentences.FromString(s.text)
+	}
+	s.base, s.offset, s.native = start, 0, start
+	return
+}
+
+// next returns the native index of the next boundary within the paragraph,
+// not including the start of the paragraph
+func (s *segmenter) next() (boundary int64, ok bool) {
+	if !s.it.Next() {
+		return -1, false
+	}
+	end := s.it.End()
+	if s.runes {
+		s.native += int64(utf8.RuneCountInString(s.text[s.offset:end]))
+	} else {
+		s.native = s.base + int64(end)
+	}
+	s.offset = end
+	return s.native, true
+}
+
+// nextBoundary returns the first boundary after the index given
+func nextBoundary(r RuneReader, name string, index int64, kind segmentKind) (next int64, err error) {
+	size := r.Size()
+	if index < 0 {
+		return -1, errors.New(name + ": negative position")
+	} else if index >= size {
+		return size, io.EOF
+	}
+	var start int64
+	if start, err = segmentStart(r, index); err != nil {
+		return -1, err
+	}
+	s := newSegmenter(r, kind)
+	if err = s.load(start); err != nil {
+		return -1, err
+	}
+	for {
+		if boundary, ok := s.next(); !ok {
+			return size, nil
+		} else if boundary > index {
+			return boundary, nil
+		}
+	}
+}
+
+// prevBoundary returns the last boundary before the index given
+func prevBoundary(r RuneReader, name string, index int64, kind segmentKind) (prev int64, err error) {
+	size := r.Size()
+	if index < 0 {
+		return -1, errors.New(name + ": negative position")
+	} else if index == 0 {
+		return 0, io.EOF
+	} else if index > size {
+		index = size
+	}
+	var width int
+	if _, width, err = r.ReadPrevRuneFrom(index); err != nil {
+		return -1, err
+	}
+	var start int64
+	if start, err = segmentStart(r, index-int64(width)); err != nil {
+		return -1, err
+	}
+	s := newSegmenter(r, kind)
+	if err = s.load(start); err != nil {
+		return -1, err
+	}
+	for prev = start; ; {
+		if boundary, ok := s.next(); !ok || boundary >= index {
+			return prev, nil
+		} else {
+			prev = boundary
+		}
+	}
+}
+
+// Segments is an iterator over the UAX #29 words or sentences of a
+// RuneReader, created by the Words and Sentences methods of the readers.
+// Every rune belongs to exactly one segment, so the words include the runs
+// of spaces and punctuation between them
+//
+// The reader is used to read each paragraph of text as it is reached and
+// its position is unspecified while iterating
+type Segments struct {
+	seg   *segmenter
+	size  int64
+	start int64
+	end   int64
+	text  string
+	err   error
+}
+
+func newSegments(r RuneReader, kind segmentKind) *Segments {
+	return &Segments{seg: newSegmenter(r, kind), size: r.Size()}
+}
+
+// Next advances to the next segment, returning false when there are no more
+// segments or an error occurred
+//
+// Next was added by go-corelibs
+func (s *Segments) Next() bool {
+	if s.err != nil || s.end >= s.size {
+		return false
+	}
+	if s.seg.it == nil {
+		if s.err = s.seg.load(s.end); s.err != nil {
+			return false
+		}
+	}
+	offset := s.seg.offset
+	boundary, ok := s.seg.next()
+	if !ok {
+		// the end of the paragraph, continue with the next one
+		if s.err = s.seg.load(s.end); s.err != nil {
+			return false
+		}
+		offset = 0
+		if boundary, ok = s.seg.next(); !ok {
+			return false
+		}
+	}
+	s.text = s.seg.text[offset:s.seg.offset]
+	s.start, s.end = s.end, boundary
+	return true
+}
+
+// Start returns the index of the current segment
+//
+// Start was added by go-corelibs
+func (s *Segments) Start() int64 { return s.start }
+
+// End returns the index following the current segment
+//
+// End was added by go-corelibs
+func (s *Segments) End() int64 { return s.end }
+
+// Text returns the text of the current segment
+//
+// Text was added by go-corelibs
+func (s *Segments) Text() string { return s.text }
+
+// Err returns the first error encountered while iterating
+//
+// Err was added by go-corelibs
+func (s *Segments) Err() error { return s.err }
@@ -0,0 +1,225 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes_test
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	. "github.com/go-corelibs/runes"
+)
+
+// segmentReader is implemented by all the readers supporting segmentation
+type segmentReader interface {
+	RuneReader
+	NextWordBoundary(index int64) (next int64, err error)
+	PrevWordBoundary(index int64) (prev int64, err error)
+	NextSentenceBoundary(index int64) (next int64, err error)
+	PrevSentenceBoundary(index int64) (prev int64, err error)
+	Words() *Segments
+	Sentences() *Segments
+}
+
+// parseBreakTest parses a line of the UAX #29 break test data, returning the
+// text and the byte offsets of the boundaries, or false for blank lines
+func parseBreakTest(t *testing.T, line string) (text string, breaks []int, ok bool) {
+	if i := strings.IndexByte(line, '#'); i >= 0 {
+		line = line[:i]
+	}
+	var buf strings.Builder
+	for _, field := range strings.Fields(line) {
+		switch field {
+		case "÷":
+			breaks = append(breaks, buf.Len())
+		case "×":
+		default:
+			cp, err := strconv.ParseUint(field, 16, 32)
+			if err != nil {
+				t.Fatalf("invalid code point %q: %v", field, err)
+			}
+			buf.WriteRune(rune(cp))
+		}
+	}
+	return buf.String(), breaks, len(breaks) > 0
+}
+
+// segmentKinds pairs up the methods for each kind of segment
+type segmentKind struct {
+	name string
+	next func(r segmentReader, index int64) (int64, error)
+	prev func(r segmentReader, index int64) (int64, error)
+	iter func(r segmentReader) *Segments
+}
+
+var (
+	wordKind = segmentKind{
+		name: "Word",
+		next: segmentReader.NextWordBoundary,
+		prev: segmentReader.PrevWordBoundary,
+		iter: segmentReader.Words,
+	}
+	sentenceKind = segmentKind{
+		name: "Sentence",
+		next: segmentReader.NextSentenceBoundary,
+		prev: segmentReader.PrevSentenceBoundary,
+		iter: segmentReader.Sentences,
+	}
+)
+
+func newSegmentReaders(text string) []segmentReader {
+	return []segmentReader{
+		NewBytesReader([]byte(text)),
+		NewStringReader(text),
+		NewRunesReader([]rune(text)),
+	}
+}
+
+// checkSegments checks every way of finding the boundaries given, which are
+// byte offsets into text
+//
+//gocyclo:ignore
+func checkSegments(t *testing.T, line int, kind segmentKind, text string, breaks []int) {
+	t.Helper()
+	for _, r := range newSegmentReaders(text) {
+		want := make([]int64, len(breaks))
+		for i, b := range breaks {
+			want[i] = int64(b)
+			if _, ok := r.(*Reader); ok {
+				want[i] = int64(utf8.RuneCountInString(text[:b]))
+			}
+		}
+
+		got := []int64{0}
+		var joined strings.Builder
+		it := kind.iter(r)
+		for it.Next() {
+			if it.Start() != got[len(got)-1] {
+				t.Errorf("line %d: %T %ss start %d; want %d", line, r, kind.name, it.Start(), got[len(got)-1])
+			}
+			got = append(got, it.End())
+			joined.WriteString(it.Text())
+		}
+		if it.Err() != nil || !reflect.DeepEqual(got, want) || joined.String() != text {
+			t.Errorf("line %d: %T %ss(%+q) got %v,%v; want %v", line, r, kind.name, text, got, it.Err(), want)
+			continue
+		}
+
+		// every index between two boundaries has the same next and prev
+		for k := 1; k < len(want); k++ {
+			for index := want[k-1]; index < want[k]; index++ {
+				if _, ok := r.(*Reader); !ok && !utf8.RuneStart(text[index]) {
+					continue
+				}
+				if next, err := kind.next(r, index); next != want[k] || err != nil {
+					t.Errorf("line %d: %T Next%sBoundary(%+q, %d) got %d,%v; want %d", line, r, kind.name, text, index, next, err, want[k])
+				}
+				if index == 0 {
+					continue
+				}
+				wantPrev := want[k-1]
+				if index == want[k-1] {
+					wantPrev = want[k-2]
+				}
+				if prev, err := kind.prev(r, index); prev != wantPrev || err != nil {
+					t.Errorf("line %d: %T Prev%sBoundary(%+q, %d) got %d,%v; want %d", line, r, kind.name, text, index, prev, err, wantPrev)
+				}
+			}
+		}
+		size := r.Size()
+		if prev, err := kind.prev(r, size); size > 0 && (prev != want[len(want)-2] || err != nil) {
+			t.Errorf("line %d: %T Prev%sBoundary(%+q, %d) got %d,%v; want %d", line, r, kind.name, text, size, prev, err, want[len(want)-2])
+		}
+	}
+}
+
+func checkBreakTest(t *testing.T, path string, kind segmentKind) {
+	fh, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fh.Close()
+
+	var count int
+	s := bufio.NewScanner(fh)
+	for line := 1; s.Scan(); line++ {
+		if text, breaks, ok := parseBreakTest(t, s.Text()); ok {
+			count++
+			checkSegments(t, line, kind, text, breaks)
+		}
+	}
+	if err = s.Err(); err != nil {
+		t.Fatal(err)
+	} else if count < 500 {
+		t.Errorf("only %d tests found in %s", count, path)
+	}
+}
+
+func TestWordBreakConformance(t *testing.T) {
+	checkBreakTest(t, "testdata/WordBreakTest.txt", wordKind)
+}
+
+func TestSentenceBreakConformance(t *testing.T) {
+	checkBreakTest(t, "testdata/SentenceBreakTest.txt", sentenceKind)
+}
+
+func TestSegments_Paragraphs(t *testing.T) {
+	const text = "Hello, world. Mr. Smith's\r\n\r\nSecond \u00e9t\u00e9 line.\u2029Done"
+	var words []string
+	it := NewStringReader(text).Words()
+	for it.Next() {
+		words = append(words, it.Text())
+	}
+	wantWords := []string{
+		"Hello", ",", " ", "world", ".", " ", "Mr", ".", " ", "Smith's", "\r\n", "\r\n",
+		"Second", " ", "\u00e9t\u00e9", " ", "line", ".", "\u2029", "Done",
+	}
+	if !reflect.DeepEqual(words, wantWords) {
+		t.Errorf("Words got %+q; want %+q", words, wantWords)
+	}
+
+	var sentences []string
+	it = NewRunesReader([]rune(text)).Sentences()
+	for it.Next() {
+		sentences = append(sentences, it.Text())
+	}
+	wantSentences := []string{"Hello, world. ", "Mr. ", "Smith's\r\n", "\r\n", "Second \u00e9t\u00e9 line.\u2029", "Done"}
+	if !reflect.DeepEqual(sentences, wantSentences) {
+		t.Errorf("Sentences got %+q; want %+q", sentences, wantSentences)
+	}
+
+	// a boundary inside the crlf is never reported
+	r := NewStringReader(text)
+	if next, err := r.NextWordBoundary(25); next != 27 || err != nil {
+		t.Errorf("NextWordBoundary(25) got %d,%v; want 27", next, err)
+	}
+	if prev, err := r.PrevWordBoundary(29); prev != 27 || err != nil {
+		t.Errorf("PrevWordBoundary(29) got %d,%v; want 27", prev, err)
+	}
+}
+
+func TestSegments_Errors(t *testing.T) {
+	r := NewStringReader("one two")
+	if _, err := r.NextWordBoundary(-1); err == nil || err.Error() != "StringReader.NextWordBoundary: negative position" {
+		t.Errorf("NextWordBoundary(-1) got %v", err)
+	}
+	if _, err := r.PrevSentenceBoundary(-1); err == nil || err.Error() != "StringReader.PrevSentenceBoundary: negative position" {
+		t.Errorf("PrevSentenceBoundary(-1) got %v", err)
+	}
+	if next, err := r.NextWordBoundary(7); next != 7 || err != io.EOF {
+		t.Errorf("NextWordBoundary(7) got %d,%v; want 7,io.EOF", next, err)
+	}
+	if prev, err := r.PrevWordBoundary(0); prev != 0 || err != io.EOF {
+		t.Errorf("PrevWordBoundary(0) got %d,%v; want 0,io.EOF", prev, err)
+	}
+	if it := NewBytesReader(nil).Words(); it.Next() || it.Err() != nil {
+		t.Errorf("Words of empty text got %+q,%v", it.Text(), it.Err())
+	}
+}
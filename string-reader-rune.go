@@ -5,182 +5,22 @@
 package runes
 
 import (
-	"errors"
 	"io"
-	"unicode/utf8"
 )
 
-// ReadRuneAt is a convenience method combining Seek and ReadRune into one
-// operation. The index argument is always relative to the start of the
-// slice, equivalent to Seek(index, io.SeekStart)
+// Substring returns the portion of the underlying string starting at the
+// byte index given and spanning up to count bytes, without copying. Unlike
+// ReadString, Substring does not modify the state of the reader and is safe
+// for concurrent use with other calls to Substring and ReadAt
 //
-// ReadRuneAt was added by go-corelibs
-func (r *StringReader) ReadRuneAt(index int64) (ch rune, size int, err error) {
-	r.prevRune = -1
+// Substring was added by go-corelibs
+func (r *StringReader) Substring(index, count int64) (slice string, err error) {
 	if index < 0 {
-		return 0, 0, errors.New("StringReader.ReadRuneAt: negative position")
-	} else if index >= int64(len(r.s)) {
-		return 0, 0, io.EOF
-	}
-	r.i = index
-	r.prevRune = int(r.i)
-	if c := r.s[r.i]; c < utf8.RuneSelf {
-		r.i++
-		return rune(c), 1, nil
-	}
-	ch, size = utf8.DecodeRuneInString(r.s[r.i:])
-	r.i += int64(size)
-	return
-}
-
-// ReadPrevRuneFrom is a convenience method combining Seek and ReadRune into one
-// operation. The index argument is always relative to the start of the
-// slice, equivalent to Seek(index, io.SeekStart)
-//
-// ReadPrevRuneFrom was added by go-corelibs
-func (r *StringReader) ReadPrevRuneFrom(index int64) (ch rune, size int, err error) {
-	r.prevRune = -1
-	if index <= 0 {
-		return 0, 0, errors.New("StringReader.ReadPrevRuneFrom: zero or negative position")
-	} else if index >= int64(len(r.s)) {
-		return 0, 0, io.EOF
-	}
-	if r.s[index-1] < utf8.RuneSelf {
-		r.i -= 1
-		return rune(r.s[index-1]), 1, nil
-	}
-	ch, size = utf8.DecodeLastRuneInString(r.s[:index])
-	r.i -= int64(size)
-	return
-}
-
-// ReadNextRuneFrom is a convenience method combining Seek and ReadRune into one
-// operation. The index argument is always relative to the start of the
-// slice, equivalent to Seek(index, io.SeekStart)
-//
-// ReadNextRuneFrom was added by go-corelibs
-func (r *StringReader) ReadNextRuneFrom(index int64) (ch rune, size int, err error) {
-	r.prevRune = -1
-	if index < 0 {
-		return 0, 0, errors.New("StringReader.ReadNextRuneFrom: negative position")
-	} else if index >= int64(len(r.s)) {
-		return 0, 0, io.EOF
-	}
-	r.i = index
-	r.prevRune = int(r.i)
-	// move this forward once
-	if c := r.s[r.i]; c < utf8.RuneSelf {
-		r.i++ // got this one
-	} else {
-		_, sz := utf8.DecodeRuneInString(r.s[r.i:])
-		r.i += int64(sz)
-	}
-	// find next one
-	if next := r.s[r.i]; next < utf8.RuneSelf {
-		// returning the next one
-		return rune(next), 1, nil
-	}
-	ch, size = utf8.DecodeRuneInString(r.s[r.i:])
-	r.i += int64(size)
-	return
-}
-
-// ReadRuneSlice is a convenience method combining Seek and then ReadRune
-// operations accumulating the requested count of runes, starting at the
-// index given. The index argument is always relative to the start of the
-// slice, equivalent to Seek(index, io.SeekStart). The count argument is
-// exclusive, meaning start at the index and stop at index+count, equivalent
-// to the slice index syntax of bytes[index:index+count]
-//
-// ReadRuneSlice was added by go-corelibs
-func (r *StringReader) ReadRuneSlice(index, count int64) (slice []rune, size int, err error) {
-	r.prevRune = -1
-	if index < 0 {
-		return nil, 0, errors.New("StringReader.ReadRuneSlice: negative position")
+		return "", r.newError(".Substring: negative position")
 	} else if count < 1 {
-		return nil, 0, errors.New("StringReader.ReadRuneSlice: zero or negative count")
-	} else if index >= int64(len(r.s)) {
-		return nil, 0, io.EOF
-	}
-	r.i = index
-
-	length := int64(len(r.s))
-
-	slice = make([]rune, count)
-	track := int64(0)
-	for idx := index; idx < length; {
-		if track == count {
-			break
-		}
-		r.prevRune = int(r.i)
-		if c := r.s[r.i]; c < utf8.RuneSelf {
-			slice[track] = rune(c)
-			track += 1
-			size += 1
-			r.i++
-		} else {
-			ch, sz := utf8.DecodeRuneInString(r.s[r.i:])
-			slice[track] = ch
-			track += 1
-			size += sz
-			r.i += int64(sz)
-		}
-	}
-	return
-}
-
-func (r *StringReader) ReadByteSlice(index, count int64) (slice []byte, err error) {
-	r.prevRune = -1
-	if index < 0 {
-		return nil, errors.New("StringReader.ReadByteSlice: negative position")
-	} else if count < 1 {
-		return nil, errors.New("StringReader.ReadByteSlice: zero or negative count")
-	} else if index >= int64(len(r.s)) {
-		return nil, io.EOF
-	}
-	r.i = index
-
-	length := int64(len(r.s))
-
-	slice = make([]byte, count)
-	track := int64(0)
-	for idx := index; idx < length; {
-		if track == count {
-			break
-		}
-		r.prevRune = int(r.i)
-		slice[track] = r.s[r.i]
-		track += 1
-		r.i++
-	}
-	return
-}
-
-func (r *StringReader) ReadString(index, count int64) (slice string, err error) {
-	r.prevRune = -1
-	if index < 0 {
-		return "", errors.New("StringReader.ReadString: negative position")
-	} else if count < 1 {
-		return "", errors.New("StringReader.ReadString: zero or negative count")
-	} else if index >= int64(len(r.s)) {
+		return "", r.newError(".Substring: zero or negative count")
+	} else if index >= r.n {
 		return "", io.EOF
 	}
-	r.i = index
-
-	length := int64(len(r.s))
-
-	buf := spStringBuilder.Get()
-	track := int64(0)
-	for idx := index; idx < length; {
-		if track == count {
-			break
-		}
-		r.prevRune = int(r.i)
-		buf.WriteByte(r.s[r.i])
-		track += 1
-		r.i++
-	}
-	slice = buf.String()
-	spStringBuilder.Put(buf)
-	return
+	return r.str[index:min(index+count, r.n)], nil
 }
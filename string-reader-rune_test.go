@@ -7,6 +7,7 @@ package runes_test
 import (
 	"io"
 	"testing"
+	"unsafe"
 
 	. "github.com/go-corelibs/runes"
 )
@@ -198,3 +199,38 @@ func TestStringReader_ReadString(t *testing.T) {
 	}
 
 }
+
+//gocyclo:ignore
+func TestStringReader_Substring(t *testing.T) {
+	r := &StringReader{}
+
+	if data, err := r.Substring(-1, 0); data != "" || (err == nil || err.Error() != "StringReader.Substring: negative position") {
+		t.Errorf("Substring: got %q, %v; want \"\", error", data, err)
+	}
+
+	if data, err := r.Substring(0, 0); data != "" || (err == nil || err.Error() != "StringReader.Substring: zero or negative count") {
+		t.Errorf("Substring: got %q, %v; want \"\", error", data, err)
+	}
+
+	if data, err := r.Substring(0, 1); data != "" || err != io.EOF {
+		t.Errorf("Substring: got %q, %v; want \"\", io.EOF", data, err)
+	}
+
+	const text = "hello, 世界"
+	r.Reset(text)
+	_, _ = r.Seek(3, io.SeekStart)
+
+	if data, err := r.Substring(7, 100); data != "世界" || err != nil {
+		t.Errorf("Substring: got %q, %v; want \"世界\", nil", data, err)
+	} else if unsafe.StringData(data) != unsafe.StringData(text[7:]) {
+		t.Errorf("Substring: returned a copy of the original string")
+	}
+
+	if pos, _ := r.Seek(0, io.SeekCurrent); pos != 3 {
+		t.Errorf("Substring: moved the reader to %d; want 3", pos)
+	}
+
+	if allocs := testing.AllocsPerRun(100, func() { _, _ = r.Substring(0, 5) }); allocs != 0 {
+		t.Errorf("Substring: got %v allocations; want 0", allocs)
+	}
+}
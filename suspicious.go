@@ -0,0 +1,310 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SuspiciousKind is a bitmask of the kinds of suspicious runes found by
+// FindSuspicious, which may be used to hide or disguise text such as the
+// Trojan Source attacks on source code (CVE-2021-42574)
+type SuspiciousKind uint8
+
+const (
+	// UnterminatedBidi is a bidirectional embedding, override or isolate
+	// which is not terminated before the end of its line
+	UnterminatedBidi SuspiciousKind = 1 << iota
+	// BidiControl is any other bidirectional formatting character: the
+	// terminated embeddings, overrides and isolates, their terminators and
+	// the implicit directional marks
+	BidiControl
+	// ZeroWidth is an invisible character, such as the zero width space and
+	// joiners, the word joiner, a byte order mark which is not at the start
+	// of the text, the invisible mathematical operators and the Hangul
+	// fillers. The zero width joiners of emoji ZWJ sequences are not
+	// suspicious
+	ZeroWidth
+	// TagCharacter is one of the tag characters, U+E0000 to U+E007F, which
+	// are invisible outside of emoji tag sequences. The tag characters of
+	// emoji tag sequences are not suspicious
+	TagCharacter
+	// UnusualWhitespace is whitespace other than the space, tab, line feed
+	// and carriage return, such as the non-breaking and ideographic spaces
+	UnusualWhitespace
+
+	// AllSuspicious is every SuspiciousKind
+	AllSuspicious = UnterminatedBidi | BidiControl | ZeroWidth | TagCharacter | UnusualWhitespace
+)
+
+// String returns the name of the SuspiciousKind, or the names of the kinds
+// joined with a vertical bar
+func (k SuspiciousKind) String() string {
+	var names []string
+	for _, kind := range []struct {
+		kind SuspiciousKind
+		name string
+	}{
+		{UnterminatedBidi, "UnterminatedBidi"},
+		{BidiControl, "BidiControl"},
+		{ZeroWidth, "ZeroWidth"},
+		{TagCharacter, "TagCharacter"},
+		{UnusualWhitespace, "UnusualWhitespace"},
+	} {
+		if k&kind.kind != 0 {
+			names = append(names, kind.name)
+		}
+	}
+	if len(names) == 0 {
+		return "SuspiciousKind(0)"
+	}
+	return strings.Join(names, "|")
+}
+
+// SuspiciousRune is a rune found by FindSuspicious
+type SuspiciousRune struct {
+	Kind SuspiciousKind
+	Rune rune
+	// Index is the index of the rune
+	Index int64
+	// Size is the width of the rune in native units
+	Size int
+}
+
+// bidi formatting characters
+const (
+	bidiLRE = '\u202a'
+	bidiRLE = '\u202b'
+	bidiPDF = '\u202c'
+	bidiLRO = '\u202d'
+	bidiRLO = '\u202e'
+	bidiLRI = '\u2066'
+	bidiRLI = '\u2067'
+	bidiFSI = '\u2068'
+	bidiPDI = '\u2069'
+)
+
+// suspiciousKind returns the kind of the rune at the index given, or zero
+// if it is not suspicious
+func suspiciousKind(ch rune, index int64) SuspiciousKind {
+	switch {
+	case ch == '\u061c', ch == '\u200e', ch == '\u200f',
+		bidiLRE <= ch && ch <= bidiRLO, bidiLRI <= ch && ch <= bidiPDI:
+		return BidiControl
+	case ch == '\ufeff':
+		if index == 0 {
+			return 0
+		}
+		return ZeroWidth
+	case '\u200b' <= ch && ch <= '\u200d', '\u2060' <= ch && ch <= '\u2064',
+		ch == '\u00ad', ch == '\u034f', ch == '\u180e', ch == '\u115f', ch == '\u1160',
+		ch == '\u3164', ch == '\uffa0':
+		return ZeroWidth
+	case '\U000e0000' <= ch && ch <= '\U000e007f':
+		return TagCharacter
+	case ch == ' ', ch == '\t', ch == '\n', ch == '\r':
+		return 0
+	case unicode.IsSpace(ch), unicode.Is(unicode.Zs, ch):
+		return UnusualWhitespace
+	}
+	return 0
+}
+
+// suspiciousScanner tracks the open bidi embeddings and isolates of a line
+type suspiciousScanner struct {
+	found []SuspiciousRune
+	open  []int // index in found of each open embedding or isolate
+}
+
+// add records a suspicious rune, tracking the bidi controls
+func (s *suspiciousScanner) add(sr SuspiciousRune) {
+	s.found = append(s.found, sr)
+	switch sr.Rune {
+	case bidiLRE, bidiRLE, bidiLRO, bidiRLO, bidiLRI, bidiRLI, bidiFSI:
+		s.open = append(s.open, len(s.found)-1)
+	case bidiPDF:
+		// closes the last embedding or override, unless an isolate is open
+		if n := len(s.open) - 1; n >= 0 && !isBidiIsolate(s.found[s.open[n]].Rune) {
+			s.open = s.open[:n]
+		}
+	case bidiPDI:
+		// closes the last isolate and any embeddings opened within it
+		for n := len(s.open) - 1; n >= 0; n-- {
+			if isBidiIsolate(s.found[s.open[n]].Rune) {
+				s.open = s.open[:n]
+				break
+			}
+		}
+	}
+}
+
+// endLine marks the embeddings and isolates left open as unterminated
+func (s *suspiciousScanner) endLine() {
+	for _, k := range s.open {
+		s.found[k].Kind = UnterminatedBidi
+	}
+	s.open = s.open[:0]
+}
+
+// isBidiIsolate returns true for the isolate initiators
+func isBidiIsolate(ch rune) bool {
+	return ch == bidiLRI || ch == bidiRLI || ch == bidiFSI
+}
+
+// FindSuspicious returns the suspicious runes of the text of r, in order.
+// Bidirectional controls are terminated at the end of each line, as they
+// are by the Unicode Bidirectional Algorithm at the end of each paragraph.
+// The runes of the emoji sequences recognized by ReadEmojiAt are skipped, so
+// the joiners and tags of the ZWJ and tag sequences are not suspicious
+//
+// FindSuspicious was added by go-corelibs
+func FindSuspicious(r RuneReader) (found []SuspiciousRune, err error) {
+	s := &suspiciousScanner{}
+	size := r.Size()
+	for index := int64(0); index < size; {
+		var ch rune
+		var width int
+		if ch, width, err = r.ReadRuneAt(index); err != nil {
+			return nil, err
+		} else if mayStartEmoji(ch) {
+			var emoji Emoji
+			if emoji, _, err = readEmojiAt(r, "FindSuspicious", index); err != nil {
+				return nil, err
+			} else if emoji.Size > width {
+				index += int64(emoji.Size)
+				continue
+			}
+		}
+		if kind := suspiciousKind(ch, index); kind != 0 {
+			s.add(SuspiciousRune{Kind: kind, Rune: ch, Index: index, Size: width})
+		}
+		if isParaSep(ch) {
+			s.endLine()
+		}
+		index += int64(width)
+	}
+	s.endLine()
+	return s.found, nil
+}
+
+// SanitizeMode selects what a SanitizeReader does with suspicious runes
+type SanitizeMode uint8
+
+const (
+	// SanitizeRemove removes suspicious runes
+	SanitizeRemove SanitizeMode = iota
+	// SanitizeEscape replaces suspicious runes with their code points, as
+	// in <U+202E>
+	SanitizeEscape
+)
+
+// SanitizeReader is a RuneReader presenting the text of another RuneReader
+// with the suspicious runes of the kinds given removed or escaped. All
+// indices are in the native units of the source reader. SourceIndex and
+// SanitizedIndex convert between the sanitized text and the source
+//
+// The sanitized text is produced when the SanitizeReader is created and the
+// source reader is not used after that
+type SanitizeReader struct {
+	textRuneReader
+	runes bool
+	size  int64
+	found []SuspiciousRune
+	edits []escapeEdit
+}
+
+// NewSanitizeReader returns a new SanitizeReader for the text of src,
+// sanitizing the kinds of suspicious runes given in the mode given
+//
+// NewSanitizeReader was added by go-corelibs
+func NewSanitizeReader(src RuneReader, kinds SuspiciousKind, mode SanitizeMode) (r *SanitizeReader, err error) {
+	var found []SuspiciousRune
+	if found, err = FindSuspicious(src); err != nil {
+		return nil, err
+	}
+	var text string
+	r = &SanitizeReader{size: src.Size()}
+	if text, r.runes, err = readText(src); err != nil {
+		return nil, err
+	}
+
+	var buf strings.Builder
+	var offset int
+	var srcIndex, visible int64
+	for _, sr := range found {
+		if sr.Kind&kinds == 0 {
+			continue
+		}
+		// copy the text preceding the suspicious rune
+		kept := sr.Index - srcIndex
+		n := int(kept)
+		if r.runes {
+			n = 0
+			for count := int64(0); count < kept; count++ {
+				_, w := utf8.DecodeRuneInString(text[offset+n:])
+				n += w
+			}
+		}
+		buf.WriteString(text[offset : offset+n])
+		offset += n
+		visible += kept
+
+		edit := escapeEdit{src: sr.Index, width: int64(sr.Size), visible: visible}
+		if mode == SanitizeEscape {
+			escaped := fmt.Sprintf("<U+%04X>", sr.Rune)
+			buf.WriteString(escaped)
+			edit.replaced = int64(len(escaped))
+		}
+		r.edits = append(r.edits, edit)
+		r.found = append(r.found, sr)
+		_, w := utf8.DecodeRuneInString(text[offset:])
+		offset += w
+		srcIndex = sr.Index + int64(sr.Size)
+		visible += edit.replaced
+	}
+	buf.WriteString(text[offset:])
+	r.textRuneReader = textReaderFor(buf.String(), r.runes)
+	return r, nil
+}
+
+// runeIndexed returns true if the native units of the source are runes
+func (r *SanitizeReader) runeIndexed() bool { return r.runes }
+
+// Sanitized returns the suspicious runes which were removed or escaped
+//
+// Sanitized was added by go-corelibs
+func (r *SanitizeReader) Sanitized() []SuspiciousRune {
+	return r.found
+}
+
+// SourceIndex returns the index in the source of the sanitized index given.
+// Indices within an escape map to the suspicious rune it replaced, and the
+// end of the sanitized text maps to the end of the source
+//
+// SourceIndex was added by go-corelibs
+func (r *SanitizeReader) SourceIndex(index int64) (src int64, err error) {
+	if index < 0 || index > r.Size() {
+		return -1, errors.New("SanitizeReader.SourceIndex: index out of range")
+	} else if index == r.Size() {
+		return r.size, nil
+	}
+	return sourceIndexOf(r.edits, index), nil
+}
+
+// SanitizedIndex returns the sanitized index of the source index given. A
+// removed rune maps to the sanitized text following it, and an escaped rune
+// to the start of its escape
+//
+// SanitizedIndex was added by go-corelibs
+func (r *SanitizeReader) SanitizedIndex(src int64) (index int64, err error) {
+	if src < 0 || src > r.size {
+		return -1, errors.New("SanitizeReader.SanitizedIndex: index out of range")
+	}
+	return visibleIndexOf(r.edits, src), nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes_test
+
+import (
+	"io"
+	"testing"
+
+	. "github.com/go-corelibs/runes"
+)
+
+const suspiciousText = "\ufeffa\u202eb\u202c\u2067c\n" +
+	"d\u2066e\u202af\u2069g\u200bh\n" +
+	"i\u00a0j\U000e0041k\u3000"
+
+// suspiciousEmoji is a family ZWJ sequence, a profession ZWJ sequence with a
+// skin tone and the flag of England, a tag sequence
+const suspiciousEmoji = "\U0001F468\u200d\U0001F469\u200d\U0001F467\u200d\U0001F466 " +
+	"\U0001F469\U0001F3FD\u200d\U0001F4BB " +
+	"\U0001F3F4\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F"
+
+func TestFindSuspicious(t *testing.T) {
+	want := []struct {
+		kind SuspiciousKind
+		ch   rune
+	}{
+		{BidiControl, '\u202e'},
+		{BidiControl, '\u202c'},
+		{UnterminatedBidi, '\u2067'},
+		{BidiControl, '\u2066'},
+		{BidiControl, '\u202a'},
+		{BidiControl, '\u2069'},
+		{ZeroWidth, '\u200b'},
+		{UnusualWhitespace, '\u00a0'},
+		{TagCharacter, '\U000e0041'},
+		{UnusualWhitespace, '\u3000'},
+	}
+	for _, src := range newCaseReaders(suspiciousText) {
+		found, err := FindSuspicious(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(found) != len(want) {
+			t.Fatalf("got %d findings; want %d: %+v", len(found), len(want), found)
+		}
+		for i, sr := range found {
+			if sr.Kind != want[i].kind || sr.Rune != want[i].ch {
+				t.Errorf("%d: got %v %U; want %v %U", i, sr.Kind, sr.Rune, want[i].kind, want[i].ch)
+			}
+			if ch, size, err := src.ReadRuneAt(sr.Index); ch != sr.Rune || size != sr.Size || err != nil {
+				t.Errorf("%d: ReadRuneAt(%d) got %U,%d,%v", i, sr.Index, ch, size, err)
+			}
+		}
+	}
+
+	// a dangling embedding is terminated by the end of the text, and a
+	// directional formatting terminator does not close an isolate
+	found, _ := FindSuspicious(NewStringReader("\u2068x\u202c"))
+	if len(found) != 2 || found[0].Kind != UnterminatedBidi || found[1].Kind != BidiControl {
+		t.Errorf("isolate: got %+v", found)
+	}
+	if found, _ = FindSuspicious(NewStringReader("a \tb\r\n")); len(found) != 0 {
+		t.Errorf("plain: got %+v", found)
+	}
+
+	// the joiners and tags of emoji sequences are not suspicious, those
+	// outside of them are
+	found, _ = FindSuspicious(NewStringReader(suspiciousEmoji + "\u200d\U0001F3F4\U000E0078\U000E007F"))
+	if len(found) != 3 || found[0].Rune != '\u200d' || found[1].Rune != '\U000E0078' || found[2].Rune != '\U000E007F' {
+		t.Errorf("emoji: got %+v", found)
+	}
+
+	if got := (UnterminatedBidi | ZeroWidth).String(); got != "UnterminatedBidi|ZeroWidth" {
+		t.Errorf("String: got %q", got)
+	}
+	if got := SuspiciousKind(0).String(); got != "SuspiciousKind(0)" {
+		t.Errorf("String: got %q", got)
+	}
+}
+
+func TestSanitizeReader(t *testing.T) {
+	for _, tc := range []struct {
+		kinds SuspiciousKind
+		mode  SanitizeMode
+		want  string
+	}{
+		{AllSuspicious, SanitizeRemove, "\ufeffabc\ndefgh\nijk"},
+		{UnterminatedBidi | ZeroWidth, SanitizeRemove, "\ufeffa\u202eb\u202cc\n" +
+			"d\u2066e\u202af\u2069gh\ni\u00a0j\U000e0041k\u3000"},
+		{UnterminatedBidi | TagCharacter, SanitizeEscape, "\ufeffa\u202eb\u202c<U+2067>c\n" +
+			"d\u2066e\u202af\u2069g\u200bh\ni\u00a0j<U+E0041>k\u3000"},
+	} {
+		for _, src := range newCaseReaders(suspiciousText) {
+			r, err := NewSanitizeReader(src, tc.kinds, tc.mode)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, _ := io.ReadAll(r); string(got) != tc.want {
+				t.Errorf("%v: got %+q; want %+q", tc.kinds, got, tc.want)
+			}
+		}
+	}
+
+	for _, src := range newCaseReaders(suspiciousEmoji) {
+		r, err := NewSanitizeReader(src, AllSuspicious, SanitizeRemove)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, _ := io.ReadAll(r); string(got) != suspiciousEmoji || len(r.Sanitized()) != 0 {
+			t.Errorf("emoji: got %+q, %+v", got, r.Sanitized())
+		}
+	}
+
+	r, err := NewSanitizeReader(NewStringReader("a\u202eb\u2067c"), AllSuspicious, SanitizeEscape)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := r.Sanitized(); len(got) != 2 || got[0].Index != 1 || got[1].Index != 5 {
+		t.Errorf("Sanitized: got %+v", got)
+	}
+	// a<U+202E>b<U+2067>c
+	for index, want := range map[int64]int64{0: 0, 1: 1, 5: 1, 9: 4, 10: 5, 17: 5, 18: 8, 19: 9} {
+		if got, err := r.SourceIndex(index); got != want || err != nil {
+			t.Errorf("SourceIndex(%d): got %d,%v; want %d", index, got, err, want)
+		}
+	}
+	for src, want := range map[int64]int64{0: 0, 1: 1, 3: 1, 4: 9, 5: 10, 8: 18, 9: 19} {
+		if got, err := r.SanitizedIndex(src); got != want || err != nil {
+			t.Errorf("SanitizedIndex(%d): got %d,%v; want %d", src, got, err, want)
+		}
+	}
+	if _, err = r.SourceIndex(20); err == nil {
+		t.Errorf("SourceIndex(20): expected error")
+	}
+	if _, err = r.SanitizedIndex(-1); err == nil {
+		t.Errorf("SanitizedIndex(-1): expected error")
+	}
+
+	r, err = NewSanitizeReader(NewRunesReader([]rune("a\u202eb\u2067c")), AllSuspicious, SanitizeRemove)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := r.SourceIndex(2); got != 4 {
+		t.Errorf("runes SourceIndex(2): got %d; want 4", got)
+	}
+	if got, _ := r.SanitizedIndex(1); got != 1 {
+		t.Errorf("runes SanitizedIndex(1): got %d; want 1", got)
+	}
+	if got, _ := r.SanitizedIndex(4); got != 2 {
+		t.Errorf("runes SanitizedIndex(4): got %d; want 2", got)
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+// ReadEmojiAt returns the UTS #51 emoji sequence starting at the index given,
+// such as a keycap, flag, skin tone modifier or ZWJ sequence. The Size of the
+// emoji is zero when there is no emoji at the index, and io.EOF is returned
+// when the index is at or past the end
+//
+// ReadEmojiAt was added by go-corelibs
+func (r *textReader[V]) ReadEmojiAt(index int64) (emoji Emoji, err error) {
+	emoji, _, err = readEmojiAt(r, r.name()+".ReadEmojiAt", index)
+	return
+}
+
+// Emojis returns a new iterator over the UTS #51 emoji sequences of the text
+//
+// Emojis was added by go-corelibs
+func (r *textReader[V]) Emojis() *EmojiSpans {
+	return newEmojiSpans(r, r.name()+".Emojis")
+}
@@ -0,0 +1,246 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// ReadRuneAt is a convenience method combining Seek and ReadRune into one
+// operation. The index argument is always relative to the start of the
+// slice, equivalent to Seek(index, io.SeekStart)
+//
+// The size returned is in native units: bytes for BytesReader and
+// StringReader, runes (always 1) for Reader
+//
+// ReadRuneAt was added by go-corelibs
+func (r *textReader[V]) ReadRuneAt(index int64) (ch rune, size int, err error) {
+	r.prevRune = -1
+	if index < 0 {
+		return 0, 0, r.newError(".ReadRuneAt: negative position")
+	} else if index >= r.n {
+		return 0, 0, io.EOF
+	}
+	r.i = index
+	r.prevRune = int(r.i)
+	width := int64(1)
+	if ch = r.unitAt(index); ch >= utf8.RuneSelf {
+		ch, width = r.decodeMultiRune(index, ch)
+	}
+	r.i += width
+	return ch, int(width), nil
+}
+
+// ReadPrevRuneFrom is a convenience method combining Seek and reading the
+// rune which ends at the index given. The index argument is always relative
+// to the start of the slice, equivalent to Seek(index, io.SeekStart). The
+// reader is left positioned at the start of the rune returned
+//
+// ReadPrevRuneFrom was added by go-corelibs
+func (r *textReader[V]) ReadPrevRuneFrom(index int64) (ch rune, size int, err error) {
+	r.prevRune = -1
+	if index <= 0 {
+		return 0, 0, r.newError(".ReadPrevRuneFrom: zero or negative position")
+	} else if index > r.n {
+		return 0, 0, io.EOF
+	}
+	width := int64(1)
+	if ch = r.unitAt(index - 1); ch >= utf8.RuneSelf {
+		ch, width = r.decodeLastMultiRune(index, ch)
+	}
+	r.i = index - width
+	return ch, int(width), nil
+}
+
+// ReadNextRuneFrom is a convenience method combining Seek and reading the
+// rune which follows the one at the index given. The index argument is
+// always relative to the start of the slice, equivalent to
+// Seek(index, io.SeekStart). The reader is left positioned at the start of
+// the rune returned
+//
+// ReadNextRuneFrom was added by go-corelibs
+func (r *textReader[V]) ReadNextRuneFrom(index int64) (ch rune, size int, err error) {
+	r.prevRune = -1
+	if index < 0 {
+		return 0, 0, r.newError(".ReadNextRuneFrom: negative position")
+	} else if index >= r.n {
+		return 0, 0, io.EOF
+	}
+	// step over the rune at index
+	next := index + 1
+	if c := r.unitAt(index); c >= utf8.RuneSelf {
+		_, skip := r.decodeMultiRune(index, c)
+		next = index + skip
+	}
+	if next >= r.n {
+		return 0, 0, io.EOF
+	}
+	width := int64(1)
+	if ch = r.unitAt(next); ch >= utf8.RuneSelf {
+		ch, width = r.decodeMultiRune(next, ch)
+	}
+	r.i = next
+	return ch, int(width), nil
+}
+
+// ReadRuneSlice is a convenience method combining Seek and then ReadRune
+// operations accumulating the requested count of runes, starting at the
+// index given. The index argument is always relative to the start of the
+// slice, equivalent to Seek(index, io.SeekStart). The count argument is
+// exclusive, meaning start at the index and stop at index+count, equivalent
+// to the slice index syntax of bytes[index:index+count]
+//
+// Fewer than count runes are returned when the end of the slice is reached
+// first. The size returned is the number of native units consumed: bytes for
+// BytesReader and StringReader, runes for Reader
+//
+// ReadRuneSlice was added by go-corelibs
+func (r *textReader[V]) ReadRuneSlice(index, count int64) (slice []rune, size int, err error) {
+	if err = r.checkSlice(".ReadRuneSlice", index, count); err != nil {
+		return nil, 0, err
+	}
+	slice, size = r.appendRunes(make([]rune, 0, min(count, r.n-index)), index, count)
+	return
+}
+
+// AppendRuneSlice is like ReadRuneSlice except that the runes are appended to
+// dst and the extended slice is returned. On error, dst is returned unchanged
+//
+// AppendRuneSlice was added by go-corelibs
+func (r *textReader[V]) AppendRuneSlice(dst []rune, index, count int64) (slice []rune, size int, err error) {
+	if err = r.checkSlice(".AppendRuneSlice", index, count); err != nil {
+		return dst, 0, err
+	}
+	slice, size = r.appendRunes(dst, index, count)
+	return
+}
+
+// ReadByteSlice is like ReadRuneSlice except that count is in native units
+// and the UTF-8 encoding of the data is returned
+//
+// ReadByteSlice was added by go-corelibs
+func (r *textReader[V]) ReadByteSlice(index, count int64) (slice []byte, err error) {
+	if err = r.checkSlice(".ReadByteSlice", index, count); err != nil {
+		return nil, err
+	}
+	end := min(index+count, r.n)
+	if len(r.runes) != 0 {
+		// the encoded size is not known without another pass
+		slice = []byte(string(r.runes[index:end]))
+		r.i = end
+		return
+	}
+	slice = r.appendBytes(make([]byte, 0, end-index), index, end)
+	return
+}
+
+// AppendByteSlice is like ReadByteSlice except that the data is appended to
+// dst and the extended slice is returned. On error, dst is returned unchanged
+//
+// AppendByteSlice was added by go-corelibs
+func (r *textReader[V]) AppendByteSlice(dst []byte, index, count int64) (slice []byte, err error) {
+	if err = r.checkSlice(".AppendByteSlice", index, count); err != nil {
+		return dst, err
+	}
+	slice = r.appendBytes(dst, index, min(index+count, r.n))
+	return
+}
+
+// ReadString is like ReadByteSlice except that a string is returned
+//
+// ReadString was added by go-corelibs
+func (r *textReader[V]) ReadString(index, count int64) (slice string, err error) {
+	if err = r.checkSlice(".ReadString", index, count); err != nil {
+		return "", err
+	}
+	end := min(index+count, r.n)
+	switch {
+	case len(r.runes) != 0:
+		slice = string(r.runes[index:end])
+	case len(r.bytes) != 0:
+		slice = string(r.bytes[index:end])
+	default:
+		slice = r.str[index:end]
+	}
+	r.i = end
+	return
+}
+
+// AppendString is like ReadString except that the data is written to the
+// [strings.Builder] given, reusing its buffer instead of allocating a new
+// string for each call. On error, nothing is written to dst
+//
+// AppendString was added by go-corelibs
+func (r *textReader[V]) AppendString(dst *strings.Builder, index, count int64) (err error) {
+	if err = r.checkSlice(".AppendString", index, count); err != nil {
+		return err
+	}
+	end := min(index+count, r.n)
+	switch {
+	case len(r.runes) != 0:
+		for _, ch := range r.runes[index:end] {
+			dst.WriteRune(ch)
+		}
+	case len(r.bytes) != 0:
+		dst.Write(r.bytes[index:end])
+	default:
+		dst.WriteString(r.str[index:end])
+	}
+	r.i = end
+	return
+}
+
+// checkSlice validates the index and count arguments of the slice methods,
+// returning an error prefixed with method when they are out of range
+func (r *textReader[V]) checkSlice(method string, index, count int64) error {
+	r.prevRune = -1
+	if index < 0 {
+		return r.newError(method + ": negative position")
+	} else if count < 1 {
+		return r.newError(method + ": zero or negative count")
+	} else if index >= r.n {
+		return io.EOF
+	}
+	return nil
+}
+
+// appendRunes appends up to count runes, starting at index, to dst and
+// returns the extended slice and the number of native units consumed. The
+// caller must ensure that index is within range
+func (r *textReader[V]) appendRunes(dst []rune, index, count int64) ([]rune, int) {
+	r.i = index
+	for track := int64(0); track < count && r.i < r.n; track++ {
+		r.prevRune = int(r.i)
+		if ch := r.unitAt(r.i); ch < utf8.RuneSelf {
+			dst = append(dst, ch)
+			r.i += 1
+		} else {
+			ch, width := r.decodeMultiRune(r.i, ch)
+			dst = append(dst, ch)
+			r.i += width
+		}
+	}
+	return dst, int(r.i - index)
+}
+
+// appendBytes appends the UTF-8 encoding of the native units from index to
+// end to dst and returns the extended slice. The caller must ensure that
+// index and end are within range
+func (r *textReader[V]) appendBytes(dst []byte, index, end int64) []byte {
+	switch {
+	case len(r.runes) != 0:
+		for _, ch := range r.runes[index:end] {
+			dst = utf8.AppendRune(dst, ch)
+		}
+	case len(r.bytes) != 0:
+		dst = append(dst, r.bytes[index:end]...)
+	default:
+		dst = append(dst, r.str[index:end]...)
+	}
+	r.i = end
+	return dst
+}
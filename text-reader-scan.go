@@ -0,0 +1,44 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+// ReadWhile seeks to the index given and reads runes for as long as fn
+// returns true, returning the runes read and their total size in native
+// units. As with ReadRuneSlice, the reader is left positioned after the last
+// rune read and io.EOF is returned when the index is at or past the end
+//
+// ReadWhile was added by go-corelibs
+func (r *textReader[V]) ReadWhile(index int64, fn func(ch rune) bool) (slice []rune, size int, err error) {
+	return readWhile(r, r.name()+".ReadWhile", index, fn)
+}
+
+// ReadUntil is like ReadWhile, reading runes up to the first rune in delims.
+// When inclusive is true, the delimiter is included in the slice and size,
+// and the reader is left positioned after it. A nil delims is an empty set,
+// reading to the end of the text
+//
+// ReadUntil was added by go-corelibs
+func (r *textReader[V]) ReadUntil(index int64, delims *RuneSet, inclusive bool) (slice []rune, size int, err error) {
+	return readUntil(r, r.name()+".ReadUntil", index, delims, inclusive)
+}
+
+// SkipWhile is like ReadWhile, but returns the index of the first rune for
+// which fn returns false, or the size when there is none, without collecting
+// the runes skipped
+//
+// SkipWhile was added by go-corelibs
+func (r *textReader[V]) SkipWhile(index int64, fn func(ch rune) bool) (next int64, err error) {
+	return skipWhile(r, r.name()+".SkipWhile", index, fn)
+}
+
+// ReadLine reads the line at the index given, up to and including the next
+// line feed, and returns it without the line feed or the carriage return
+// preceding it, along with the index of the next line. The last line of the
+// text need not end with a line feed, in which case next is the size
+//
+// ReadLine was added by go-corelibs
+func (r *textReader[V]) ReadLine(index int64) (line []rune, next int64, err error) {
+	return readLine(r, r.name()+".ReadLine", index)
+}
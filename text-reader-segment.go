@@ -0,0 +1,51 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+// NextWordBoundary returns the index of the first UAX #29 word boundary after
+// the index given. The end of the text is always a boundary and io.EOF is
+// returned along with the size when the index is at or past the end
+//
+// NextWordBoundary was added by go-corelibs
+func (r *textReader[V]) NextWordBoundary(index int64) (next int64, err error) {
+	return nextBoundary(r, r.name()+".NextWordBoundary", index, wordSegments)
+}
+
+// PrevWordBoundary returns the index of the last UAX #29 word boundary before
+// the index given. The start of the text is always a boundary and io.EOF is
+// returned along with zero when the index is zero
+//
+// PrevWordBoundary was added by go-corelibs
+func (r *textReader[V]) PrevWordBoundary(index int64) (prev int64, err error) {
+	return prevBoundary(r, r.name()+".PrevWordBoundary", index, wordSegments)
+}
+
+// NextSentenceBoundary is like NextWordBoundary, for UAX #29 sentences
+//
+// NextSentenceBoundary was added by go-corelibs
+func (r *textReader[V]) NextSentenceBoundary(index int64) (next int64, err error) {
+	return nextBoundary(r, r.name()+".NextSentenceBoundary", index, sentenceSegments)
+}
+
+// PrevSentenceBoundary is like PrevWordBoundary, for UAX #29 sentences
+//
+// PrevSentenceBoundary was added by go-corelibs
+func (r *textReader[V]) PrevSentenceBoundary(index int64) (prev int64, err error) {
+	return prevBoundary(r, r.name()+".PrevSentenceBoundary", index, sentenceSegments)
+}
+
+// Words returns a new iterator over the UAX #29 words of the text
+//
+// Words was added by go-corelibs
+func (r *textReader[V]) Words() *Segments {
+	return newSegments(r, wordSegments)
+}
+
+// Sentences returns a new iterator over the UAX #29 sentences of the text
+//
+// Sentences was added by go-corelibs
+func (r *textReader[V]) Sentences() *Segments {
+	return newSegments(r, sentenceSegments)
+}
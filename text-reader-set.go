@@ -0,0 +1,24 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes
+
+// IndexAny returns the index of the first rune in the set, from the index
+// given, or -1 when there is none. The reader is left positioned at the rune
+// found and io.EOF is returned when the index is at or past the end
+//
+// IndexAny was added by go-corelibs
+func (r *textReader[V]) IndexAny(index int64, set *RuneSet) (found int64, err error) {
+	return indexAny(r, r.name()+".IndexAny", index, set)
+}
+
+// Accept reads the rune at the index given when it is in the set, returning
+// true and its size in native units with the reader left positioned after
+// it. Otherwise the reader is left positioned at the index. io.EOF is
+// returned when the index is at or past the end
+//
+// Accept was added by go-corelibs
+func (r *textReader[V]) Accept(index int64, set *RuneSet) (ok bool, size int, err error) {
+	return accept(r, r.name()+".Accept", index, set)
+}
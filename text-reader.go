@@ -0,0 +1,366 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package runes
+
+import (
+	"errors"
+	"io"
+	"unicode/utf8"
+)
+
+// Text is the set of underlying data types that the readers in this package
+// can operate upon
+type Text interface {
+	[]byte | string | []rune
+}
+
+// textReader is the single implementation behind BytesReader, StringReader
+// and Reader. All indices and lengths are in the native units of V: bytes for
+// []byte and string, runes for []rune
+//
+// Only the field matching V is ever populated. The per-type code paths test
+// the length of the rune and byte views instead of type-switching on V, which
+// keeps the hot paths free of generic dictionary lookups
+type textReader[V Text] struct {
+	n        int64  // length of the text, in native units
+	i        int64  // current reading index
+	prevRune int    // index of previous rune; or < 0
+	runes    []rune // the text, when V is []rune
+	bytes    []byte // the text, when V is []byte
+	str      string // the text, when V is string
+}
+
+// Len returns the number of native units (bytes or runes) of the unread
+// portion of the slice.
+func (r *textReader[V]) Len() int {
+	if r.i >= r.n {
+		return 0
+	}
+	return int(r.n - r.i)
+}
+
+// Size returns the original length of the underlying slice, in native units.
+// Size is the number of units available for reading via ReadAt.
+// The result is unaffected by any method calls except Reset.
+func (r *textReader[V]) Size() int64 { return r.n }
+
+// Read implements the [io.Reader] interface.
+//
+// For rune slices, only whole runes are encoded into b and io.ErrShortBuffer
+// is returned if b is too small to hold the next rune.
+func (r *textReader[V]) Read(b []byte) (n int, err error) {
+	if r.i >= r.n {
+		return 0, io.EOF
+	}
+	r.prevRune = -1
+	switch {
+	case len(r.runes) != 0:
+		var count int64
+		n, count = encodeRunes(b, r.runes[r.i:], false)
+		r.i += count
+		if n == 0 && len(b) > 0 {
+			err = io.ErrShortBuffer
+		}
+	case len(r.bytes) != 0:
+		n = copy(b, r.bytes[r.i:])
+		r.i += int64(n)
+	default:
+		n = copy(b, r.str[r.i:])
+		r.i += int64(n)
+	}
+	return
+}
+
+// ReadAt implements the [io.ReaderAt] interface.
+//
+// For rune slices, off is a rune index and b is filled with the UTF-8
+// encoding of the runes starting at off.
+func (r *textReader[V]) ReadAt(b []byte, off int64) (n int, err error) {
+	// cannot modify state - see io.ReaderAt
+	if off < 0 {
+		return 0, r.newError(".ReadAt: negative offset")
+	}
+	if off >= r.n {
+		return 0, io.EOF
+	}
+	switch {
+	case len(r.runes) != 0:
+		n, _ = encodeRunes(b, r.runes[off:], true)
+	case len(r.bytes) != 0:
+		n = copy(b, r.bytes[off:])
+	default:
+		n = copy(b, r.str[off:])
+	}
+	if n < len(b) {
+		err = io.EOF
+	}
+	return
+}
+
+// ReadByte implements the [io.ByteReader] interface.
+//
+// For rune slices, the low byte of the next rune is returned.
+func (r *textReader[V]) ReadByte() (byte, error) {
+	r.prevRune = -1
+	if r.i >= r.n {
+		return 0, io.EOF
+	}
+	b := byte(r.unitAt(r.i))
+	r.i++
+	return b, nil
+}
+
+// UnreadByte complements ReadByte in implementing the [io.ByteScanner]
+// interface.
+func (r *textReader[V]) UnreadByte() error {
+	if r.i <= 0 {
+		return r.newError(".UnreadByte: at beginning of " + r.noun())
+	}
+	r.prevRune = -1
+	r.i--
+	return nil
+}
+
+// ReadRune implements the [io.RuneReader] interface. The size returned is
+// always the UTF-8 encoded length of the rune, in bytes.
+func (r *textReader[V]) ReadRune() (ch rune, size int, err error) {
+	if r.i >= r.n {
+		r.prevRune = -1
+		return 0, 0, io.EOF
+	}
+	r.prevRune = int(r.i)
+	if ch = r.unitAt(r.i); ch < utf8.RuneSelf {
+		r.i++
+		return ch, 1, nil
+	}
+	var width int64
+	ch, width = r.decodeMultiRune(r.i, ch)
+	r.i += width
+	if len(r.runes) != 0 {
+		return ch, runeLen(ch), nil
+	}
+	return ch, int(width), nil
+}
+
+// UnreadRune complements ReadRune in implementing the [io.RuneScanner]
+// interface.
+func (r *textReader[V]) UnreadRune() error {
+	if r.i <= 0 {
+		return r.newError(".UnreadRune: at beginning of " + r.noun())
+	}
+	if r.prevRune < 0 {
+		return r.newError(".UnreadRune: previous operation was not ReadRune")
+	}
+	r.i = int64(r.prevRune)
+	r.prevRune = -1
+	return nil
+}
+
+// Seek implements the [io.Seeker] interface.
+func (r *textReader[V]) Seek(offset int64, whence int) (int64, error) {
+	r.prevRune = -1
+	var abs int64
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		abs = r.i + offset
+	case io.SeekEnd:
+		abs = r.n + offset
+	default:
+		return 0, r.newError(".Seek: invalid whence")
+	}
+	if abs < 0 {
+		return 0, r.newError(".Seek: negative position")
+	}
+	r.i = abs
+	return abs, nil
+}
+
+// WriteTo implements the [io.WriterTo] interface. The count returned is
+// always the number of bytes written.
+func (r *textReader[V]) WriteTo(w io.Writer) (n int64, err error) {
+	r.prevRune = -1
+	if r.i >= r.n {
+		return 0, nil
+	}
+	var m, total int
+	switch {
+	case len(r.runes) != 0:
+		b := []byte(string(r.runes[r.i:]))
+		total = len(b)
+		m, err = w.Write(b)
+		if m <= total {
+			// advance past each rune that was completely written
+			for written := 0; r.i < r.n; r.i++ {
+				if written += runeLen(r.runes[r.i]); written > m {
+					break
+				}
+			}
+		}
+	case len(r.bytes) != 0:
+		b := r.bytes[r.i:]
+		total = len(b)
+		m, err = w.Write(b)
+		if m <= total {
+			r.i += int64(m)
+		}
+	default:
+		s := r.str[r.i:]
+		total = len(s)
+		m, err = io.WriteString(w, s)
+		if m <= total {
+			r.i += int64(m)
+		}
+	}
+	if m > total {
+		panic(r.name() + ".WriteTo: invalid Write count")
+	}
+	n = int64(m)
+	if m != total && err == nil {
+		err = io.ErrShortWrite
+	}
+	return
+}
+
+// Reset resets the reader to be reading from s.
+func (r *textReader[V]) Reset(s V) {
+	*r = textReader[V]{n: int64(len(s)), prevRune: -1}
+	switch t := any(&s).(type) {
+	case *[]byte:
+		r.bytes = *t
+	case *string:
+		r.str = *t
+	case *[]rune:
+		r.runes = *t
+	}
+}
+
+// name returns the exported type name used in error messages
+func (r *textReader[V]) name() string {
+	switch any(r).(type) {
+	case *textReader[[]byte]:
+		return "BytesReader"
+	case *textReader[string]:
+		return "StringReader"
+	default:
+		return "Reader"
+	}
+}
+
+// newError returns a new error with the exported type name prefixed to msg
+func (r *textReader[V]) newError(msg string) error {
+	return errors.New(r.name() + msg)
+}
+
+// runeIndexed returns true if the native units of the reader are runes
+func (r *textReader[V]) runeIndexed() bool {
+	_, ok := any(r).(*textReader[[]rune])
+	return ok
+}
+
+// noun returns the word used in error messages to describe the text
+func (r *textReader[V]) noun() string {
+	if _, ok := any(r).(*textReader[string]); ok {
+		return "string"
+	}
+	return "slice"
+}
+
+// unitAt returns the native unit at index i as a rune. For []byte and string
+// text, values at or above utf8.RuneSelf need to be decoded with
+// decodeMultiRune. The caller must ensure that i is within range
+func (r *textReader[V]) unitAt(i int64) rune {
+	if len(r.runes) != 0 {
+		return r.runes[i]
+	} else if len(r.bytes) != 0 {
+		return rune(r.bytes[i])
+	}
+	return rune(r.str[i])
+}
+
+// decodeRune returns the rune starting at index i and its width in native
+// units. The caller must ensure that i is within range
+func (r *textReader[V]) decodeRune(i int64) (ch rune, width int64) {
+	if ch = r.unitAt(i); ch < utf8.RuneSelf {
+		return ch, 1
+	}
+	return r.decodeMultiRune(i, ch)
+}
+
+// decodeMultiRune is the slow path of decodeRune, where unit is the
+// non-ASCII value returned by unitAt(i)
+func (r *textReader[V]) decodeMultiRune(i int64, unit rune) (ch rune, width int64) {
+	var size int
+	if len(r.runes) != 0 {
+		return unit, 1
+	} else if len(r.bytes) != 0 {
+		ch, size = utf8.DecodeRune(r.bytes[i:])
+	} else {
+		ch, size = utf8.DecodeRuneInString(r.str[i:])
+	}
+	return ch, int64(size)
+}
+
+// decodeLastRune returns the rune ending at index i and its width in native
+// units. The caller must ensure that 0 < i <= Size()
+func (r *textReader[V]) decodeLastRune(i int64) (ch rune, width int64) {
+	if ch = r.unitAt(i - 1); ch < utf8.RuneSelf {
+		return ch, 1
+	}
+	return r.decodeLastMultiRune(i, ch)
+}
+
+// decodeLastMultiRune is the slow path of decodeLastRune, where unit is the
+// non-ASCII value returned by unitAt(i-1)
+func (r *textReader[V]) decodeLastMultiRune(i int64, unit rune) (ch rune, width int64) {
+	var size int
+	if len(r.runes) != 0 {
+		return unit, 1
+	} else if len(r.bytes) != 0 {
+		ch, size = utf8.DecodeLastRune(r.bytes[:i])
+	} else {
+		ch, size = utf8.DecodeLastRuneInString(r.str[:i])
+	}
+	return ch, int64(size)
+}
+
+// runeLen is utf8.RuneLen except that invalid runes are counted as the
+// length of utf8.RuneError, which is what they are encoded as
+func runeLen(ch rune) int {
+	if n := utf8.RuneLen(ch); n > 0 {
+		return n
+	}
+	return 3
+}
+
+// encodeRunes writes the UTF-8 encoding of src into dst, returning the number
+// of bytes and whole runes written. When partial is true, the last rune may
+// be truncated in order to fill dst completely
+func encodeRunes(dst []byte, src []rune, partial bool) (n int, count int64) {
+	var buf [utf8.UTFMax]byte
+	for _, ch := range src {
+		if n >= len(dst) {
+			break
+		}
+		if ch < utf8.RuneSelf {
+			dst[n] = byte(ch)
+			n += 1
+			count += 1
+			continue
+		}
+		size := utf8.EncodeRune(buf[:], ch)
+		if n+size > len(dst) {
+			if partial {
+				n += copy(dst[n:], buf[:size])
+			}
+			break
+		}
+		copy(dst[n:], buf[:size])
+		n += size
+		count += 1
+	}
+	return
+}
@@ -0,0 +1,279 @@
+// Copyright 2024 The Go-CoreLibs Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+package runes_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	. "github.com/go-corelibs/runes"
+)
+
+var differentialSeeds = []string{
+	"",
+	"a",
+	"stuff",
+	"日本語",
+	"hello, 世界!",
+	"éé \U0001F600 ok",
+	"\t\r\n\x00 ",
+}
+
+// differential holds the same text in all three reader types, along with the
+// byte offset of every rune so that native indices can be translated
+type differential struct {
+	text    string
+	offsets []int64 // offsets[n] is the byte offset of rune n, plus the total
+	bytes   *BytesReader
+	string  *StringReader
+	runes   *Reader
+}
+
+func newDifferential(text string) (d *differential) {
+	d = &differential{
+		text:   text,
+		bytes:  NewBytesReader([]byte(text)),
+		string: NewStringReader(text),
+		runes:  NewRunesReader([]rune(text)),
+	}
+	for idx := range text {
+		d.offsets = append(d.offsets, int64(idx))
+	}
+	d.offsets = append(d.offsets, int64(len(text)))
+	return
+}
+
+type runeResult struct {
+	ch   rune
+	size int
+	err  error
+}
+
+//gocyclo:ignore
+func (d *differential) check(t *testing.T, index, count int64) {
+	t.Helper()
+	total := int64(len(d.offsets) - 1)
+	index = index % (total + 1)
+	count = count%(total+1) + 1
+	end := min(index+count, total)
+	boff, bend := d.offsets[index], d.offsets[end]
+
+	// ReadRuneAt
+	for _, r := range []RuneReader{d.bytes, d.string} {
+		ch, size, err := r.ReadRuneAt(boff)
+		ech, esize, eerr := d.runes.ReadRuneAt(index)
+		if ch != ech || err != eerr || (err == nil && (esize != 1 || int64(size) != d.offsets[index+1]-boff)) {
+			t.Errorf("%q ReadRuneAt(%d): got %q,%d,%v; want %q,%v", d.text, index, ch, size, err, ech, eerr)
+		}
+	}
+
+	// ReadPrevRuneFrom
+	if index > 0 {
+		for _, r := range []RuneReader{d.bytes, d.string} {
+			ch, _, err := r.ReadPrevRuneFrom(boff)
+			ech, _, eerr := d.runes.ReadPrevRuneFrom(index)
+			if ch != ech || err != eerr {
+				t.Errorf("%q ReadPrevRuneFrom(%d): got %q,%v; want %q,%v", d.text, index, ch, err, ech, eerr)
+			}
+			pos, _ := r.Seek(0, io.SeekCurrent)
+			epos, _ := d.runes.Seek(0, io.SeekCurrent)
+			if pos != d.offsets[epos] {
+				t.Errorf("%q ReadPrevRuneFrom(%d): left at %d; want %d", d.text, index, pos, d.offsets[epos])
+			}
+		}
+	}
+
+	// ReadNextRuneFrom
+	for _, r := range []RuneReader{d.bytes, d.string} {
+		ch, _, err := r.ReadNextRuneFrom(boff)
+		ech, _, eerr := d.runes.ReadNextRuneFrom(index)
+		if ch != ech || err != eerr {
+			t.Errorf("%q ReadNextRuneFrom(%d): got %q,%v; want %q,%v", d.text, index, ch, err, ech, eerr)
+		}
+	}
+
+	// ReadRuneSlice
+	for _, r := range []RuneReader{d.bytes, d.string} {
+		slice, size, err := r.ReadRuneSlice(boff, count)
+		eslice, esize, eerr := d.runes.ReadRuneSlice(index, count)
+		if string(slice) != string(eslice) || err != eerr {
+			t.Errorf("%q ReadRuneSlice(%d,%d): got %q,%v; want %q,%v", d.text, index, count, string(slice), err, string(eslice), eerr)
+		} else if err == nil && (int64(size) != bend-boff || int64(esize) != end-index) {
+			t.Errorf("%q ReadRuneSlice(%d,%d): got sizes %d,%d; want %d,%d", d.text, index, count, size, esize, bend-boff, end-index)
+		}
+		if err == nil && len(slice) != cap(slice) && int64(len(slice)) == count {
+			t.Errorf("%q ReadRuneSlice(%d,%d): over-allocated %d", d.text, index, count, cap(slice))
+		}
+	}
+
+	// AppendRuneSlice matches ReadRuneSlice, after the existing contents
+	for _, r := range []RuneReader{d.bytes, d.string, d.runes} {
+		at := boff
+		if r == d.runes {
+			at = index
+		}
+		eslice, esize, eerr := r.ReadRuneSlice(at, count)
+		epos, _ := r.Seek(0, io.SeekCurrent)
+		slice, size, err := r.(RuneAppender).AppendRuneSlice([]rune("prefix"), at, count)
+		if string(slice) != "prefix"+string(eslice) || size != esize || err != eerr {
+			t.Errorf("%q AppendRuneSlice(%d,%d): got %q,%d,%v; want %q,%d,%v", d.text, index, count, string(slice), size, err, "prefix"+string(eslice), esize, eerr)
+		}
+		if pos, _ := r.Seek(0, io.SeekCurrent); err == nil && pos != epos {
+			t.Errorf("%q AppendRuneSlice(%d,%d): left at %d; want %d", d.text, index, count, pos, epos)
+		}
+	}
+
+	// ReadByteSlice and ReadString use native counts
+	for _, r := range []RuneReader{d.bytes, d.string} {
+		data, err := r.ReadByteSlice(boff, max(bend-boff, 1))
+		edata, eerr := d.runes.ReadByteSlice(index, max(end-index, 1))
+		if !bytes.Equal(data, edata) || err != eerr {
+			t.Errorf("%q ReadByteSlice(%d): got %q,%v; want %q,%v", d.text, index, data, err, edata, eerr)
+		}
+		str, err := r.ReadString(boff, max(bend-boff, 1))
+		estr, eerr := d.runes.ReadString(index, max(end-index, 1))
+		if str != estr || err != eerr {
+			t.Errorf("%q ReadString(%d): got %q,%v; want %q,%v", d.text, index, str, err, estr, eerr)
+		}
+		data, err = r.(RuneAppender).AppendByteSlice([]byte("prefix"), boff, max(bend-boff, 1))
+		edata, eerr = d.runes.AppendByteSlice([]byte("prefix"), index, max(end-index, 1))
+		if !bytes.Equal(data, edata) || err != eerr || (err == nil && string(data) != "prefix"+estr) {
+			t.Errorf("%q AppendByteSlice(%d): got %q,%v; want %q,%v", d.text, index, data, err, edata, eerr)
+		}
+		var sb, esb strings.Builder
+		sb.WriteString("prefix")
+		esb.WriteString("prefix")
+		err = r.(RuneAppender).AppendString(&sb, boff, max(bend-boff, 1))
+		eerr = d.runes.AppendString(&esb, index, max(end-index, 1))
+		if sb.String() != esb.String() || err != eerr || (err == nil && sb.String() != "prefix"+estr) {
+			t.Errorf("%q AppendString(%d): got %q,%v; want %q,%v", d.text, index, sb.String(), err, esb.String(), eerr)
+		}
+	}
+}
+
+//gocyclo:ignore
+func (d *differential) checkSequential(t *testing.T) {
+	t.Helper()
+	readers := []RuneReader{d.bytes, d.string, d.runes}
+
+	// ReadRune and UnreadRune over the whole text
+	var results [3][]runeResult
+	for idx, r := range readers {
+		_, _ = r.Seek(0, io.SeekStart)
+		for {
+			ch, size, err := r.ReadRune()
+			results[idx] = append(results[idx], runeResult{ch, size, err})
+			if err != nil {
+				break
+			}
+			if err = r.UnreadRune(); err != nil {
+				t.Fatalf("%q UnreadRune: %v", d.text, err)
+			}
+			if again, _, _ := r.ReadRune(); again != ch {
+				t.Fatalf("%q ReadRune after UnreadRune: got %q; want %q", d.text, again, ch)
+			}
+		}
+	}
+	for idx := 1; idx < 3; idx++ {
+		if len(results[idx]) != len(results[0]) {
+			t.Fatalf("%q ReadRune: reader %d read %d runes; want %d", d.text, idx, len(results[idx]), len(results[0]))
+		}
+		for jdx := range results[0] {
+			if results[idx][jdx] != results[0][jdx] {
+				t.Errorf("%q ReadRune #%d: reader %d got %v; want %v", d.text, jdx, idx, results[idx][jdx], results[0][jdx])
+			}
+		}
+	}
+
+	// io.ReadAll, WriteTo and ReadAt produce identical bytes
+	for idx, r := range readers {
+		_, _ = r.Seek(0, io.SeekStart)
+		if data, err := io.ReadAll(r); err != nil || string(data) != d.text {
+			t.Errorf("%q ReadAll: reader %d got %q,%v", d.text, idx, data, err)
+		}
+		_, _ = r.Seek(0, io.SeekStart)
+		var buf bytes.Buffer
+		if n, err := r.WriteTo(&buf); err != nil || n != int64(len(d.text)) || buf.String() != d.text {
+			t.Errorf("%q WriteTo: reader %d got %q,%d,%v", d.text, idx, buf.String(), n, err)
+		}
+		if r.Len() != 0 {
+			t.Errorf("%q WriteTo: reader %d left %d unread", d.text, idx, r.Len())
+		}
+		buf.Reset()
+		if _, err := io.Copy(&buf, io.NewSectionReader(r, 0, r.Size())); err != nil {
+			t.Errorf("%q ReadAt: reader %d error %v", d.text, idx, err)
+		}
+		if idx < 2 && buf.String() != d.text {
+			t.Errorf("%q ReadAt: reader %d got %q", d.text, idx, buf.String())
+		}
+	}
+}
+
+func TestAppendReuse(t *testing.T) {
+	const text = "hello, 世界"
+	for _, r := range []RuneAppender{NewBytesReader([]byte(text)), NewStringReader(text), NewRunesReader([]rune(text))} {
+		runeBuf := make([]rune, 0, 64)
+		byteBuf := make([]byte, 0, 64)
+		var sb strings.Builder
+		sb.Grow(1024)
+		allocs := testing.AllocsPerRun(100, func() {
+			runeBuf, _, _ = r.AppendRuneSlice(runeBuf[:0], 0, 9)
+			byteBuf, _ = r.AppendByteSlice(byteBuf[:0], 0, 5)
+			_ = r.AppendString(&sb, 0, 5)
+		})
+		if allocs != 0 {
+			t.Errorf("%T: got %v allocations; want 0", r, allocs)
+		}
+		if string(runeBuf) != "hello, 世界" || string(byteBuf) != "hello" {
+			t.Errorf("%T: got %q,%q", r, string(runeBuf), string(byteBuf))
+		}
+		if _, _, err := r.AppendRuneSlice(runeBuf, -1, 1); err == nil || !strings.HasSuffix(err.Error(), ".AppendRuneSlice: negative position") {
+			t.Errorf("%T: AppendRuneSlice: got %v; want negative position error", r, err)
+		}
+		if data, err := r.AppendByteSlice(byteBuf, 0, 0); err == nil || len(data) != len(byteBuf) {
+			t.Errorf("%T: AppendByteSlice: got %q,%v; want unchanged dst and error", r, data, err)
+		}
+		if err := r.AppendString(&sb, int64(len(text)+1), 1); err != io.EOF {
+			t.Errorf("%T: AppendString: got %v; want io.EOF", r, err)
+		}
+	}
+
+	// the wrappers promote the methods of the readers of their text
+	if r, err := NewEscapeReader(NewStringReader(text)); err != nil {
+		t.Fatal(err)
+	} else if _, ok := RuneReader(r).(RuneAppender); !ok {
+		t.Errorf("EscapeReader: does not implement RuneAppender")
+	}
+}
+
+func TestDifferentialReaders(t *testing.T) {
+	for _, text := range differentialSeeds {
+		d := newDifferential(text)
+		d.checkSequential(t)
+		for index := int64(0); index <= int64(len(d.offsets)); index++ {
+			for count := int64(0); count <= int64(len(d.offsets)); count++ {
+				d.check(t, index, count)
+			}
+		}
+	}
+}
+
+func FuzzDifferentialReaders(f *testing.F) {
+	for _, text := range differentialSeeds {
+		f.Add(text, int64(0), int64(1))
+	}
+	f.Fuzz(func(t *testing.T, text string, index, count int64) {
+		if !utf8.ValidString(text) || index < 0 || count < 0 {
+			// invalid UTF-8 is not representable as a []rune
+			t.Skip()
+		}
+		d := newDifferential(text)
+		d.checkSequential(t)
+		d.check(t, index, count)
+	})
+}